@@ -0,0 +1,9 @@
+package db
+
+import "embed"
+
+// Migrations holds the SQL files of db/migrations so the binary can apply
+// them without the migrate CLI.
+//
+//go:embed migrations/*.sql
+var Migrations embed.FS
@@ -0,0 +1,10 @@
+ALTER TABLE sales DROP CONSTRAINT IF EXISTS sales_entity_id_dealer_id_fkey;
+ALTER TABLE vehicles DROP CONSTRAINT IF EXISTS vehicles_entity_id_dealer_id_key;
+
+ALTER TABLE api_keys DROP COLUMN IF EXISTS dealer_id;
+ALTER TABLE sales DROP COLUMN IF EXISTS dealer_id;
+ALTER TABLE vehicles DROP COLUMN IF EXISTS dealer_id;
+
+UPDATE api_keys SET roles = REPLACE(roles, 'PLATFORM_ADMIN', 'ADMIN');
+
+DROP TABLE IF EXISTS dealers;
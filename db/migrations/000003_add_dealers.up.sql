@@ -0,0 +1,38 @@
+CREATE TABLE IF NOT EXISTS dealers (
+    id SERIAL PRIMARY KEY,
+    name TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW()
+);
+
+ALTER TABLE vehicles ADD COLUMN dealer_id INT REFERENCES dealers (id);
+ALTER TABLE sales ADD COLUMN dealer_id INT REFERENCES dealers (id);
+ALTER TABLE api_keys ADD COLUMN dealer_id INT REFERENCES dealers (id);
+
+-- Rows stored before tenancy belong to a default dealer, created only when
+-- there is something to move into it.
+INSERT INTO dealers (name)
+SELECT 'Default'
+WHERE EXISTS (SELECT 1 FROM vehicles)
+   OR EXISTS (SELECT 1 FROM api_keys WHERE roles LIKE '%DEALER_STAFF%');
+
+UPDATE vehicles SET dealer_id = (SELECT MIN(id) FROM dealers);
+UPDATE sales SET dealer_id = (SELECT MIN(id) FROM dealers);
+
+-- Existing keys keep their reach: admin keys managed every vehicle, so they
+-- become platform admin keys, and staff keys join the default dealer.
+UPDATE api_keys SET roles = REPLACE(roles, 'ADMIN', 'PLATFORM_ADMIN');
+UPDATE api_keys SET dealer_id = (SELECT MIN(id) FROM dealers) WHERE roles LIKE '%DEALER_STAFF%';
+
+ALTER TABLE vehicles ALTER COLUMN dealer_id SET NOT NULL;
+ALTER TABLE sales ALTER COLUMN dealer_id SET NOT NULL;
+
+-- entity_id stays unique across dealers, since buyers and the payments
+-- webhook address vehicles without knowing their dealer. The composite key
+-- keeps a sale in the dealer of its vehicle.
+ALTER TABLE vehicles ADD CONSTRAINT vehicles_entity_id_dealer_id_key UNIQUE (entity_id, dealer_id);
+ALTER TABLE sales ADD CONSTRAINT sales_entity_id_dealer_id_fkey
+    FOREIGN KEY (entity_id, dealer_id) REFERENCES vehicles (entity_id, dealer_id);
+
+CREATE INDEX IF NOT EXISTS vehicles_dealer_id_idx ON vehicles (dealer_id);
+CREATE INDEX IF NOT EXISTS sales_dealer_id_idx ON sales (dealer_id);
+CREATE INDEX IF NOT EXISTS api_keys_dealer_id_idx ON api_keys (dealer_id);
@@ -0,0 +1,12 @@
+DROP INDEX IF EXISTS vehicles_vin_key;
+
+ALTER TABLE vehicles
+    DROP COLUMN IF EXISTS vin,
+    DROP COLUMN IF EXISTS license_plate,
+    DROP COLUMN IF EXISTS engine_displacement,
+    DROP COLUMN IF EXISTS doors,
+    DROP COLUMN IF EXISTS condition,
+    DROP COLUMN IF EXISTS body_type,
+    DROP COLUMN IF EXISTS transmission,
+    DROP COLUMN IF EXISTS fuel_type,
+    DROP COLUMN IF EXISTS mileage;
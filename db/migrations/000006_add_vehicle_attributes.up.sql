@@ -0,0 +1,16 @@
+-- Optional attributes default to empty, which the service reads as not
+-- informed, so vehicles stored before them need no backfill.
+ALTER TABLE vehicles
+    ADD COLUMN mileage INT NOT NULL DEFAULT 0,
+    ADD COLUMN fuel_type TEXT NOT NULL DEFAULT '',
+    ADD COLUMN transmission TEXT NOT NULL DEFAULT '',
+    ADD COLUMN body_type TEXT NOT NULL DEFAULT '',
+    ADD COLUMN condition TEXT NOT NULL DEFAULT '',
+    ADD COLUMN doors INT NOT NULL DEFAULT 0,
+    ADD COLUMN engine_displacement INT NOT NULL DEFAULT 0,
+    ADD COLUMN license_plate TEXT NOT NULL DEFAULT '',
+    ADD COLUMN vin TEXT NOT NULL DEFAULT '';
+
+-- A VIN identifies a single vehicle, so it is unique across dealers once
+-- informed.
+CREATE UNIQUE INDEX IF NOT EXISTS vehicles_vin_key ON vehicles (vin) WHERE vin <> '';
@@ -0,0 +1,22 @@
+-- Photos of a vehicle. The files live in the media storage, the rows only
+-- keep their keys, and they go away with their vehicle.
+CREATE TABLE IF NOT EXISTS vehicle_media (
+    id SERIAL PRIMARY KEY,
+    entity_id TEXT UNIQUE NOT NULL,
+    vehicle_id TEXT NOT NULL,
+    dealer_id INT NOT NULL,
+    content_type TEXT NOT NULL,
+    size BIGINT NOT NULL,
+    width INT NOT NULL,
+    height INT NOT NULL,
+    position INT NOT NULL,
+    is_cover BOOLEAN NOT NULL DEFAULT FALSE,
+    storage_key TEXT NOT NULL,
+    thumbnail_key TEXT NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+
+    FOREIGN KEY (vehicle_id, dealer_id) REFERENCES vehicles (entity_id, dealer_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS vehicle_media_vehicle_id_idx ON vehicle_media (vehicle_id, position);
+CREATE INDEX IF NOT EXISTS vehicle_media_dealer_id_idx ON vehicle_media (dealer_id);
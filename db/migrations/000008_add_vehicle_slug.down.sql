@@ -0,0 +1,3 @@
+DROP INDEX IF EXISTS vehicles_slug_key;
+
+ALTER TABLE vehicles DROP COLUMN IF EXISTS slug;
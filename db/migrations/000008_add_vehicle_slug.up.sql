@@ -0,0 +1,15 @@
+ALTER TABLE vehicles ADD COLUMN slug TEXT NOT NULL DEFAULT '';
+
+-- Vehicles stored before slugs get one built like the service does, dropping
+-- the accents of the Latin letters, with a suffix from a hash of the entity id.
+UPDATE vehicles
+SET slug = trim(BOTH '-' FROM regexp_replace(
+        translate(
+            lower(year || '-' || brand || '-' || model || '-' || color),
+            'áàâãäåéèêëíìîïóòôõöúùûüýÿçñ',
+            'aaaaaaeeeeiiiiooooouuuuyycn'
+        ),
+        '[^a-z0-9]+', '-', 'g'
+    )) || '-' || left(encode(sha256(convert_to(entity_id, 'UTF8')), 'hex'), 6);
+
+CREATE UNIQUE INDEX IF NOT EXISTS vehicles_slug_key ON vehicles (slug) WHERE slug <> '';
@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS vehicles_status_published_at_idx;
+
+ALTER TABLE vehicles
+    DROP COLUMN IF EXISTS published_at,
+    DROP COLUMN IF EXISTS status;
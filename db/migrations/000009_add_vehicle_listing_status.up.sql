@@ -0,0 +1,9 @@
+ALTER TABLE vehicles
+    ADD COLUMN status TEXT NOT NULL DEFAULT 'DRAFT',
+    ADD COLUMN published_at TIMESTAMP;
+
+-- Vehicles stored before the listing workflow were offered from their
+-- creation, so they stay published.
+UPDATE vehicles SET status = 'PUBLISHED', published_at = created_at;
+
+CREATE INDEX IF NOT EXISTS vehicles_status_published_at_idx ON vehicles (status, published_at);
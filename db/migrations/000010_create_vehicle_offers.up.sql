@@ -0,0 +1,27 @@
+-- Offers made by buyers for a vehicle. They are kept once answered, as the
+-- negotiation history of the vehicle, and go away with it.
+CREATE TABLE IF NOT EXISTS vehicle_offers (
+    id SERIAL PRIMARY KEY,
+    entity_id TEXT UNIQUE NOT NULL,
+    vehicle_id TEXT NOT NULL,
+    dealer_id INT NOT NULL,
+    buyer_subject TEXT NOT NULL,
+    buyer_document_number TEXT NOT NULL,
+    amount DECIMAL(12,2) NOT NULL,
+    counter_amount DECIMAL(12,2),
+    status TEXT NOT NULL,
+    payment_id TEXT NOT NULL DEFAULT '',
+    expires_at TIMESTAMP NOT NULL,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+
+    FOREIGN KEY (vehicle_id, dealer_id) REFERENCES vehicles (entity_id, dealer_id) ON DELETE CASCADE
+);
+
+CREATE INDEX IF NOT EXISTS vehicle_offers_vehicle_id_idx ON vehicle_offers (vehicle_id, id);
+CREATE INDEX IF NOT EXISTS vehicle_offers_dealer_id_idx ON vehicle_offers (dealer_id);
+
+CREATE TRIGGER set_timestamp
+BEFORE UPDATE ON vehicle_offers
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
@@ -0,0 +1,6 @@
+ALTER TABLE sales
+    DROP COLUMN IF EXISTS coupon_code,
+    DROP COLUMN IF EXISTS discount,
+    DROP COLUMN IF EXISTS list_price;
+
+DROP TABLE IF EXISTS coupons;
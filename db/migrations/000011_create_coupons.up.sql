@@ -0,0 +1,36 @@
+-- Coupons of a dealer, redeemed by code when buying one of its vehicles. Zero
+-- and empty restrictions do not restrict.
+CREATE TABLE IF NOT EXISTS coupons (
+    id SERIAL PRIMARY KEY,
+    dealer_id INT NOT NULL REFERENCES dealers (id),
+    code TEXT NOT NULL,
+    discount_type TEXT NOT NULL,
+    discount_value DECIMAL(12,2) NOT NULL,
+    max_uses INT NOT NULL DEFAULT 0,
+    uses INT NOT NULL DEFAULT 0,
+    valid_from TIMESTAMP,
+    valid_until TIMESTAMP,
+    brand TEXT NOT NULL DEFAULT '',
+    model TEXT NOT NULL DEFAULT '',
+    min_year INT NOT NULL DEFAULT 0,
+    max_year INT NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW(),
+
+    CONSTRAINT coupons_dealer_id_code_key UNIQUE (dealer_id, code)
+);
+
+CREATE TRIGGER set_timestamp
+BEFORE UPDATE ON coupons
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- Sales stored before coupons were charged the list price of the vehicle.
+ALTER TABLE sales
+    ADD COLUMN list_price DECIMAL(12,2),
+    ADD COLUMN discount DECIMAL(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN coupon_code TEXT NOT NULL DEFAULT '';
+
+UPDATE sales SET list_price = price;
+
+ALTER TABLE sales ALTER COLUMN list_price SET NOT NULL;
@@ -0,0 +1,11 @@
+ALTER TABLE sales
+    DROP COLUMN IF EXISTS trade_in_vehicle_id,
+    DROP COLUMN IF EXISTS trade_in_appraised_by,
+    DROP COLUMN IF EXISTS trade_in_value,
+    DROP COLUMN IF EXISTS trade_in_vin,
+    DROP COLUMN IF EXISTS trade_in_license_plate,
+    DROP COLUMN IF EXISTS trade_in_mileage,
+    DROP COLUMN IF EXISTS trade_in_color,
+    DROP COLUMN IF EXISTS trade_in_year,
+    DROP COLUMN IF EXISTS trade_in_model,
+    DROP COLUMN IF EXISTS trade_in_brand;
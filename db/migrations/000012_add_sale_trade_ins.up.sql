@@ -0,0 +1,14 @@
+-- Vehicle taken as part of the payment of a sale. A zero trade_in_value means
+-- the sale has no trade-in, and trade_in_vehicle_id stays empty until the sale
+-- is approved and the trade-in becomes a vehicle of the dealer.
+ALTER TABLE sales
+    ADD COLUMN trade_in_brand TEXT NOT NULL DEFAULT '',
+    ADD COLUMN trade_in_model TEXT NOT NULL DEFAULT '',
+    ADD COLUMN trade_in_year INT NOT NULL DEFAULT 0,
+    ADD COLUMN trade_in_color TEXT NOT NULL DEFAULT '',
+    ADD COLUMN trade_in_mileage INT NOT NULL DEFAULT 0,
+    ADD COLUMN trade_in_license_plate TEXT NOT NULL DEFAULT '',
+    ADD COLUMN trade_in_vin TEXT NOT NULL DEFAULT '',
+    ADD COLUMN trade_in_value DECIMAL(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN trade_in_appraised_by TEXT NOT NULL DEFAULT '',
+    ADD COLUMN trade_in_vehicle_id TEXT NOT NULL DEFAULT '';
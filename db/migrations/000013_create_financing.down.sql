@@ -0,0 +1,11 @@
+DROP TABLE IF EXISTS sale_installments;
+
+ALTER TABLE sales
+    DROP COLUMN IF EXISTS financing_months,
+    DROP COLUMN IF EXISTS financing_principal,
+    DROP COLUMN IF EXISTS financing_down_payment,
+    DROP COLUMN IF EXISTS financing_monthly_interest_rate,
+    DROP COLUMN IF EXISTS financing_amortization_system,
+    DROP COLUMN IF EXISTS financing_plan_id;
+
+DROP TABLE IF EXISTS financing_plans;
@@ -0,0 +1,43 @@
+-- Financing plans of a dealer, picked by the buyer when buying one of its
+-- vehicles.
+CREATE TABLE IF NOT EXISTS financing_plans (
+    id SERIAL PRIMARY KEY,
+    dealer_id INT NOT NULL REFERENCES dealers (id),
+    name TEXT NOT NULL,
+    amortization_system TEXT NOT NULL,
+    monthly_interest_rate DECIMAL(7,4) NOT NULL,
+    max_months INT NOT NULL,
+    min_down_payment_percentage DECIMAL(5,2) NOT NULL DEFAULT 0,
+    created_at TIMESTAMP DEFAULT NOW(),
+    updated_at TIMESTAMP DEFAULT NOW()
+);
+
+CREATE TRIGGER set_timestamp
+BEFORE UPDATE ON financing_plans
+FOR EACH ROW
+EXECUTE PROCEDURE trigger_set_timestamp();
+
+-- Terms of the financing of a sale, copied from its plan. A zero
+-- financing_months means the sale is not financed.
+ALTER TABLE sales
+    ADD COLUMN financing_plan_id INT NOT NULL DEFAULT 0,
+    ADD COLUMN financing_amortization_system TEXT NOT NULL DEFAULT '',
+    ADD COLUMN financing_monthly_interest_rate DECIMAL(7,4) NOT NULL DEFAULT 0,
+    ADD COLUMN financing_down_payment DECIMAL(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN financing_principal DECIMAL(12,2) NOT NULL DEFAULT 0,
+    ADD COLUMN financing_months INT NOT NULL DEFAULT 0;
+
+-- Amortization table of a financed sale, paid_at being set as the payments
+-- platform reports each installment paid.
+CREATE TABLE IF NOT EXISTS sale_installments (
+    sale_id INT NOT NULL REFERENCES sales (id) ON DELETE CASCADE,
+    number INT NOT NULL,
+    due_date DATE NOT NULL,
+    amount DECIMAL(12,2) NOT NULL,
+    interest DECIMAL(12,2) NOT NULL,
+    amortization DECIMAL(12,2) NOT NULL,
+    balance DECIMAL(12,2) NOT NULL,
+    paid_at TIMESTAMP,
+
+    PRIMARY KEY (sale_id, number)
+);
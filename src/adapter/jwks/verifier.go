@@ -0,0 +1,125 @@
+package jwks
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+// leeway tolerates clock skew between the issuer and this service.
+const leeway = 30 * time.Second
+
+var signatureAlgorithms = []jose.SignatureAlgorithm{
+	jose.RS256, jose.RS384, jose.RS512,
+	jose.PS256, jose.PS384, jose.PS512,
+	jose.ES256, jose.ES384, jose.ES512,
+	jose.EdDSA,
+}
+
+// claims are the private claims of the token. DealerID binds the caller to a
+// dealer and is left out for platform wide callers.
+type claims struct {
+	Roles    []string `json:"roles"`
+	DealerID int      `json:"dealer_id"`
+}
+
+type tokenVerifier struct {
+	keys          jose.JSONWebKeySet
+	issuer        string
+	audience      string
+	timeGenerator func() time.Time
+}
+
+// NewTokenVerifier reads the key set once. Rotating keys means publishing the
+// new key in the file and restarting, before tokens are signed with it.
+func NewTokenVerifier(path, issuer, audience string, timeGenerator func() time.Time) (interfaces.TokenVerifier, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("read jwks file: %w", err)
+	}
+
+	var keys jose.JSONWebKeySet
+	if err = json.Unmarshal(content, &keys); err != nil {
+		return nil, fmt.Errorf("parse jwks file: %w", err)
+	}
+
+	if len(keys.Keys) == 0 {
+		return nil, fmt.Errorf("jwks file %s has no keys", path)
+	}
+
+	for _, key := range keys.Keys {
+		if !key.IsPublic() {
+			return nil, fmt.Errorf("jwks file %s holds a private key (kid %q)", path, key.KeyID)
+		}
+	}
+
+	return &tokenVerifier{
+		keys:          keys,
+		issuer:        issuer,
+		audience:      audience,
+		timeGenerator: timeGenerator,
+	}, nil
+}
+
+// Verify requires a signature from a key of the set, the configured issuer and
+// audience, an expiry and a subject. Roles this service does not know are
+// ignored, as the same token may be used with other services.
+func (ref *tokenVerifier) Verify(ctx context.Context, token string) (*entity.Principal, error) {
+	parsed, err := jwt.ParseSigned(token, signatureAlgorithms)
+	if err != nil {
+		return nil, err
+	}
+
+	var registered jwt.Claims
+	var custom claims
+
+	if err = parsed.Claims(ref.keys, &registered, &custom); err != nil {
+		return nil, err
+	}
+
+	if registered.Expiry == nil {
+		return nil, errors.New("token has no expiry")
+	}
+
+	if registered.Subject == "" {
+		return nil, errors.New("token has no subject")
+	}
+
+	expected := jwt.Expected{
+		Issuer:      ref.issuer,
+		AnyAudience: jwt.Audience{ref.audience},
+		Time:        ref.timeGenerator(),
+	}
+
+	if err = registered.ValidateWithLeeway(expected, leeway); err != nil {
+		return nil, err
+	}
+
+	roles := make([]valueobjects.Role, 0, len(custom.Roles))
+
+	for _, value := range custom.Roles {
+		role, err := valueobjects.ParseRole(value)
+		if err != nil {
+			logger.FromContext(ctx).DebugContext(ctx, "ignoring token role", "role", value)
+			continue
+		}
+		roles = append(roles, role)
+	}
+
+	return &entity.Principal{
+		Subject:  registered.Subject,
+		DealerID: custom.DealerID,
+		Roles:    roles,
+	}, nil
+}
@@ -0,0 +1,166 @@
+package jwks
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/go-jose/go-jose/v4"
+	"github.com/go-jose/go-jose/v4/jwt"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+const (
+	issuer   = "https://auth.example.com"
+	audience = "vehicle-platform-sales"
+)
+
+func TestVerify(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Now()
+
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.Nil(t, err)
+
+	signingKey := jose.JSONWebKey{Key: privateKey, KeyID: "key-1", Algorithm: string(jose.ES256)}
+
+	path := writeKeySet(t, signingKey.Public())
+
+	verifier, err := NewTokenVerifier(path, issuer, audience, func() time.Time { return now })
+	require.Nil(t, err)
+
+	sign := func(claims jwt.Claims, roles []string, private ...map[string]any) string {
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: signingKey}, nil)
+		require.Nil(t, err)
+
+		builder := jwt.Signed(signer).Claims(claims).Claims(map[string]any{"roles": roles})
+		for _, values := range private {
+			builder = builder.Claims(values)
+		}
+
+		token, err := builder.Serialize()
+		require.Nil(t, err)
+
+		return token
+	}
+
+	validClaims := func() jwt.Claims {
+		return jwt.Claims{
+			Issuer:   issuer,
+			Subject:  "user-1",
+			Audience: jwt.Audience{audience},
+			Expiry:   jwt.NewNumericDate(now.Add(time.Hour)),
+		}
+	}
+
+	t.Run("should verify token keeping known roles", func(t *testing.T) {
+		token := sign(validClaims(), []string{"buyer", "unknown"})
+
+		actual, err := verifier.Verify(ctx, token)
+
+		require.Nil(t, err)
+		assert.Equal(t, "user-1", actual.Subject)
+		assert.Equal(t, []valueobjects.Role{valueobjects.RoleBuyer}, actual.Roles)
+		assert.Zero(t, actual.DealerID)
+	})
+
+	t.Run("should verify token bound to a dealer", func(t *testing.T) {
+		token := sign(validClaims(), []string{"dealer_staff"}, map[string]any{"dealer_id": 7})
+
+		actual, err := verifier.Verify(ctx, token)
+
+		require.Nil(t, err)
+		assert.Equal(t, 7, actual.DealerID)
+		assert.Equal(t, []valueobjects.Role{valueobjects.RoleDealerStaff}, actual.Roles)
+	})
+
+	t.Run("should not verify expired token", func(t *testing.T) {
+		claims := validClaims()
+		claims.Expiry = jwt.NewNumericDate(now.Add(-time.Hour))
+
+		actual, err := verifier.Verify(ctx, sign(claims, nil))
+
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should not verify token without expiry", func(t *testing.T) {
+		claims := validClaims()
+		claims.Expiry = nil
+
+		actual, err := verifier.Verify(ctx, sign(claims, nil))
+
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should not verify token for another audience", func(t *testing.T) {
+		claims := validClaims()
+		claims.Audience = jwt.Audience{"another-service"}
+
+		actual, err := verifier.Verify(ctx, sign(claims, nil))
+
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should not verify token signed by unknown key", func(t *testing.T) {
+		otherKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.Nil(t, err)
+
+		signer, err := jose.NewSigner(jose.SigningKey{Algorithm: jose.ES256, Key: jose.JSONWebKey{Key: otherKey, KeyID: "key-1"}}, nil)
+		require.Nil(t, err)
+
+		token, err := jwt.Signed(signer).Claims(validClaims()).Serialize()
+		require.Nil(t, err)
+
+		actual, err := verifier.Verify(ctx, token)
+
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+	})
+}
+
+func TestNewTokenVerifier(t *testing.T) {
+	t.Run("should not load key set with private key", func(t *testing.T) {
+		privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+		require.Nil(t, err)
+
+		path := writeKeySet(t, jose.JSONWebKey{Key: privateKey, KeyID: "key-1"})
+
+		actual, err := NewTokenVerifier(path, issuer, audience, time.Now)
+
+		assert.Nil(t, actual)
+		assert.ErrorContains(t, err, "private key")
+	})
+
+	t.Run("should not load empty key set", func(t *testing.T) {
+		path := writeKeySet(t)
+
+		actual, err := NewTokenVerifier(path, issuer, audience, time.Now)
+
+		assert.Nil(t, actual)
+		assert.ErrorContains(t, err, "has no keys")
+	})
+}
+
+func writeKeySet(t *testing.T, keys ...jose.JSONWebKey) string {
+	t.Helper()
+
+	content, err := json.Marshal(jose.JSONWebKeySet{Keys: keys})
+	require.Nil(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.Nil(t, os.WriteFile(path, content, 0o600))
+
+	return path
+}
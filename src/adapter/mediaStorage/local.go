@@ -0,0 +1,98 @@
+package mediastorage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+
+	"github.com/google/uuid"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+)
+
+// localStorage keeps media in a directory of the local filesystem. Files are
+// written under a temporary name and renamed once complete, so readers never
+// see a partial file, and every key is resolved inside the directory.
+type localStorage struct {
+	root *os.Root
+}
+
+func NewLocalStorage(dir string) (interfaces.MediaStorage, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	root, err := os.OpenRoot(dir)
+	if err != nil {
+		return nil, err
+	}
+
+	return &localStorage{
+		root: root,
+	}, nil
+}
+
+func (ref *localStorage) Put(_ context.Context, key string, content io.Reader) error {
+	name, err := localName(key)
+	if err != nil {
+		return err
+	}
+
+	if err = ref.root.MkdirAll(path.Dir(name), 0o755); err != nil {
+		return err
+	}
+
+	temporary := name + "." + uuid.NewString() + ".tmp"
+
+	file, err := ref.root.OpenFile(temporary, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0o644)
+	if err != nil {
+		return err
+	}
+
+	_, err = io.Copy(file, content)
+	if closeErr := file.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		ref.root.Remove(temporary)
+		return err
+	}
+
+	return ref.root.Rename(temporary, name)
+}
+
+func (ref *localStorage) Open(_ context.Context, key string) (io.ReadCloser, error) {
+	name, err := localName(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return ref.root.Open(name)
+}
+
+func (ref *localStorage) Delete(_ context.Context, key string) error {
+	name, err := localName(key)
+	if err != nil {
+		return err
+	}
+
+	if err = ref.root.Remove(name); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return err
+	}
+
+	return nil
+}
+
+func localName(key string) (string, error) {
+	if !filepath.IsLocal(filepath.FromSlash(key)) {
+		return "", fmt.Errorf("invalid media key %q", key)
+	}
+
+	return path.Clean(key), nil
+}
@@ -0,0 +1,62 @@
+package mediastorage
+
+import (
+	"context"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLocalStorage(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("should put, open and delete files by key", func(t *testing.T) {
+		dir := t.TempDir()
+
+		storage, err := NewLocalStorage(dir)
+		require.Nil(t, err)
+
+		err = storage.Put(ctx, "vehicles/1/photo.jpg", strings.NewReader("content"))
+		require.Nil(t, err)
+
+		file, err := storage.Open(ctx, "vehicles/1/photo.jpg")
+		require.Nil(t, err)
+
+		content, err := io.ReadAll(file)
+		file.Close()
+
+		assert.Nil(t, err)
+		assert.Equal(t, "content", string(content))
+
+		entries, err := os.ReadDir(filepath.Join(dir, "vehicles", "1"))
+		require.Nil(t, err)
+		assert.Len(t, entries, 1)
+
+		assert.Nil(t, storage.Delete(ctx, "vehicles/1/photo.jpg"))
+
+		_, err = storage.Open(ctx, "vehicles/1/photo.jpg")
+		assert.ErrorIs(t, err, fs.ErrNotExist)
+	})
+
+	t.Run("should delete missing file", func(t *testing.T) {
+		storage, err := NewLocalStorage(t.TempDir())
+		require.Nil(t, err)
+
+		assert.Nil(t, storage.Delete(ctx, "vehicles/1/photo.jpg"))
+	})
+
+	t.Run("should not reach outside of the directory", func(t *testing.T) {
+		storage, err := NewLocalStorage(t.TempDir())
+		require.Nil(t, err)
+
+		for _, key := range []string{"../photo.jpg", "/etc/passwd", "vehicles/../../photo.jpg", ""} {
+			assert.NotNil(t, storage.Put(ctx, key, strings.NewReader("content")), key)
+		}
+	})
+}
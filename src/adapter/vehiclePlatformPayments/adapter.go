@@ -5,6 +5,7 @@ import (
 
 	"github.com/caiiomp/vehicle-platform-sales/src/adapter/vehiclePlatformPayments/http"
 	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
 )
 
 type vehiclePlatformPaymentsAdapter struct {
@@ -17,6 +18,21 @@ func NewVehiclePlatformPaymentsAdapter(httpClient http.VehiclePlatformPaymentsHt
 	}
 }
 
-func (ref *vehiclePlatformPaymentsAdapter) GeneratePayment(ctx context.Context, amount float64, status string) (string, error) {
-	return ref.httpClient.GeneratePayment(ctx, amount, status)
+func (ref *vehiclePlatformPaymentsAdapter) GeneratePayment(ctx context.Context, payment entity.Payment) (string, error) {
+	var installments []http.Installment
+
+	for _, installment := range payment.Installments {
+		installments = append(installments, http.Installment{
+			Number:  installment.Number,
+			DueDate: installment.DueDate,
+			Amount:  installment.Amount,
+		})
+	}
+
+	return ref.httpClient.GeneratePayment(ctx, http.Payment{
+		Amount:       payment.Amount,
+		Status:       payment.Status,
+		DownPayment:  payment.DownPayment,
+		Installments: installments,
+	})
 }
@@ -3,30 +3,58 @@ package vehicleplatformpayments
 import (
 	"context"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 
+	"github.com/caiiomp/vehicle-platform-sales/src/adapter/vehiclePlatformPayments/http"
 	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
 )
 
 func TestGeneratePayment(t *testing.T) {
 	ctx := context.TODO()
-	amount := float64(50000)
-	status := "APPROVED"
 	paymentID := uuid.NewString()
 
-	httpClientMocked := mocks.NewVehiclePlatformPaymentsHttpClient(t)
+	t.Run("should generate payment", func(t *testing.T) {
+		httpClientMocked := mocks.NewVehiclePlatformPaymentsHttpClient(t)
 
-	httpClientMocked.On("GeneratePayment", ctx, amount, status).
-		Return(paymentID, nil)
+		httpClientMocked.On("GeneratePayment", ctx, http.Payment{Amount: 50000, Status: "APPROVED"}).
+			Return(paymentID, nil)
 
-	adapter := NewVehiclePlatformPaymentsAdapter(httpClientMocked)
+		adapter := NewVehiclePlatformPaymentsAdapter(httpClientMocked)
 
-	expected := paymentID
+		actual, err := adapter.GeneratePayment(ctx, entity.Payment{Amount: 50000, Status: "APPROVED"})
 
-	actual, err := adapter.GeneratePayment(ctx, amount, status)
+		assert.Equal(t, paymentID, actual)
+		assert.Nil(t, err)
+	})
 
-	assert.Equal(t, expected, actual)
-	assert.Nil(t, err)
+	t.Run("should generate payment with installments", func(t *testing.T) {
+		dueDate := time.Date(2026, time.November, 19, 0, 0, 0, 0, time.UTC)
+
+		httpClientMocked := mocks.NewVehiclePlatformPaymentsHttpClient(t)
+
+		httpClientMocked.On("GeneratePayment", ctx, http.Payment{
+			Amount:       50000,
+			Status:       "APPROVED",
+			DownPayment:  10000,
+			Installments: []http.Installment{{Number: 1, DueDate: dueDate, Amount: 40400}},
+		}).Return(paymentID, nil)
+
+		adapter := NewVehiclePlatformPaymentsAdapter(httpClientMocked)
+
+		actual, err := adapter.GeneratePayment(ctx, entity.Payment{
+			Amount:      50000,
+			Status:      "APPROVED",
+			DownPayment: 10000,
+			Installments: []entity.Installment{
+				{Number: 1, DueDate: dueDate, Amount: 40400, Interest: 400, Amortization: 40000},
+			},
+		})
+
+		assert.Equal(t, paymentID, actual)
+		assert.Nil(t, err)
+	})
 }
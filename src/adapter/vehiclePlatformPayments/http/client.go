@@ -7,10 +7,19 @@ import (
 	"fmt"
 	"io"
 	"net/http"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+	"github.com/caiiomp/vehicle-platform-sales/src/metrics"
 )
 
+// requestIDHeader forwards the ID of the request that triggered the payment,
+// so it can be traced through vehicle platform payments and back in the webhook.
+const requestIDHeader = "X-Request-ID"
+
 type VehiclePlatformPaymentsHttpClient interface {
-	GeneratePayment(ctx context.Context, amount float64, status string) (string, error)
+	GeneratePayment(ctx context.Context, payment Payment) (string, error)
+	Ping(ctx context.Context) error
 }
 
 type vehiclePlatformPaymentsHttpClient struct {
@@ -27,34 +36,58 @@ func NewVehiclePlatformSalesHttpClient(client *http.Client, vehiclePlatformPayme
 	}
 }
 
-func (ref *vehiclePlatformPaymentsHttpClient) GeneratePayment(ctx context.Context, amount float64, status string) (string, error) {
+// GeneratePayment sends the installments of a financed payment along with the
+// webhook that reports each one paid.
+func (ref *vehiclePlatformPaymentsHttpClient) GeneratePayment(ctx context.Context, payment Payment) (string, error) {
 	url := ref.vehiclePlatformPaymentsHost + "/payments"
 	webhookUrl := ref.vehiclePlatformSalesHost + "/sales/webhook"
 
-	payment := createPaymentRequest{
-		WebhookUrl: webhookUrl,
-		Amount:     amount,
-		Status:     status,
+	body := createPaymentRequest{
+		WebhookUrl:  webhookUrl,
+		Amount:      payment.Amount,
+		Status:      payment.Status,
+		DownPayment: payment.DownPayment,
+	}
+
+	if len(payment.Installments) > 0 {
+		body.InstallmentsWebhookUrl = ref.vehiclePlatformSalesHost + "/sales/installments/webhook"
+
+		for _, installment := range payment.Installments {
+			body.Installments = append(body.Installments, installmentRequest{
+				Number:  installment.Number,
+				DueDate: installment.DueDate.Format(time.DateOnly),
+				Amount:  installment.Amount,
+			})
+		}
 	}
 
-	data, err := json.Marshal(payment)
+	data, err := json.Marshal(body)
 	if err != nil {
 		return "", err
 	}
 
-	request, err := http.NewRequest(http.MethodPost, url, bytes.NewBuffer(data))
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewBuffer(data))
 	if err != nil {
 		return "", err
 	}
 
 	request.Header.Set("Content-Type", "application/json")
 
+	if requestID := logger.RequestIDFromContext(ctx); requestID != "" {
+		request.Header.Set(requestIDHeader, requestID)
+	}
+
+	startedAt := time.Now()
+
 	response, err := ref.client.Do(request)
 	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "failed to call vehicle platform payments", "error", err, "latency_ms", time.Since(startedAt).Milliseconds())
 		return "", err
 	}
 	defer response.Body.Close()
 
+	logger.FromContext(ctx).InfoContext(ctx, "vehicle platform payments answered", "status", response.StatusCode, "latency_ms", time.Since(startedAt).Milliseconds())
+
 	rawResponse, err := io.ReadAll(response.Body)
 	if err != nil {
 		return "", err
@@ -71,3 +104,29 @@ func (ref *vehiclePlatformPaymentsHttpClient) GeneratePayment(ctx context.Contex
 
 	return createPaymentResponse.PaymentID, nil
 }
+
+// Ping checks that vehicle platform payments is reachable. Any answer below
+// 500 means the service is up, even if it does not expose the root path. The
+// check stays out of the payments metrics.
+func (ref *vehiclePlatformPaymentsHttpClient) Ping(ctx context.Context) error {
+	request, err := http.NewRequestWithContext(metrics.HealthProbe(ctx), http.MethodGet, ref.vehiclePlatformPaymentsHost, nil)
+	if err != nil {
+		return err
+	}
+
+	response, err := ref.client.Do(request)
+	if err != nil {
+		return err
+	}
+	defer response.Body.Close()
+
+	if _, err = io.Copy(io.Discard, response.Body); err != nil {
+		return err
+	}
+
+	if response.StatusCode >= http.StatusInternalServerError {
+		return fmt.Errorf("vehicle platform payments answered with status %d", response.StatusCode)
+	}
+
+	return nil
+}
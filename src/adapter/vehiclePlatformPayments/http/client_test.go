@@ -0,0 +1,115 @@
+package http
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+func TestGeneratePayment(t *testing.T) {
+	paymentID := uuid.NewString()
+
+	t.Run("should generate payment forwarding the request id", func(t *testing.T) {
+		var (
+			receivedRequestID string
+			receivedPayment   createPaymentRequest
+		)
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			receivedRequestID = r.Header.Get(requestIDHeader)
+			json.NewDecoder(r.Body).Decode(&receivedPayment)
+			json.NewEncoder(w).Encode(createPaymentResponse{PaymentID: paymentID})
+		}))
+		defer server.Close()
+
+		client := NewVehiclePlatformSalesHttpClient(server.Client(), server.URL, "http://vehicle-platform-sales")
+
+		ctx := logger.WithRequestID(context.TODO(), "some-request-id")
+
+		actual, err := client.GeneratePayment(ctx, Payment{Amount: 50000, Status: "APPROVED"})
+
+		assert.Equal(t, paymentID, actual)
+		assert.Nil(t, err)
+		assert.Equal(t, "some-request-id", receivedRequestID)
+		assert.Equal(t, "http://vehicle-platform-sales/sales/webhook", receivedPayment.WebhookUrl)
+		assert.Equal(t, float64(50000), receivedPayment.Amount)
+		assert.Empty(t, receivedPayment.Installments)
+		assert.Empty(t, receivedPayment.InstallmentsWebhookUrl)
+	})
+
+	t.Run("should send installments of financed payment", func(t *testing.T) {
+		var receivedPayment createPaymentRequest
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			json.NewDecoder(r.Body).Decode(&receivedPayment)
+			json.NewEncoder(w).Encode(createPaymentResponse{PaymentID: paymentID})
+		}))
+		defer server.Close()
+
+		client := NewVehiclePlatformSalesHttpClient(server.Client(), server.URL, "http://vehicle-platform-sales")
+
+		actual, err := client.GeneratePayment(context.TODO(), Payment{
+			Amount:      50000,
+			Status:      "APPROVED",
+			DownPayment: 10000,
+			Installments: []Installment{
+				{Number: 1, DueDate: time.Date(2026, time.November, 19, 0, 0, 0, 0, time.UTC), Amount: 20300},
+				{Number: 2, DueDate: time.Date(2026, time.December, 19, 0, 0, 0, 0, time.UTC), Amount: 20150},
+			},
+		})
+
+		assert.Equal(t, paymentID, actual)
+		assert.Nil(t, err)
+		assert.Equal(t, float64(10000), receivedPayment.DownPayment)
+		assert.Equal(t, "http://vehicle-platform-sales/sales/installments/webhook", receivedPayment.InstallmentsWebhookUrl)
+		assert.Equal(t, []installmentRequest{
+			{Number: 1, DueDate: "2026-11-19", Amount: 20300},
+			{Number: 2, DueDate: "2026-12-19", Amount: 20150},
+		}, receivedPayment.Installments)
+	})
+
+	t.Run("should not generate payment when payments answers with error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			w.Write([]byte("invalid amount"))
+		}))
+		defer server.Close()
+
+		client := NewVehiclePlatformSalesHttpClient(server.Client(), server.URL, "http://vehicle-platform-sales")
+
+		actual, err := client.GeneratePayment(context.TODO(), Payment{Status: "APPROVED"})
+
+		assert.Empty(t, actual)
+		assert.ErrorContains(t, err, "invalid amount")
+	})
+}
+
+func TestPing(t *testing.T) {
+	t.Run("should ping successfully", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		defer server.Close()
+
+		client := NewVehiclePlatformSalesHttpClient(server.Client(), server.URL, "")
+
+		assert.Nil(t, client.Ping(context.TODO()))
+	})
+
+	t.Run("should not ping when payments answers with server error", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		client := NewVehiclePlatformSalesHttpClient(server.Client(), server.URL, "")
+
+		assert.ErrorContains(t, client.Ping(context.TODO()), "status 502")
+	})
+}
@@ -1,9 +1,35 @@
 package http
 
+import "time"
+
+// Payment is what vehicle platform payments is asked to charge. A financed
+// payment charges the DownPayment at once and the rest in its Installments.
+type Payment struct {
+	Amount       float64
+	Status       string
+	DownPayment  float64
+	Installments []Installment
+}
+
+type Installment struct {
+	Number  int
+	DueDate time.Time
+	Amount  float64
+}
+
 type createPaymentRequest struct {
-	WebhookUrl string  `json:"webhook_url"`
-	Amount     float64 `json:"amount"`
-	Status     string  `json:"status"`
+	WebhookUrl             string               `json:"webhook_url"`
+	Amount                 float64              `json:"amount"`
+	Status                 string               `json:"status"`
+	DownPayment            float64              `json:"down_payment,omitempty"`
+	Installments           []installmentRequest `json:"installments,omitempty"`
+	InstallmentsWebhookUrl string               `json:"installments_webhook_url,omitempty"`
+}
+
+type installmentRequest struct {
+	Number  int     `json:"number"`
+	DueDate string  `json:"due_date"`
+	Amount  float64 `json:"amount"`
 }
 
 type createPaymentResponse struct {
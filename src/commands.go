@@ -0,0 +1,281 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/config"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/useCases/auth"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/useCases/dealer"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/migrator"
+)
+
+const (
+	migrateUsage = "usage: vehicle-platform-sales migrate up|down [steps]|status|version"
+	configUsage  = "usage: vehicle-platform-sales config print"
+	apiKeyUsage  = "usage: vehicle-platform-sales apikey create <name> <role>[,<role>...] [dealer_id]|list|revoke <id>"
+	dealerUsage  = "usage: vehicle-platform-sales dealer create <name>|list"
+)
+
+// exitWithError prints command errors as plain text, since usage messages are
+// meant to be read by a person rather than a log collector.
+func exitWithError(err error) {
+	fmt.Fprintln(os.Stderr, err)
+	os.Exit(1)
+}
+
+// runConfigCommand runs without a database so a broken configuration can
+// still be inspected.
+func runConfigCommand(cfg *config.Config, args []string) error {
+	if len(args) == 0 || args[0] != "print" {
+		return errors.New(configUsage)
+	}
+
+	if err := cfg.Print(os.Stdout); err != nil {
+		return err
+	}
+
+	if err := cfg.Validate(); err != nil {
+		fmt.Fprintf(os.Stderr, "\nconfig is invalid:\n%s\n", err)
+	}
+
+	return nil
+}
+
+func runCommand(ctx context.Context, storage *storage, args []string) error {
+	switch args[0] {
+	case "migrate":
+		return runMigrateCommand(ctx, storage.migrator, args[1:])
+	case "apikey":
+		return runAPIKeyCommand(tenant.WithAllDealers(ctx), storage, args[1:])
+	case "dealer":
+		return runDealerCommand(tenant.WithAllDealers(ctx), storage, args[1:])
+	default:
+		return fmt.Errorf("unknown command %q\n%s\n%s\n%s\n%s", args[0], migrateUsage, configUsage, apiKeyUsage, dealerUsage)
+	}
+}
+
+func runMigrateCommand(ctx context.Context, schemaMigrator *migrator.Migrator, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+
+	if schemaMigrator == nil {
+		return fmt.Errorf("migrations require STORAGE=%s", config.StoragePostgres)
+	}
+
+	switch args[0] {
+	case "up":
+		err := schemaMigrator.Up(ctx)
+		if errors.Is(err, migrator.ErrNothingToDo) {
+			fmt.Println("no change")
+			return nil
+		}
+		return err
+
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			var err error
+			if steps, err = strconv.Atoi(args[1]); err != nil || steps < 1 {
+				return fmt.Errorf("invalid number of steps %q\n%s", args[1], migrateUsage)
+			}
+		}
+
+		err := schemaMigrator.Down(ctx, steps)
+		if errors.Is(err, migrator.ErrNothingToDo) {
+			fmt.Println("no change")
+			return nil
+		}
+		return err
+
+	case "status":
+		status, err := schemaMigrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+
+		for _, migration := range status {
+			state := "pending"
+			if migration.Applied {
+				state = "applied"
+			}
+			fmt.Printf("%06d_%s\t%s\n", migration.Version, migration.Name, state)
+		}
+		return nil
+
+	case "version":
+		version, dirty, err := schemaMigrator.Version(ctx)
+		if err != nil {
+			return err
+		}
+
+		if dirty {
+			fmt.Printf("%d (dirty)\n", version)
+		} else {
+			fmt.Println(version)
+		}
+		fmt.Printf("expected: %d\n", schemaMigrator.Latest())
+		return nil
+
+	default:
+		return fmt.Errorf("unknown migrate command %q\n%s", args[0], migrateUsage)
+	}
+}
+
+// runAPIKeyCommand manages API keys straight in the storage, which is how the
+// first admin key is created when no bootstrap key is configured.
+func runAPIKeyCommand(ctx context.Context, storage *storage, args []string) error {
+	if len(args) == 0 {
+		return errors.New(apiKeyUsage)
+	}
+
+	if storage.migrator != nil {
+		if err := storage.migrator.Check(ctx); err != nil {
+			return err
+		}
+	}
+
+	authService := auth.NewAuthService(storage.apiKeyRepository, nil, timeGenerator)
+
+	switch args[0] {
+	case "create":
+		if len(args) != 3 && len(args) != 4 {
+			return errors.New(apiKeyUsage)
+		}
+
+		var roles []valueobjects.Role
+		for _, value := range strings.Split(args[2], ",") {
+			role, err := valueobjects.ParseRole(value)
+			if err != nil {
+				return fmt.Errorf("%w\n%s", err, apiKeyUsage)
+			}
+			roles = append(roles, role)
+		}
+
+		dealerID := 0
+		if len(args) == 4 {
+			var err error
+			if dealerID, err = strconv.Atoi(args[3]); err != nil || dealerID < 1 {
+				return fmt.Errorf("invalid dealer id %q\n%s", args[3], apiKeyUsage)
+			}
+		}
+
+		apiKey, key, err := authService.CreateAPIKey(ctx, args[1], dealerID, roles)
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("id: %d\nkey: %s\n", apiKey.ID, key)
+		fmt.Fprintln(os.Stderr, "the key is not stored and can not be shown again")
+		return nil
+
+	case "list":
+		apiKeys, err := authService.SearchAPIKeys(ctx)
+		if err != nil {
+			return err
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "ID\tNAME\tDEALER\tPREFIX\tROLES\tCREATED\tREVOKED")
+		for _, apiKey := range apiKeys {
+			revoked := "-"
+			if apiKey.RevokedAt != nil {
+				revoked = apiKey.RevokedAt.Format(time.RFC3339)
+			}
+
+			roles := make([]string, len(apiKey.Roles))
+			for i, role := range apiKey.Roles {
+				roles[i] = role.String()
+			}
+
+			dealerID := "-"
+			if apiKey.DealerID != 0 {
+				dealerID = strconv.Itoa(apiKey.DealerID)
+			}
+
+			fmt.Fprintf(writer, "%d\t%s\t%s\t%s\t%s\t%s\t%s\n", apiKey.ID, apiKey.Name, dealerID, apiKey.Prefix, strings.Join(roles, ","), apiKey.CreatedAt.Format(time.RFC3339), revoked)
+		}
+		return writer.Flush()
+
+	case "revoke":
+		if len(args) != 2 {
+			return errors.New(apiKeyUsage)
+		}
+
+		id, err := strconv.Atoi(args[1])
+		if err != nil {
+			return fmt.Errorf("invalid api key id %q\n%s", args[1], apiKeyUsage)
+		}
+
+		apiKey, err := authService.RevokeAPIKey(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if apiKey == nil {
+			return fmt.Errorf("api key %d does not exist", id)
+		}
+
+		fmt.Println("revoked")
+		return nil
+
+	default:
+		return fmt.Errorf("unknown apikey command %q\n%s", args[0], apiKeyUsage)
+	}
+}
+
+// runDealerCommand creates the dealers the platform admin hands API keys to.
+func runDealerCommand(ctx context.Context, storage *storage, args []string) error {
+	if len(args) == 0 {
+		return errors.New(dealerUsage)
+	}
+
+	if storage.migrator != nil {
+		if err := storage.migrator.Check(ctx); err != nil {
+			return err
+		}
+	}
+
+	dealerService := dealer.NewDealerService(storage.dealerRepository)
+
+	switch args[0] {
+	case "create":
+		if len(args) != 2 {
+			return errors.New(dealerUsage)
+		}
+
+		created, err := dealerService.Create(ctx, entity.Dealer{Name: args[1]})
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("id: %d\n", created.ID)
+		return nil
+
+	case "list":
+		dealers, err := dealerService.Search(ctx)
+		if err != nil {
+			return err
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(writer, "ID\tNAME\tCREATED")
+		for _, item := range dealers {
+			fmt.Fprintf(writer, "%d\t%s\t%s\n", item.ID, item.Name, item.CreatedAt.Format(time.RFC3339))
+		}
+		return writer.Flush()
+
+	default:
+		return fmt.Errorf("unknown dealer command %q\n%s", args[0], dealerUsage)
+	}
+}
@@ -0,0 +1,353 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/financing"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+const (
+	EnvironmentProd  = "PROD"
+	EnvironmentLocal = "LOCAL"
+
+	StoragePostgres = "postgres"
+	StorageMemory   = "memory"
+	StorageMongo    = "mongo"
+
+	MediaStorageLocal = "local"
+
+	RateLimitStoreMemory   = "memory"
+	RateLimitStorePostgres = "postgres"
+)
+
+// Config is the effective configuration of the service. Each leaf field is
+// resolved from, in order of precedence: the process environment, the .env
+// file, the optional config file (CONFIG_FILE, YAML or TOML) and the default.
+type Config struct {
+	Environment string `env:"ENVIRONMENT" file:"environment" default:"LOCAL"`
+	LogLevel    string `env:"LOG_LEVEL" file:"log_level" default:"info"`
+	Storage     string `env:"STORAGE" file:"storage" default:"postgres"`
+	API         API
+	Database    Database
+	Mongo       Mongo
+	Payments    Payments
+	Tracing     Tracing
+	Auth        Auth
+	RateLimit   RateLimit
+	Cache       Cache
+	Media       Media
+	Offers      Offers
+	Financing   Financing
+}
+
+type API struct {
+	Port               string        `env:"API_PORT" file:"api.port" default:"8080"`
+	PublicHost         string        `env:"VEHICLE_PLATFORM_SALES_HOST" file:"api.public_host"`
+	ReadHeaderTimeout  time.Duration `env:"API_READ_HEADER_TIMEOUT" file:"api.read_header_timeout" default:"5s"`
+	ShutdownTimeout    time.Duration `env:"API_SHUTDOWN_TIMEOUT" file:"api.shutdown_timeout" default:"15s"`
+	HealthCheckTimeout time.Duration `env:"API_HEALTH_CHECK_TIMEOUT" file:"api.health_check_timeout" default:"2s"`
+}
+
+type Database struct {
+	InstanceConnectionName string        `env:"INSTANCE_CONNECTION_NAME" file:"database.instance_connection_name"`
+	Host                   string        `env:"DB_HOST" file:"database.host"`
+	Port                   string        `env:"DB_PORT" file:"database.port" default:"5432"`
+	User                   string        `env:"DB_USER" file:"database.user"`
+	Password               string        `env:"DB_PASSWORD" file:"database.password" secret:"true"`
+	Name                   string        `env:"DB_NAME" file:"database.name"`
+	MigrateOnStartup       bool          `env:"MIGRATE_ON_STARTUP" file:"database.migrate_on_startup" default:"false"`
+	MaxOpenConns           int           `env:"DB_MAX_OPEN_CONNS" file:"database.max_open_conns" default:"10"`
+	MaxIdleConns           int           `env:"DB_MAX_IDLE_CONNS" file:"database.max_idle_conns" default:"5"`
+	ConnMaxLifetime        time.Duration `env:"DB_CONN_MAX_LIFETIME" file:"database.conn_max_lifetime" default:"30m"`
+	ConnMaxIdleTime        time.Duration `env:"DB_CONN_MAX_IDLE_TIME" file:"database.conn_max_idle_time" default:"5m"`
+	ConnectTimeout         time.Duration `env:"DB_CONNECT_TIMEOUT" file:"database.connect_timeout" default:"5s"`
+	StatementTimeout       time.Duration `env:"DB_STATEMENT_TIMEOUT" file:"database.statement_timeout" default:"0s"`
+	StatementCacheCapacity int           `env:"DB_STATEMENT_CACHE_CAPACITY" file:"database.statement_cache_capacity" default:"512"`
+}
+
+type Mongo struct {
+	URI      string `env:"MONGO_URI" file:"mongo.uri" secret:"true"`
+	Database string `env:"MONGO_DATABASE" file:"mongo.database" default:"vehicle-platform-sales"`
+}
+
+type Payments struct {
+	Host    string        `env:"VEHICLE_PLATFORM_PAYMENTS_HOST" file:"payments.host"`
+	Timeout time.Duration `env:"VEHICLE_PLATFORM_PAYMENTS_TIMEOUT" file:"payments.timeout" default:"3s"`
+}
+
+type Tracing struct {
+	Exporter    string  `env:"TRACING_EXPORTER" file:"tracing.exporter" default:"none"`
+	Endpoint    string  `env:"TRACING_OTLP_ENDPOINT" file:"tracing.otlp_endpoint"`
+	ServiceName string  `env:"OTEL_SERVICE_NAME" file:"tracing.service_name" default:"vehicle-platform-sales"`
+	SampleRatio float64 `env:"TRACING_SAMPLE_RATIO" file:"tracing.sample_ratio" default:"1"`
+}
+
+// Auth configures the bearer tokens accepted besides API keys, disabled while
+// JWKSFile is empty, and an optional admin key stored on startup so a new
+// deployment can create the other keys.
+type Auth struct {
+	JWKSFile          string `env:"AUTH_JWKS_FILE" file:"auth.jwks_file"`
+	Issuer            string `env:"AUTH_JWT_ISSUER" file:"auth.jwt_issuer"`
+	Audience          string `env:"AUTH_JWT_AUDIENCE" file:"auth.jwt_audience" default:"vehicle-platform-sales"`
+	BootstrapAdminKey string `env:"AUTH_BOOTSTRAP_ADMIN_KEY" file:"auth.bootstrap_admin_key" secret:"true"`
+}
+
+// RateLimit configures the token buckets of the buy and webhook routes, kept
+// per client IP, per API key or token subject and, on buy, per buyer
+// document. A group with zero requests per minute is not limited, and neither
+// is the buy concurrency per vehicle when zero.
+type RateLimit struct {
+	Store                    string `env:"RATE_LIMIT_STORE" file:"rate_limit.store" default:"memory"`
+	BuyPerMinute             int    `env:"RATE_LIMIT_BUY_PER_MINUTE" file:"rate_limit.buy_per_minute" default:"10"`
+	BuyBurst                 int    `env:"RATE_LIMIT_BUY_BURST" file:"rate_limit.buy_burst" default:"5"`
+	BuyConcurrencyPerVehicle int    `env:"RATE_LIMIT_BUY_CONCURRENCY_PER_VEHICLE" file:"rate_limit.buy_concurrency_per_vehicle" default:"1"`
+	WebhookPerMinute         int    `env:"RATE_LIMIT_WEBHOOK_PER_MINUTE" file:"rate_limit.webhook_per_minute" default:"600"`
+	WebhookBurst             int    `env:"RATE_LIMIT_WEBHOOK_BURST" file:"rate_limit.webhook_burst" default:"100"`
+}
+
+// Cache configures the in-process read cache of the vehicle catalog, disabled
+// when CatalogTTL is zero. Each instance has its own, so a change made through
+// one instance reaches the others within CatalogTTL.
+type Cache struct {
+	CatalogTTL      time.Duration `env:"CACHE_CATALOG_TTL" file:"cache.catalog_ttl" default:"15s"`
+	CatalogCapacity int           `env:"CACHE_CATALOG_CAPACITY" file:"cache.catalog_capacity" default:"1024"`
+}
+
+// Media configures the photos of vehicles. Uploads larger than MaxSize bytes,
+// or with dimensions outside of the Min and Max bounds, are refused, and
+// thumbnails fit in a ThumbnailSize square. The local storage keeps the files
+// under Dir.
+type Media struct {
+	Storage       string `env:"MEDIA_STORAGE" file:"media.storage" default:"local"`
+	Dir           string `env:"MEDIA_DIR" file:"media.dir" default:"./data/media"`
+	MaxSize       int64  `env:"MEDIA_MAX_SIZE" file:"media.max_size" default:"10485760"`
+	MinWidth      int    `env:"MEDIA_MIN_WIDTH" file:"media.min_width" default:"640"`
+	MinHeight     int    `env:"MEDIA_MIN_HEIGHT" file:"media.min_height" default:"480"`
+	MaxWidth      int    `env:"MEDIA_MAX_WIDTH" file:"media.max_width" default:"8000"`
+	MaxHeight     int    `env:"MEDIA_MAX_HEIGHT" file:"media.max_height" default:"8000"`
+	MaxPerVehicle int    `env:"MEDIA_MAX_PER_VEHICLE" file:"media.max_per_vehicle" default:"20"`
+	ThumbnailSize int    `env:"MEDIA_THUMBNAIL_SIZE" file:"media.thumbnail_size" default:"320"`
+}
+
+// Offers configures the negotiation of vehicles. An open offer expires TTL
+// after it is made or countered.
+type Offers struct {
+	TTL time.Duration `env:"OFFERS_TTL" file:"offers.ttl" default:"72h"`
+}
+
+// Financing configures the simulations of financings. RateTable holds the
+// monthly interest rates, in percent, by the longest term each one applies to,
+// as "months:rate" pairs separated by commas. The RegistrationFee and the IOF,
+// IOFPercentage percent of the principal, are financed along with it.
+type Financing struct {
+	RateTable       string  `env:"FINANCING_RATE_TABLE" file:"financing.rate_table" default:"12:1.49,24:1.59,36:1.69,48:1.79,60:1.89"`
+	RegistrationFee float64 `env:"FINANCING_REGISTRATION_FEE" file:"financing.registration_fee" default:"0"`
+	IOFPercentage   float64 `env:"FINANCING_IOF_PERCENTAGE" file:"financing.iof_percentage" default:"0"`
+}
+
+func (ref Config) IsProd() bool {
+	return ref.Environment == EnvironmentProd
+}
+
+// Validate checks the fields required by the current environment and returns
+// every problem found at once.
+func (ref Config) Validate() error {
+	var errs []error
+
+	required := func(name, value string) {
+		if value == "" {
+			errs = append(errs, fmt.Errorf("%s is required", name))
+		}
+	}
+
+	if ref.Environment != EnvironmentLocal && ref.Environment != EnvironmentProd {
+		errs = append(errs, fmt.Errorf("ENVIRONMENT must be %s or %s, got %q", EnvironmentLocal, EnvironmentProd, ref.Environment))
+	}
+
+	switch ref.Storage {
+	case StoragePostgres:
+		switch ref.Environment {
+		case EnvironmentProd:
+			required("INSTANCE_CONNECTION_NAME", ref.Database.InstanceConnectionName)
+		case EnvironmentLocal:
+			required("DB_HOST", ref.Database.Host)
+			required("DB_PORT", ref.Database.Port)
+		}
+
+		required("DB_USER", ref.Database.User)
+		required("DB_PASSWORD", ref.Database.Password)
+		required("DB_NAME", ref.Database.Name)
+	case StorageMongo:
+		required("MONGO_URI", ref.Mongo.URI)
+		required("MONGO_DATABASE", ref.Mongo.Database)
+	case StorageMemory:
+		if ref.IsProd() {
+			errs = append(errs, errors.New("STORAGE=memory is not allowed in PROD"))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("STORAGE must be %s, %s or %s, got %q", StoragePostgres, StorageMongo, StorageMemory, ref.Storage))
+	}
+
+	if _, err := logger.ParseLevel(ref.LogLevel); err != nil {
+		errs = append(errs, fmt.Errorf("LOG_LEVEL must be debug, info, warn or error, got %q", ref.LogLevel))
+	}
+
+	if _, err := strconv.ParseUint(ref.API.Port, 10, 16); err != nil {
+		errs = append(errs, fmt.Errorf("API_PORT must be a valid port, got %q", ref.API.Port))
+	}
+
+	errs = append(errs, validateURL("VEHICLE_PLATFORM_SALES_HOST", ref.API.PublicHost))
+	errs = append(errs, validateURL("VEHICLE_PLATFORM_PAYMENTS_HOST", ref.Payments.Host))
+
+	if ref.Database.MaxOpenConns < 0 {
+		errs = append(errs, errors.New("DB_MAX_OPEN_CONNS must not be negative"))
+	}
+
+	if ref.Database.MaxIdleConns < 0 {
+		errs = append(errs, errors.New("DB_MAX_IDLE_CONNS must not be negative"))
+	}
+
+	if ref.Database.MaxOpenConns > 0 && ref.Database.MaxIdleConns > ref.Database.MaxOpenConns {
+		errs = append(errs, errors.New("DB_MAX_IDLE_CONNS must not be greater than DB_MAX_OPEN_CONNS"))
+	}
+
+	if ref.Database.StatementTimeout < 0 {
+		errs = append(errs, errors.New("DB_STATEMENT_TIMEOUT must not be negative"))
+	}
+
+	if ref.Database.StatementCacheCapacity < 0 {
+		errs = append(errs, errors.New("DB_STATEMENT_CACHE_CAPACITY must not be negative"))
+	}
+
+	if ref.API.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("API_SHUTDOWN_TIMEOUT must be positive"))
+	}
+
+	if ref.API.HealthCheckTimeout <= 0 {
+		errs = append(errs, errors.New("API_HEALTH_CHECK_TIMEOUT must be positive"))
+	}
+
+	if ref.Payments.Timeout <= 0 {
+		errs = append(errs, errors.New("VEHICLE_PLATFORM_PAYMENTS_TIMEOUT must be positive"))
+	}
+
+	switch ref.Tracing.Exporter {
+	case "none", "stdout", "otlp":
+	default:
+		errs = append(errs, fmt.Errorf("TRACING_EXPORTER must be none, stdout or otlp, got %q", ref.Tracing.Exporter))
+	}
+
+	if ref.Tracing.SampleRatio < 0 || ref.Tracing.SampleRatio > 1 {
+		errs = append(errs, errors.New("TRACING_SAMPLE_RATIO must be between 0 and 1"))
+	}
+
+	if ref.Auth.JWKSFile != "" {
+		required("AUTH_JWT_ISSUER", ref.Auth.Issuer)
+		required("AUTH_JWT_AUDIENCE", ref.Auth.Audience)
+	}
+
+	switch ref.RateLimit.Store {
+	case RateLimitStoreMemory:
+	case RateLimitStorePostgres:
+		if ref.Storage != StoragePostgres {
+			errs = append(errs, fmt.Errorf("RATE_LIMIT_STORE=%s requires STORAGE=%s", RateLimitStorePostgres, StoragePostgres))
+		}
+	default:
+		errs = append(errs, fmt.Errorf("RATE_LIMIT_STORE must be %s or %s, got %q", RateLimitStoreMemory, RateLimitStorePostgres, ref.RateLimit.Store))
+	}
+
+	errs = append(errs, validateRateLimit("RATE_LIMIT_BUY", ref.RateLimit.BuyPerMinute, ref.RateLimit.BuyBurst))
+	errs = append(errs, validateRateLimit("RATE_LIMIT_WEBHOOK", ref.RateLimit.WebhookPerMinute, ref.RateLimit.WebhookBurst))
+
+	if ref.RateLimit.BuyConcurrencyPerVehicle < 0 {
+		errs = append(errs, errors.New("RATE_LIMIT_BUY_CONCURRENCY_PER_VEHICLE must not be negative"))
+	}
+
+	if ref.Cache.CatalogTTL < 0 {
+		errs = append(errs, errors.New("CACHE_CATALOG_TTL must not be negative"))
+	}
+
+	if ref.Cache.CatalogTTL > 0 && ref.Cache.CatalogCapacity < 1 {
+		errs = append(errs, errors.New("CACHE_CATALOG_CAPACITY must be at least 1"))
+	}
+
+	switch ref.Media.Storage {
+	case MediaStorageLocal:
+		required("MEDIA_DIR", ref.Media.Dir)
+	default:
+		errs = append(errs, fmt.Errorf("MEDIA_STORAGE must be %s, got %q", MediaStorageLocal, ref.Media.Storage))
+	}
+
+	if ref.Media.MaxSize < 1 {
+		errs = append(errs, errors.New("MEDIA_MAX_SIZE must be positive"))
+	}
+
+	if ref.Media.MinWidth < 1 || ref.Media.MinHeight < 1 {
+		errs = append(errs, errors.New("MEDIA_MIN_WIDTH and MEDIA_MIN_HEIGHT must be positive"))
+	}
+
+	if ref.Media.MaxWidth < ref.Media.MinWidth || ref.Media.MaxHeight < ref.Media.MinHeight {
+		errs = append(errs, errors.New("MEDIA_MAX_WIDTH and MEDIA_MAX_HEIGHT must not be less than the minimums"))
+	}
+
+	if ref.Media.MaxPerVehicle < 1 {
+		errs = append(errs, errors.New("MEDIA_MAX_PER_VEHICLE must be at least 1"))
+	}
+
+	if ref.Media.ThumbnailSize < 1 {
+		errs = append(errs, errors.New("MEDIA_THUMBNAIL_SIZE must be positive"))
+	}
+
+	if ref.Offers.TTL <= 0 {
+		errs = append(errs, errors.New("OFFERS_TTL must be positive"))
+	}
+
+	if _, err := financing.ParseRateTable(ref.Financing.RateTable); err != nil {
+		errs = append(errs, fmt.Errorf("FINANCING_RATE_TABLE: %w", err))
+	}
+
+	if !isFinite(ref.Financing.RegistrationFee) || ref.Financing.RegistrationFee < 0 {
+		errs = append(errs, errors.New("FINANCING_REGISTRATION_FEE must be a finite number not below zero"))
+	}
+
+	if !isFinite(ref.Financing.IOFPercentage) || ref.Financing.IOFPercentage < 0 || ref.Financing.IOFPercentage >= 100 {
+		errs = append(errs, errors.New("FINANCING_IOF_PERCENTAGE must be a number from 0 to below 100"))
+	}
+
+	return errors.Join(errs...)
+}
+
+func isFinite(value float64) bool {
+	return !math.IsNaN(value) && !math.IsInf(value, 0)
+}
+
+func validateRateLimit(prefix string, perMinute, burst int) error {
+	if perMinute < 0 {
+		return fmt.Errorf("%s_PER_MINUTE must not be negative", prefix)
+	}
+
+	if perMinute > 0 && burst < 1 {
+		return fmt.Errorf("%s_BURST must be at least 1", prefix)
+	}
+
+	return nil
+}
+
+func validateURL(name, value string) error {
+	if value == "" {
+		return fmt.Errorf("%s is required", name)
+	}
+
+	parsed, err := url.Parse(value)
+	if err != nil || parsed.Scheme == "" || parsed.Host == "" {
+		return fmt.Errorf("%s must be an absolute URL, got %q", name, value)
+	}
+
+	return nil
+}
@@ -0,0 +1,302 @@
+package config
+
+import (
+	"bytes"
+	"math"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func validConfig() Config {
+	return Config{
+		Environment: EnvironmentLocal,
+		LogLevel:    "info",
+		Storage:     StoragePostgres,
+		API: API{
+			Port:               "8080",
+			PublicHost:         "http://vehicle-platform-sales:4002",
+			ShutdownTimeout:    time.Second,
+			HealthCheckTimeout: time.Second,
+		},
+		Database: Database{
+			Host:         "localhost",
+			Port:         "5432",
+			User:         "docker",
+			Password:     "docker",
+			Name:         "vehicle-platform-sales",
+			MaxOpenConns: 10,
+			MaxIdleConns: 5,
+		},
+		Payments: Payments{
+			Host:    "http://vehicle-platform-payments:4003",
+			Timeout: time.Second,
+		},
+		Tracing: Tracing{
+			Exporter:    "none",
+			SampleRatio: 1,
+		},
+		RateLimit: RateLimit{
+			Store:        RateLimitStoreMemory,
+			BuyPerMinute: 10,
+			BuyBurst:     5,
+		},
+		Cache: Cache{
+			CatalogTTL:      time.Second,
+			CatalogCapacity: 10,
+		},
+		Media: Media{
+			Storage:       MediaStorageLocal,
+			Dir:           "./data/media",
+			MaxSize:       1024,
+			MinWidth:      640,
+			MinHeight:     480,
+			MaxWidth:      8000,
+			MaxHeight:     8000,
+			MaxPerVehicle: 20,
+			ThumbnailSize: 320,
+		},
+		Offers: Offers{
+			TTL: 72 * time.Hour,
+		},
+		Financing: Financing{
+			RateTable: "12:1.49,24:1.59",
+		},
+	}
+}
+
+func envFrom(values map[string]string) func(string) (string, bool) {
+	return func(key string) (string, bool) {
+		value, ok := values[key]
+		return value, ok
+	}
+}
+
+func TestLoad(t *testing.T) {
+	t.Run("should load defaults", func(t *testing.T) {
+		actual, err := load(envFrom(nil), nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, EnvironmentLocal, actual.Environment)
+		assert.Equal(t, "8080", actual.API.Port)
+		assert.Equal(t, 10, actual.Database.MaxOpenConns)
+		assert.Equal(t, 30*time.Minute, actual.Database.ConnMaxLifetime)
+		assert.Equal(t, 3*time.Second, actual.Payments.Timeout)
+		assert.False(t, actual.Database.MigrateOnStartup)
+	})
+
+	t.Run("should prefer environment over file and file over defaults", func(t *testing.T) {
+		env := envFrom(map[string]string{
+			"API_PORT": "4002",
+		})
+
+		fileValues := map[string]string{
+			"api.port":                    "9090",
+			"database.host":               "postgres",
+			"payments.timeout":            "5s",
+			"database.migrate_on_startup": "true",
+		}
+
+		actual, err := load(env, fileValues)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "4002", actual.API.Port)
+		assert.Equal(t, "postgres", actual.Database.Host)
+		assert.Equal(t, 5*time.Second, actual.Payments.Timeout)
+		assert.True(t, actual.Database.MigrateOnStartup)
+	})
+
+	t.Run("should not load invalid typed values", func(t *testing.T) {
+		env := envFrom(map[string]string{
+			"DB_MAX_OPEN_CONNS": "many",
+		})
+
+		actual, err := load(env, nil)
+
+		assert.Nil(t, actual)
+		assert.ErrorContains(t, err, "DB_MAX_OPEN_CONNS")
+	})
+}
+
+func TestReadFile(t *testing.T) {
+	dir := t.TempDir()
+
+	t.Run("should read yaml file", func(t *testing.T) {
+		path := filepath.Join(dir, "config.yaml")
+		content := "api:\n  port: 4002\ndatabase:\n  max_open_conns: 20\n  conn_max_lifetime: 1h\n"
+		assert.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+
+		actual, err := readFile(path)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"api.port":                   "4002",
+			"database.max_open_conns":    "20",
+			"database.conn_max_lifetime": "1h",
+		}, actual)
+	})
+
+	t.Run("should read toml file", func(t *testing.T) {
+		path := filepath.Join(dir, "config.toml")
+		content := "environment = \"PROD\"\n[payments]\ntimeout = \"2s\"\n"
+		assert.Nil(t, os.WriteFile(path, []byte(content), 0o600))
+
+		actual, err := readFile(path)
+
+		assert.Nil(t, err)
+		assert.Equal(t, map[string]string{
+			"environment":      "PROD",
+			"payments.timeout": "2s",
+		}, actual)
+	})
+
+	t.Run("should not read unsupported file", func(t *testing.T) {
+		path := filepath.Join(dir, "config.json")
+		assert.Nil(t, os.WriteFile(path, []byte("{}"), 0o600))
+
+		actual, err := readFile(path)
+
+		assert.Nil(t, actual)
+		assert.ErrorContains(t, err, "unsupported config file extension")
+	})
+}
+
+func TestValidate(t *testing.T) {
+	t.Run("should validate local config successfully", func(t *testing.T) {
+		cfg := validConfig()
+
+		assert.Nil(t, cfg.Validate())
+	})
+
+	t.Run("should require cloud sql instance in prod", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Environment = EnvironmentProd
+
+		assert.ErrorContains(t, cfg.Validate(), "INSTANCE_CONNECTION_NAME is required")
+	})
+
+	t.Run("should report every missing field", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Database.Password = ""
+		cfg.Payments.Host = ""
+
+		err := cfg.Validate()
+
+		assert.ErrorContains(t, err, "DB_PASSWORD is required")
+		assert.ErrorContains(t, err, "VEHICLE_PLATFORM_PAYMENTS_HOST is required")
+	})
+
+	t.Run("should not validate unknown environment", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Environment = "STAGING"
+
+		assert.ErrorContains(t, cfg.Validate(), "ENVIRONMENT must be")
+	})
+
+	t.Run("should not validate relative payments host", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Payments.Host = "vehicle-platform-payments"
+
+		assert.ErrorContains(t, cfg.Validate(), "must be an absolute URL")
+	})
+
+	t.Run("should not require database in memory storage", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Storage = StorageMemory
+		cfg.Database = Database{}
+
+		assert.Nil(t, cfg.Validate())
+	})
+
+	t.Run("should require mongo uri in mongo storage", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Storage = StorageMongo
+		cfg.Database = Database{}
+
+		assert.EqualError(t, cfg.Validate(), "MONGO_URI is required\nMONGO_DATABASE is required")
+	})
+
+	t.Run("should not validate memory storage in prod", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Environment = EnvironmentProd
+		cfg.Storage = StorageMemory
+
+		assert.ErrorContains(t, cfg.Validate(), "STORAGE=memory is not allowed in PROD")
+	})
+
+	t.Run("should require issuer and audience when tokens are enabled", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Auth.JWKSFile = "jwks.json"
+
+		assert.EqualError(t, cfg.Validate(), "AUTH_JWT_ISSUER is required\nAUTH_JWT_AUDIENCE is required")
+	})
+	t.Run("should require postgres storage for the shared rate limit store", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Storage = StorageMemory
+		cfg.Database = Database{}
+		cfg.RateLimit.Store = RateLimitStorePostgres
+
+		assert.EqualError(t, cfg.Validate(), "RATE_LIMIT_STORE=postgres requires STORAGE=postgres")
+	})
+
+	t.Run("should require capacity when catalog cache is enabled", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Cache.CatalogCapacity = 0
+
+		assert.EqualError(t, cfg.Validate(), "CACHE_CATALOG_CAPACITY must be at least 1")
+	})
+
+	t.Run("should require burst when rate limit is enabled", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.RateLimit.BuyBurst = 0
+
+		assert.EqualError(t, cfg.Validate(), "RATE_LIMIT_BUY_BURST must be at least 1")
+	})
+
+	t.Run("should validate media limits", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Media.Storage = "s3"
+		cfg.Media.MaxWidth = 100
+
+		assert.EqualError(t, cfg.Validate(), "MEDIA_STORAGE must be local, got \"s3\"\nMEDIA_MAX_WIDTH and MEDIA_MAX_HEIGHT must not be less than the minimums")
+	})
+
+	t.Run("should require positive offers ttl", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Offers.TTL = 0
+
+		assert.EqualError(t, cfg.Validate(), "OFFERS_TTL must be positive")
+	})
+
+	t.Run("should validate financing rate table and fees", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Financing.RateTable = "12:1.49,24"
+		cfg.Financing.IOFPercentage = 100
+
+		assert.EqualError(t, cfg.Validate(), "FINANCING_RATE_TABLE: rate \"24\" is not written as months:rate\nFINANCING_IOF_PERCENTAGE must be a number from 0 to below 100")
+	})
+
+	t.Run("should refuse financing fees that are not finite", func(t *testing.T) {
+		cfg := validConfig()
+		cfg.Financing.RateTable = "12:NaN"
+		cfg.Financing.RegistrationFee = math.Inf(1)
+		cfg.Financing.IOFPercentage = math.NaN()
+
+		assert.EqualError(t, cfg.Validate(), "FINANCING_RATE_TABLE: rate \"12:NaN\" must be from 0 to below 100 percent\nFINANCING_REGISTRATION_FEE must be a finite number not below zero\nFINANCING_IOF_PERCENTAGE must be a number from 0 to below 100")
+	})
+}
+
+func TestPrint(t *testing.T) {
+	cfg := validConfig()
+
+	var buffer bytes.Buffer
+	err := cfg.Print(&buffer)
+
+	assert.Nil(t, err)
+	assert.Contains(t, buffer.String(), "password: '******'")
+	assert.Contains(t, buffer.String(), "timeout: 1s")
+	assert.NotContains(t, buffer.String(), "password: docker")
+}
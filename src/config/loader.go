@@ -0,0 +1,191 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/joho/godotenv"
+	"github.com/pelletier/go-toml/v2"
+	"gopkg.in/yaml.v3"
+)
+
+const configFileEnv = "CONFIG_FILE"
+
+var durationType = reflect.TypeOf(time.Duration(0))
+
+// Load resolves the configuration from the environment, the .env file of the
+// working directory and the file pointed by CONFIG_FILE, when set.
+func Load() (*Config, error) {
+	if err := godotenv.Load(); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, fmt.Errorf("failed to read .env file: %w", err)
+	}
+
+	fileValues := make(map[string]string)
+
+	if path := os.Getenv(configFileEnv); path != "" {
+		var err error
+		if fileValues, err = readFile(path); err != nil {
+			return nil, err
+		}
+	}
+
+	return load(os.LookupEnv, fileValues)
+}
+
+func load(lookupEnv func(string) (string, bool), fileValues map[string]string) (*Config, error) {
+	var cfg Config
+
+	err := walk(reflect.ValueOf(&cfg).Elem(), func(field reflect.StructField, value reflect.Value) error {
+		raw, ok := lookupEnv(field.Tag.Get("env"))
+		if !ok {
+			raw, ok = fileValues[field.Tag.Get("file")]
+		}
+		if !ok {
+			raw = field.Tag.Get("default")
+		}
+
+		if err := set(value, raw); err != nil {
+			return fmt.Errorf("invalid value for %s: %w", field.Tag.Get("env"), err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &cfg, nil
+}
+
+// walk calls fn for every leaf field of a configuration struct.
+func walk(value reflect.Value, fn func(field reflect.StructField, value reflect.Value) error) error {
+	for i := 0; i < value.NumField(); i++ {
+		field := value.Type().Field(i)
+
+		if field.Type.Kind() == reflect.Struct {
+			if err := walk(value.Field(i), fn); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := fn(field, value.Field(i)); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func set(value reflect.Value, raw string) error {
+	if value.Type() == durationType {
+		if raw == "" {
+			value.SetInt(0)
+			return nil
+		}
+
+		duration, err := time.ParseDuration(raw)
+		if err != nil {
+			return err
+		}
+
+		value.SetInt(int64(duration))
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.String:
+		value.SetString(raw)
+
+	case reflect.Bool:
+		if raw == "" {
+			value.SetBool(false)
+			return nil
+		}
+
+		parsed, err := strconv.ParseBool(raw)
+		if err != nil {
+			return err
+		}
+		value.SetBool(parsed)
+
+	case reflect.Int, reflect.Int64:
+		if raw == "" {
+			value.SetInt(0)
+			return nil
+		}
+
+		parsed, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return err
+		}
+		value.SetInt(parsed)
+
+	case reflect.Float64:
+		if raw == "" {
+			value.SetFloat(0)
+			return nil
+		}
+
+		parsed, err := strconv.ParseFloat(raw, 64)
+		if err != nil {
+			return err
+		}
+		value.SetFloat(parsed)
+
+	default:
+		return fmt.Errorf("unsupported config type %s", value.Type())
+	}
+
+	return nil
+}
+
+// readFile parses a YAML or TOML file and flattens it into dotted keys, the
+// same ones used by the `file` tags.
+func readFile(path string) (map[string]string, error) {
+	content, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config file: %w", err)
+	}
+
+	document := make(map[string]any)
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".yaml", ".yml":
+		err = yaml.Unmarshal(content, &document)
+	case ".toml":
+		err = toml.Unmarshal(content, &document)
+	default:
+		return nil, fmt.Errorf("unsupported config file extension %q, use .yaml, .yml or .toml", filepath.Ext(path))
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse config file: %w", err)
+	}
+
+	values := make(map[string]string)
+	flatten("", document, values)
+
+	return values, nil
+}
+
+func flatten(prefix string, document map[string]any, values map[string]string) {
+	for key, value := range document {
+		if prefix != "" {
+			key = prefix + "." + key
+		}
+
+		if nested, ok := value.(map[string]any); ok {
+			flatten(key, nested, values)
+			continue
+		}
+
+		values[key] = fmt.Sprint(value)
+	}
+}
@@ -0,0 +1,58 @@
+package config
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+const redacted = "******"
+
+// Redacted returns the configuration as a nested document keyed like the
+// config file, with every secret field masked.
+func (ref Config) Redacted() map[string]any {
+	document := make(map[string]any)
+
+	walk(reflect.ValueOf(&ref).Elem(), func(field reflect.StructField, value reflect.Value) error {
+		var printable any = value.Interface()
+
+		if value.Type() == durationType {
+			printable = fmt.Sprint(value.Interface())
+		}
+
+		if field.Tag.Get("secret") == "true" && !value.IsZero() {
+			printable = redacted
+		}
+
+		keys := strings.Split(field.Tag.Get("file"), ".")
+		node := document
+		for _, key := range keys[:len(keys)-1] {
+			child, ok := node[key].(map[string]any)
+			if !ok {
+				child = make(map[string]any)
+				node[key] = child
+			}
+			node = child
+		}
+		node[keys[len(keys)-1]] = printable
+
+		return nil
+	})
+
+	return document
+}
+
+// Print writes the effective configuration as YAML, secrets redacted.
+func (ref Config) Print(w io.Writer) error {
+	encoder := yaml.NewEncoder(w)
+	encoder.SetIndent(2)
+
+	if err := encoder.Encode(ref.Redacted()); err != nil {
+		return err
+	}
+
+	return encoder.Close()
+}
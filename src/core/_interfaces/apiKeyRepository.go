@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type APIKeyRepository interface {
+	Create(ctx context.Context, apiKey entity.APIKey) (*entity.APIKey, error)
+	GetByHash(ctx context.Context, keyHash string) (*entity.APIKey, error)
+	Search(ctx context.Context) ([]entity.APIKey, error)
+	Revoke(ctx context.Context, id int, revokedAt time.Time) (*entity.APIKey, error)
+}
@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type AuthService interface {
+	AuthenticateAPIKey(ctx context.Context, key string) (*entity.Principal, error)
+	AuthenticateToken(ctx context.Context, token string) (*entity.Principal, error)
+	CreateAPIKey(ctx context.Context, name string, dealerID int, roles []valueobjects.Role) (*entity.APIKey, string, error)
+	EnsureAPIKey(ctx context.Context, name string, key string, roles []valueobjects.Role) (*entity.APIKey, error)
+	SearchAPIKeys(ctx context.Context) ([]entity.APIKey, error)
+	RevokeAPIKey(ctx context.Context, id int) (*entity.APIKey, error)
+}
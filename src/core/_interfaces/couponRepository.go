@@ -0,0 +1,22 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type CouponRepository interface {
+	Create(ctx context.Context, coupon entity.Coupon) (*entity.Coupon, error)
+	GetByID(ctx context.Context, id int) (*entity.Coupon, error)
+	GetByCode(ctx context.Context, dealerID int, code valueobjects.CouponCode) (*entity.Coupon, error)
+	Search(ctx context.Context) ([]entity.Coupon, error)
+	// Redeem counts one more use of the coupon, returning nil when it does not
+	// exist or has no uses left, so concurrent buys never go past MaxUses.
+	Redeem(ctx context.Context, id int) (*entity.Coupon, error)
+	// Release gives back one use of the coupon, returning nil when it does not
+	// exist or has no use counted.
+	Release(ctx context.Context, id int) (*entity.Coupon, error)
+	Delete(ctx context.Context, id int) (*entity.Coupon, error)
+}
@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type CouponService interface {
+	Create(ctx context.Context, coupon entity.Coupon) (*entity.Coupon, error)
+	GetByID(ctx context.Context, id int) (*entity.Coupon, error)
+	Search(ctx context.Context) ([]entity.Coupon, error)
+	Delete(ctx context.Context, id int) (*entity.Coupon, error)
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type DealerService interface {
+	Create(ctx context.Context, dealer entity.Dealer) (*entity.Dealer, error)
+	GetByID(ctx context.Context, id int) (*entity.Dealer, error)
+	Search(ctx context.Context) ([]entity.Dealer, error)
+}
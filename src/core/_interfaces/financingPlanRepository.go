@@ -0,0 +1,14 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type FinancingPlanRepository interface {
+	Create(ctx context.Context, plan entity.FinancingPlan) (*entity.FinancingPlan, error)
+	GetByID(ctx context.Context, id int) (*entity.FinancingPlan, error)
+	Search(ctx context.Context) ([]entity.FinancingPlan, error)
+	Delete(ctx context.Context, id int) (*entity.FinancingPlan, error)
+}
@@ -0,0 +1,13 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type FinancingSimulationService interface {
+	// Simulate returns nil when the vehicle does not exist or is not visible
+	// to the caller.
+	Simulate(ctx context.Context, entityID string, downPayment float64, months int) (*entity.FinancingSimulation, error)
+}
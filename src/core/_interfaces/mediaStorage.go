@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+)
+
+// MediaStorage keeps the files of vehicle media by key. Keys are built by the
+// media service and use "/" as separator whatever the backend.
+type MediaStorage interface {
+	Put(ctx context.Context, key string, content io.Reader) error
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete succeeds when there is no file under key.
+	Delete(ctx context.Context, key string) error
+}
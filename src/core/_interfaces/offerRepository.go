@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type OfferRepository interface {
+	Create(ctx context.Context, offer entity.Offer) (*entity.Offer, error)
+	GetByID(ctx context.Context, id string) (*entity.Offer, error)
+	// SearchByVehicleID returns the offers of the vehicle from the oldest.
+	SearchByVehicleID(ctx context.Context, vehicleID string) ([]entity.Offer, error)
+	// Update writes the status, the counter amount, the expiration and the
+	// payment of offer.
+	Update(ctx context.Context, offer entity.Offer) (*entity.Offer, error)
+}
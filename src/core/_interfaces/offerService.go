@@ -0,0 +1,15 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type OfferService interface {
+	Create(ctx context.Context, offer entity.Offer) (*entity.Offer, error)
+	Search(ctx context.Context, vehicleID string) ([]entity.Offer, error)
+	Accept(ctx context.Context, vehicleID, offerID string) (*entity.Offer, error)
+	Reject(ctx context.Context, vehicleID, offerID string) (*entity.Offer, error)
+	Counter(ctx context.Context, vehicleID, offerID string, amount float64) (*entity.Offer, error)
+}
@@ -5,11 +5,42 @@ import (
 	"time"
 
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 )
 
 type SaleRepository interface {
 	Create(ctx context.Context, sale entity.Sale) (*entity.Sale, error)
+	GetByID(ctx context.Context, id int) (*entity.Sale, error)
 	GetByEntityID(ctx context.Context, entityID string) (*entity.Sale, error)
 	Search(ctx context.Context) ([]entity.Sale, error)
-	UpdateStatusByPaymentID(ctx context.Context, paymentID, status string, soldDate time.Time) (*entity.Sale, error)
+	CountByStatus(ctx context.Context, status string) (int, error)
+	// UpdateStatusByPaymentID sets the status of the sales with the payment
+	// and returns the first one along with the status it had before, locking
+	// it so concurrent updates see each other's status. It returns nil when no
+	// sale has the payment.
+	UpdateStatusByPaymentID(ctx context.Context, paymentID, status string, soldDate time.Time) (*entity.Sale, valueobjects.SaleStatusType, error)
+	// LinkTradeInVehicle records the vehicle the trade-in of the sale became,
+	// returning nil when the sale does not exist, has no trade-in or already
+	// has its vehicle.
+	LinkTradeInVehicle(ctx context.Context, id int, vehicleID string) (*entity.Sale, error)
+	// AttachPayment sets the payment of a sale reserved without one, returning
+	// nil when there is no such sale.
+	AttachPayment(ctx context.Context, id int, paymentID string) (*entity.Sale, error)
+	// DeleteReservation deletes a sale still reserved without a payment along
+	// with its installments, returning nil when there is no such sale.
+	DeleteReservation(ctx context.Context, id int) (*entity.Sale, error)
+	// DeleteRejected deletes the sale along with its installments when it was
+	// rejected, returning nil when there is no such sale, so the vehicle can be
+	// sold again.
+	DeleteRejected(ctx context.Context, id int) (*entity.Sale, error)
+	// CreateInstallments stores the amortization table of a financed sale,
+	// failing with ErrReferenceNotFound when the sale does not exist.
+	CreateInstallments(ctx context.Context, id int, installments []entity.Installment) ([]entity.Installment, error)
+	// SearchInstallments returns the installments of the sale ordered by
+	// number, none when it is not financed.
+	SearchInstallments(ctx context.Context, id int) ([]entity.Installment, error)
+	// PayInstallment marks the installment of the sale with the payment as paid
+	// at paidAt, keeping the first time when it was already paid, and returns
+	// nil when there is no such installment.
+	PayInstallment(ctx context.Context, paymentID string, number int, paidAt time.Time) (*entity.Installment, error)
 }
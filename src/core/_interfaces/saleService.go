@@ -9,5 +9,9 @@ import (
 type SaleService interface {
 	Create(ctx context.Context, sale entity.Sale) (*entity.Sale, error)
 	Search(ctx context.Context) ([]entity.Sale, error)
-	UpdateStatusByPaymentID(ctx context.Context, paymentID, status string) (*entity.Sale, error)
+	// UpdateStatusByPaymentID also tells whether this update finished the
+	// sale, moving it out of PENDING, which a repeated webhook does not.
+	UpdateStatusByPaymentID(ctx context.Context, paymentID, status string) (*entity.Sale, bool, error)
+	GetInstallments(ctx context.Context, id int) (*entity.Sale, []entity.Installment, error)
+	PayInstallment(ctx context.Context, paymentID string, number int) (*entity.Installment, error)
 }
@@ -0,0 +1,12 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+// TokenVerifier checks a bearer token and returns the principal it asserts.
+type TokenVerifier interface {
+	Verify(ctx context.Context, token string) (*entity.Principal, error)
+}
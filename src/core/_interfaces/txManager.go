@@ -0,0 +1,35 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+// TxManager runs several repository calls as a single unit of work. Begin
+// returns a context carrying the transaction, and every repository called
+// with that context takes part in it until Commit or Rollback.
+type TxManager interface {
+	Begin(ctx context.Context) (context.Context, error)
+	Commit(ctx context.Context) error
+	Rollback(ctx context.Context) error
+}
+
+// WithinTx runs fn in a unit of work of txManager, joining the one carried by
+// ctx when there is one. It commits when fn succeeds and rolls back otherwise,
+// returning the error from fn rather than the one from the rollback.
+func WithinTx(ctx context.Context, txManager TxManager, fn func(ctx context.Context) error) error {
+	txCtx, err := txManager.Begin(ctx)
+	if err != nil {
+		return err
+	}
+
+	if err = fn(txCtx); err != nil {
+		if rollbackErr := txManager.Rollback(txCtx); rollbackErr != nil {
+			logger.FromContext(ctx).ErrorContext(ctx, "failed to roll back transaction", "error", rollbackErr)
+		}
+		return err
+	}
+
+	return txManager.Commit(txCtx)
+}
@@ -0,0 +1,19 @@
+package interfaces
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type VehicleMediaRepository interface {
+	Create(ctx context.Context, media entity.VehicleMedia) (*entity.VehicleMedia, error)
+	GetByID(ctx context.Context, id string) (*entity.VehicleMedia, error)
+	// SearchByVehicleIDs returns the media of the given vehicles ordered by
+	// vehicle and position.
+	SearchByVehicleIDs(ctx context.Context, vehicleIDs []string) ([]entity.VehicleMedia, error)
+	// Update writes the position and the cover flag of media.
+	Update(ctx context.Context, media entity.VehicleMedia) (*entity.VehicleMedia, error)
+	Delete(ctx context.Context, id string) (*entity.VehicleMedia, error)
+	DeleteByVehicleID(ctx context.Context, vehicleID string) ([]entity.VehicleMedia, error)
+}
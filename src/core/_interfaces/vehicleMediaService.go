@@ -0,0 +1,17 @@
+package interfaces
+
+import (
+	"context"
+	"io"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type VehicleMediaService interface {
+	Upload(ctx context.Context, vehicleID string, content io.Reader) (*entity.VehicleMedia, error)
+	Search(ctx context.Context, vehicleIDs []string) ([]entity.VehicleMedia, error)
+	Open(ctx context.Context, vehicleID, mediaID string, thumbnail bool) (*entity.VehicleMedia, io.ReadCloser, error)
+	SetCover(ctx context.Context, vehicleID, mediaID string) ([]entity.VehicleMedia, error)
+	Reorder(ctx context.Context, vehicleID string, mediaIDs []string) ([]entity.VehicleMedia, error)
+	Delete(ctx context.Context, vehicleID, mediaID string) (*entity.VehicleMedia, error)
+}
@@ -1,7 +1,11 @@
 package interfaces
 
-import "context"
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
 
 type VehiclePlatformPaymentsAdapter interface {
-	GeneratePayment(ctx context.Context, amount float64, status string) (string, error)
+	GeneratePayment(ctx context.Context, payment entity.Payment) (string, error)
 }
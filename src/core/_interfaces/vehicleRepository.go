@@ -4,11 +4,20 @@ import (
 	"context"
 
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 )
 
 type VehicleRepository interface {
 	Create(ctx context.Context, vehicle entity.Vehicle) (*entity.Vehicle, error)
 	GetByID(ctx context.Context, id string) (*entity.Vehicle, error)
-	Search(ctx context.Context, isSold *bool) ([]entity.Vehicle, error)
+	GetBySlug(ctx context.Context, slug string) (*entity.Vehicle, error)
+	// ExistsByVIN tells whether a vehicle of any dealer has vin, which is
+	// unique across dealers, regardless of the tenant scope.
+	ExistsByVIN(ctx context.Context, vin valueobjects.VIN) (bool, error)
+	Search(ctx context.Context, filter entity.VehicleFilter) ([]entity.Vehicle, error)
+	// Update writes the fields of vehicle only when the stored vehicle is still
+	// at vehicle.Version, returning nil when it does not exist and
+	// ErrVersionMismatch when it is at another version.
 	Update(ctx context.Context, id string, vehicle entity.Vehicle) (*entity.Vehicle, error)
+	Delete(ctx context.Context, id string) (*entity.Vehicle, error)
 }
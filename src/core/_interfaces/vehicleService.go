@@ -2,6 +2,7 @@ package interfaces
 
 import (
 	"context"
+	"time"
 
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
 )
@@ -9,7 +10,13 @@ import (
 type VehicleService interface {
 	Create(ctx context.Context, vehicle entity.Vehicle) (*entity.Vehicle, error)
 	GetByID(ctx context.Context, id string) (*entity.Vehicle, error)
-	Search(ctx context.Context, isSold *bool) ([]entity.Vehicle, error)
-	Update(ctx context.Context, id string, vehicle entity.Vehicle) (*entity.Vehicle, error)
-	Buy(ctx context.Context, entityID, documentNumber string) (*entity.Vehicle, error)
+	GetBySlug(ctx context.Context, slug string) (*entity.Vehicle, error)
+	Search(ctx context.Context, filter entity.VehicleFilter) ([]entity.Vehicle, error)
+	Update(ctx context.Context, id string, version int, patch entity.VehiclePatch) (*entity.Vehicle, error)
+	Delete(ctx context.Context, id string) (*entity.Vehicle, error)
+	// Publish offers the vehicle from publishAt on, or right away when it is
+	// nil, once it passes the publish checklist.
+	Publish(ctx context.Context, id string, publishAt *time.Time) (*entity.Vehicle, error)
+	Unpublish(ctx context.Context, id string) (*entity.Vehicle, error)
+	Buy(ctx context.Context, entityID string, purchase entity.Purchase) (*entity.Vehicle, error)
 }
@@ -10,6 +10,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	time "time"
+
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 )
 
 // SaleRepository is an autogenerated mock type for the SaleRepository type
@@ -17,6 +19,64 @@ type SaleRepository struct {
 	mock.Mock
 }
 
+// AttachPayment provides a mock function with given fields: ctx, id, paymentID
+func (_m *SaleRepository) AttachPayment(ctx context.Context, id int, paymentID string) (*entity.Sale, error) {
+	ret := _m.Called(ctx, id, paymentID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AttachPayment")
+	}
+
+	var r0 *entity.Sale
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*entity.Sale, error)); ok {
+		return rf(ctx, id, paymentID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *entity.Sale); ok {
+		r0 = rf(ctx, id, paymentID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Sale)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, paymentID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountByStatus provides a mock function with given fields: ctx, status
+func (_m *SaleRepository) CountByStatus(ctx context.Context, status string) (int, error) {
+	ret := _m.Called(ctx, status)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CountByStatus")
+	}
+
+	var r0 int
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (int, error)); ok {
+		return rf(ctx, status)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) int); ok {
+		r0 = rf(ctx, status)
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, status)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Create provides a mock function with given fields: ctx, sale
 func (_m *SaleRepository) Create(ctx context.Context, sale entity.Sale) (*entity.Sale, error) {
 	ret := _m.Called(ctx, sale)
@@ -47,6 +107,96 @@ func (_m *SaleRepository) Create(ctx context.Context, sale entity.Sale) (*entity
 	return r0, r1
 }
 
+// CreateInstallments provides a mock function with given fields: ctx, id, installments
+func (_m *SaleRepository) CreateInstallments(ctx context.Context, id int, installments []entity.Installment) ([]entity.Installment, error) {
+	ret := _m.Called(ctx, id, installments)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateInstallments")
+	}
+
+	var r0 []entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, []entity.Installment) ([]entity.Installment, error)); ok {
+		return rf(ctx, id, installments)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, []entity.Installment) []entity.Installment); ok {
+		r0 = rf(ctx, id, installments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, []entity.Installment) error); ok {
+		r1 = rf(ctx, id, installments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteRejected provides a mock function with given fields: ctx, id
+func (_m *SaleRepository) DeleteRejected(ctx context.Context, id int) (*entity.Sale, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteRejected")
+	}
+
+	var r0 *entity.Sale
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*entity.Sale, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *entity.Sale); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Sale)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteReservation provides a mock function with given fields: ctx, id
+func (_m *SaleRepository) DeleteReservation(ctx context.Context, id int) (*entity.Sale, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteReservation")
+	}
+
+	var r0 *entity.Sale
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*entity.Sale, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *entity.Sale); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Sale)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByEntityID provides a mock function with given fields: ctx, entityID
 func (_m *SaleRepository) GetByEntityID(ctx context.Context, entityID string) (*entity.Sale, error) {
 	ret := _m.Called(ctx, entityID)
@@ -77,6 +227,96 @@ func (_m *SaleRepository) GetByEntityID(ctx context.Context, entityID string) (*
 	return r0, r1
 }
 
+// GetByID provides a mock function with given fields: ctx, id
+func (_m *SaleRepository) GetByID(ctx context.Context, id int) (*entity.Sale, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByID")
+	}
+
+	var r0 *entity.Sale
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*entity.Sale, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *entity.Sale); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Sale)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LinkTradeInVehicle provides a mock function with given fields: ctx, id, vehicleID
+func (_m *SaleRepository) LinkTradeInVehicle(ctx context.Context, id int, vehicleID string) (*entity.Sale, error) {
+	ret := _m.Called(ctx, id, vehicleID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LinkTradeInVehicle")
+	}
+
+	var r0 *entity.Sale
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) (*entity.Sale, error)); ok {
+		return rf(ctx, id, vehicleID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int, string) *entity.Sale); ok {
+		r0 = rf(ctx, id, vehicleID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Sale)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int, string) error); ok {
+		r1 = rf(ctx, id, vehicleID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PayInstallment provides a mock function with given fields: ctx, paymentID, number, paidAt
+func (_m *SaleRepository) PayInstallment(ctx context.Context, paymentID string, number int, paidAt time.Time) (*entity.Installment, error) {
+	ret := _m.Called(ctx, paymentID, number, paidAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PayInstallment")
+	}
+
+	var r0 *entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, time.Time) (*entity.Installment, error)); ok {
+		return rf(ctx, paymentID, number, paidAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, time.Time) *entity.Installment); ok {
+		r0 = rf(ctx, paymentID, number, paidAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, time.Time) error); ok {
+		r1 = rf(ctx, paymentID, number, paidAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Search provides a mock function with given fields: ctx
 func (_m *SaleRepository) Search(ctx context.Context) ([]entity.Sale, error) {
 	ret := _m.Called(ctx)
@@ -107,8 +347,38 @@ func (_m *SaleRepository) Search(ctx context.Context) ([]entity.Sale, error) {
 	return r0, r1
 }
 
+// SearchInstallments provides a mock function with given fields: ctx, id
+func (_m *SaleRepository) SearchInstallments(ctx context.Context, id int) ([]entity.Installment, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SearchInstallments")
+	}
+
+	var r0 []entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) ([]entity.Installment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) []entity.Installment); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateStatusByPaymentID provides a mock function with given fields: ctx, paymentID, status, soldDate
-func (_m *SaleRepository) UpdateStatusByPaymentID(ctx context.Context, paymentID string, status string, soldDate time.Time) (*entity.Sale, error) {
+func (_m *SaleRepository) UpdateStatusByPaymentID(ctx context.Context, paymentID string, status string, soldDate time.Time) (*entity.Sale, valueobjects.SaleStatusType, error) {
 	ret := _m.Called(ctx, paymentID, status, soldDate)
 
 	if len(ret) == 0 {
@@ -116,8 +386,9 @@ func (_m *SaleRepository) UpdateStatusByPaymentID(ctx context.Context, paymentID
 	}
 
 	var r0 *entity.Sale
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (*entity.Sale, error)); ok {
+	var r1 valueobjects.SaleStatusType
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) (*entity.Sale, valueobjects.SaleStatusType, error)); ok {
 		return rf(ctx, paymentID, status, soldDate)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, string, time.Time) *entity.Sale); ok {
@@ -128,13 +399,19 @@ func (_m *SaleRepository) UpdateStatusByPaymentID(ctx context.Context, paymentID
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, time.Time) valueobjects.SaleStatusType); ok {
 		r1 = rf(ctx, paymentID, status, soldDate)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(valueobjects.SaleStatusType)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, string, string, time.Time) error); ok {
+		r2 = rf(ctx, paymentID, status, soldDate)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // NewSaleRepository creates a new instance of SaleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -45,6 +45,75 @@ func (_m *SaleService) Create(ctx context.Context, sale entity.Sale) (*entity.Sa
 	return r0, r1
 }
 
+// GetInstallments provides a mock function with given fields: ctx, id
+func (_m *SaleService) GetInstallments(ctx context.Context, id int) (*entity.Sale, []entity.Installment, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetInstallments")
+	}
+
+	var r0 *entity.Sale
+	var r1 []entity.Installment
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, int) (*entity.Sale, []entity.Installment, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, int) *entity.Sale); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Sale)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, int) []entity.Installment); ok {
+		r1 = rf(ctx, id)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).([]entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(2).(func(context.Context, int) error); ok {
+		r2 = rf(ctx, id)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// PayInstallment provides a mock function with given fields: ctx, paymentID, number
+func (_m *SaleService) PayInstallment(ctx context.Context, paymentID string, number int) (*entity.Installment, error) {
+	ret := _m.Called(ctx, paymentID, number)
+
+	if len(ret) == 0 {
+		panic("no return value specified for PayInstallment")
+	}
+
+	var r0 *entity.Installment
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) (*entity.Installment, error)); ok {
+		return rf(ctx, paymentID, number)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, int) *entity.Installment); ok {
+		r0 = rf(ctx, paymentID, number)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Installment)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, int) error); ok {
+		r1 = rf(ctx, paymentID, number)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Search provides a mock function with given fields: ctx
 func (_m *SaleService) Search(ctx context.Context) ([]entity.Sale, error) {
 	ret := _m.Called(ctx)
@@ -76,7 +145,7 @@ func (_m *SaleService) Search(ctx context.Context) ([]entity.Sale, error) {
 }
 
 // UpdateStatusByPaymentID provides a mock function with given fields: ctx, paymentID, status
-func (_m *SaleService) UpdateStatusByPaymentID(ctx context.Context, paymentID string, status string) (*entity.Sale, error) {
+func (_m *SaleService) UpdateStatusByPaymentID(ctx context.Context, paymentID string, status string) (*entity.Sale, bool, error) {
 	ret := _m.Called(ctx, paymentID, status)
 
 	if len(ret) == 0 {
@@ -84,8 +153,9 @@ func (_m *SaleService) UpdateStatusByPaymentID(ctx context.Context, paymentID st
 	}
 
 	var r0 *entity.Sale
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entity.Sale, error)); ok {
+	var r1 bool
+	var r2 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entity.Sale, bool, error)); ok {
 		return rf(ctx, paymentID, status)
 	}
 	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entity.Sale); ok {
@@ -96,13 +166,19 @@ func (_m *SaleService) UpdateStatusByPaymentID(ctx context.Context, paymentID st
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) bool); ok {
 		r1 = rf(ctx, paymentID, status)
 	} else {
-		r1 = ret.Error(1)
+		r1 = ret.Get(1).(bool)
 	}
 
-	return r0, r1
+	if rf, ok := ret.Get(2).(func(context.Context, string, string) error); ok {
+		r2 = rf(ctx, paymentID, status)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
 }
 
 // NewSaleService creates a new instance of SaleService. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	entity "github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -13,9 +15,9 @@ type VehiclePlatformPaymentsAdapter struct {
 	mock.Mock
 }
 
-// GeneratePayment provides a mock function with given fields: ctx, amount, status
-func (_m *VehiclePlatformPaymentsAdapter) GeneratePayment(ctx context.Context, amount float64, status string) (string, error) {
-	ret := _m.Called(ctx, amount, status)
+// GeneratePayment provides a mock function with given fields: ctx, payment
+func (_m *VehiclePlatformPaymentsAdapter) GeneratePayment(ctx context.Context, payment entity.Payment) (string, error) {
+	ret := _m.Called(ctx, payment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GeneratePayment")
@@ -23,17 +25,17 @@ func (_m *VehiclePlatformPaymentsAdapter) GeneratePayment(ctx context.Context, a
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, float64, string) (string, error)); ok {
-		return rf(ctx, amount, status)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Payment) (string, error)); ok {
+		return rf(ctx, payment)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, float64, string) string); ok {
-		r0 = rf(ctx, amount, status)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.Payment) string); ok {
+		r0 = rf(ctx, payment)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, float64, string) error); ok {
-		r1 = rf(ctx, amount, status)
+	if rf, ok := ret.Get(1).(func(context.Context, entity.Payment) error); ok {
+		r1 = rf(ctx, payment)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -5,6 +5,7 @@ package mocks
 import (
 	context "context"
 
+	http "github.com/caiiomp/vehicle-platform-sales/src/adapter/vehiclePlatformPayments/http"
 	mock "github.com/stretchr/testify/mock"
 )
 
@@ -13,9 +14,9 @@ type VehiclePlatformPaymentsHttpClient struct {
 	mock.Mock
 }
 
-// GeneratePayment provides a mock function with given fields: ctx, amount, status
-func (_m *VehiclePlatformPaymentsHttpClient) GeneratePayment(ctx context.Context, amount float64, status string) (string, error) {
-	ret := _m.Called(ctx, amount, status)
+// GeneratePayment provides a mock function with given fields: ctx, payment
+func (_m *VehiclePlatformPaymentsHttpClient) GeneratePayment(ctx context.Context, payment http.Payment) (string, error) {
+	ret := _m.Called(ctx, payment)
 
 	if len(ret) == 0 {
 		panic("no return value specified for GeneratePayment")
@@ -23,17 +24,17 @@ func (_m *VehiclePlatformPaymentsHttpClient) GeneratePayment(ctx context.Context
 
 	var r0 string
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, float64, string) (string, error)); ok {
-		return rf(ctx, amount, status)
+	if rf, ok := ret.Get(0).(func(context.Context, http.Payment) (string, error)); ok {
+		return rf(ctx, payment)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, float64, string) string); ok {
-		r0 = rf(ctx, amount, status)
+	if rf, ok := ret.Get(0).(func(context.Context, http.Payment) string); ok {
+		r0 = rf(ctx, payment)
 	} else {
 		r0 = ret.Get(0).(string)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, float64, string) error); ok {
-		r1 = rf(ctx, amount, status)
+	if rf, ok := ret.Get(1).(func(context.Context, http.Payment) error); ok {
+		r1 = rf(ctx, payment)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -41,6 +42,24 @@ func (_m *VehiclePlatformPaymentsHttpClient) GeneratePayment(ctx context.Context
 	return r0, r1
 }
 
+// Ping provides a mock function with given fields: ctx
+func (_m *VehiclePlatformPaymentsHttpClient) Ping(ctx context.Context) error {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Ping")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context) error); ok {
+		r0 = rf(ctx)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // NewVehiclePlatformPaymentsHttpClient creates a new instance of VehiclePlatformPaymentsHttpClient. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewVehiclePlatformPaymentsHttpClient(t interface {
@@ -8,6 +8,8 @@ import (
 	entity "github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
 
 	mock "github.com/stretchr/testify/mock"
+
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 )
 
 // VehicleRepository is an autogenerated mock type for the VehicleRepository type
@@ -45,6 +47,64 @@ func (_m *VehicleRepository) Create(ctx context.Context, vehicle entity.Vehicle)
 	return r0, r1
 }
 
+// Delete provides a mock function with given fields: ctx, id
+func (_m *VehicleRepository) Delete(ctx context.Context, id string) (*entity.Vehicle, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *entity.Vehicle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.Vehicle, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.Vehicle); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Vehicle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ExistsByVIN provides a mock function with given fields: ctx, vin
+func (_m *VehicleRepository) ExistsByVIN(ctx context.Context, vin valueobjects.VIN) (bool, error) {
+	ret := _m.Called(ctx, vin)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ExistsByVIN")
+	}
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.VIN) (bool, error)); ok {
+		return rf(ctx, vin)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, valueobjects.VIN) bool); ok {
+		r0 = rf(ctx, vin)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, valueobjects.VIN) error); ok {
+		r1 = rf(ctx, vin)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: ctx, id
 func (_m *VehicleRepository) GetByID(ctx context.Context, id string) (*entity.Vehicle, error) {
 	ret := _m.Called(ctx, id)
@@ -75,9 +135,39 @@ func (_m *VehicleRepository) GetByID(ctx context.Context, id string) (*entity.Ve
 	return r0, r1
 }
 
-// Search provides a mock function with given fields: ctx, isSold
-func (_m *VehicleRepository) Search(ctx context.Context, isSold *bool) ([]entity.Vehicle, error) {
-	ret := _m.Called(ctx, isSold)
+// GetBySlug provides a mock function with given fields: ctx, slug
+func (_m *VehicleRepository) GetBySlug(ctx context.Context, slug string) (*entity.Vehicle, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySlug")
+	}
+
+	var r0 *entity.Vehicle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.Vehicle, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.Vehicle); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Vehicle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, filter
+func (_m *VehicleRepository) Search(ctx context.Context, filter entity.VehicleFilter) ([]entity.Vehicle, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Search")
@@ -85,19 +175,19 @@ func (_m *VehicleRepository) Search(ctx context.Context, isSold *bool) ([]entity
 
 	var r0 []entity.Vehicle
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *bool) ([]entity.Vehicle, error)); ok {
-		return rf(ctx, isSold)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.VehicleFilter) ([]entity.Vehicle, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *bool) []entity.Vehicle); ok {
-		r0 = rf(ctx, isSold)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.VehicleFilter) []entity.Vehicle); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Vehicle)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *bool) error); ok {
-		r1 = rf(ctx, isSold)
+	if rf, ok := ret.Get(1).(func(context.Context, entity.VehicleFilter) error); ok {
+		r1 = rf(ctx, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
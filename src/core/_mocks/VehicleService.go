@@ -8,6 +8,8 @@ import (
 	entity "github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
 
 	mock "github.com/stretchr/testify/mock"
+
+	time "time"
 )
 
 // VehicleService is an autogenerated mock type for the VehicleService type
@@ -15,9 +17,9 @@ type VehicleService struct {
 	mock.Mock
 }
 
-// Buy provides a mock function with given fields: ctx, entityID, documentNumber
-func (_m *VehicleService) Buy(ctx context.Context, entityID string, documentNumber string) (*entity.Vehicle, error) {
-	ret := _m.Called(ctx, entityID, documentNumber)
+// Buy provides a mock function with given fields: ctx, entityID, purchase
+func (_m *VehicleService) Buy(ctx context.Context, entityID string, purchase entity.Purchase) (*entity.Vehicle, error) {
+	ret := _m.Called(ctx, entityID, purchase)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Buy")
@@ -25,19 +27,19 @@ func (_m *VehicleService) Buy(ctx context.Context, entityID string, documentNumb
 
 	var r0 *entity.Vehicle
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) (*entity.Vehicle, error)); ok {
-		return rf(ctx, entityID, documentNumber)
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Purchase) (*entity.Vehicle, error)); ok {
+		return rf(ctx, entityID, purchase)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, string) *entity.Vehicle); ok {
-		r0 = rf(ctx, entityID, documentNumber)
+	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Purchase) *entity.Vehicle); ok {
+		r0 = rf(ctx, entityID, purchase)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Vehicle)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
-		r1 = rf(ctx, entityID, documentNumber)
+	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Purchase) error); ok {
+		r1 = rf(ctx, entityID, purchase)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -75,6 +77,36 @@ func (_m *VehicleService) Create(ctx context.Context, vehicle entity.Vehicle) (*
 	return r0, r1
 }
 
+// Delete provides a mock function with given fields: ctx, id
+func (_m *VehicleService) Delete(ctx context.Context, id string) (*entity.Vehicle, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Delete")
+	}
+
+	var r0 *entity.Vehicle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.Vehicle, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.Vehicle); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Vehicle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetByID provides a mock function with given fields: ctx, id
 func (_m *VehicleService) GetByID(ctx context.Context, id string) (*entity.Vehicle, error) {
 	ret := _m.Called(ctx, id)
@@ -105,9 +137,69 @@ func (_m *VehicleService) GetByID(ctx context.Context, id string) (*entity.Vehic
 	return r0, r1
 }
 
-// Search provides a mock function with given fields: ctx, isSold
-func (_m *VehicleService) Search(ctx context.Context, isSold *bool) ([]entity.Vehicle, error) {
-	ret := _m.Called(ctx, isSold)
+// GetBySlug provides a mock function with given fields: ctx, slug
+func (_m *VehicleService) GetBySlug(ctx context.Context, slug string) (*entity.Vehicle, error) {
+	ret := _m.Called(ctx, slug)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBySlug")
+	}
+
+	var r0 *entity.Vehicle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.Vehicle, error)); ok {
+		return rf(ctx, slug)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.Vehicle); ok {
+		r0 = rf(ctx, slug)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Vehicle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, slug)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Publish provides a mock function with given fields: ctx, id, publishAt
+func (_m *VehicleService) Publish(ctx context.Context, id string, publishAt *time.Time) (*entity.Vehicle, error) {
+	ret := _m.Called(ctx, id, publishAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Publish")
+	}
+
+	var r0 *entity.Vehicle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) (*entity.Vehicle, error)); ok {
+		return rf(ctx, id, publishAt)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) *entity.Vehicle); ok {
+		r0 = rf(ctx, id, publishAt)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Vehicle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, *time.Time) error); ok {
+		r1 = rf(ctx, id, publishAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Search provides a mock function with given fields: ctx, filter
+func (_m *VehicleService) Search(ctx context.Context, filter entity.VehicleFilter) ([]entity.Vehicle, error) {
+	ret := _m.Called(ctx, filter)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Search")
@@ -115,19 +207,49 @@ func (_m *VehicleService) Search(ctx context.Context, isSold *bool) ([]entity.Ve
 
 	var r0 []entity.Vehicle
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, *bool) ([]entity.Vehicle, error)); ok {
-		return rf(ctx, isSold)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.VehicleFilter) ([]entity.Vehicle, error)); ok {
+		return rf(ctx, filter)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, *bool) []entity.Vehicle); ok {
-		r0 = rf(ctx, isSold)
+	if rf, ok := ret.Get(0).(func(context.Context, entity.VehicleFilter) []entity.Vehicle); ok {
+		r0 = rf(ctx, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]entity.Vehicle)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, *bool) error); ok {
-		r1 = rf(ctx, isSold)
+	if rf, ok := ret.Get(1).(func(context.Context, entity.VehicleFilter) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Unpublish provides a mock function with given fields: ctx, id
+func (_m *VehicleService) Unpublish(ctx context.Context, id string) (*entity.Vehicle, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for Unpublish")
+	}
+
+	var r0 *entity.Vehicle
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (*entity.Vehicle, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) *entity.Vehicle); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*entity.Vehicle)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -135,9 +257,9 @@ func (_m *VehicleService) Search(ctx context.Context, isSold *bool) ([]entity.Ve
 	return r0, r1
 }
 
-// Update provides a mock function with given fields: ctx, id, vehicle
-func (_m *VehicleService) Update(ctx context.Context, id string, vehicle entity.Vehicle) (*entity.Vehicle, error) {
-	ret := _m.Called(ctx, id, vehicle)
+// Update provides a mock function with given fields: ctx, id, version, patch
+func (_m *VehicleService) Update(ctx context.Context, id string, version int, patch entity.VehiclePatch) (*entity.Vehicle, error) {
+	ret := _m.Called(ctx, id, version, patch)
 
 	if len(ret) == 0 {
 		panic("no return value specified for Update")
@@ -145,19 +267,19 @@ func (_m *VehicleService) Update(ctx context.Context, id string, vehicle entity.
 
 	var r0 *entity.Vehicle
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Vehicle) (*entity.Vehicle, error)); ok {
-		return rf(ctx, id, vehicle)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, entity.VehiclePatch) (*entity.Vehicle, error)); ok {
+		return rf(ctx, id, version, patch)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string, entity.Vehicle) *entity.Vehicle); ok {
-		r0 = rf(ctx, id, vehicle)
+	if rf, ok := ret.Get(0).(func(context.Context, string, int, entity.VehiclePatch) *entity.Vehicle); ok {
+		r0 = rf(ctx, id, version, patch)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(*entity.Vehicle)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string, entity.Vehicle) error); ok {
-		r1 = rf(ctx, id, vehicle)
+	if rf, ok := ret.Get(1).(func(context.Context, string, int, entity.VehiclePatch) error); ok {
+		r1 = rf(ctx, id, version, patch)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -0,0 +1,23 @@
+package domainerrors
+
+import "errors"
+
+// Storage independent errors. Repositories wrap them with the detail of the
+// violated constraint, so callers should match them with errors.Is.
+var (
+	ErrAlreadyExists     = errors.New("resource already exists")
+	ErrReferenceNotFound = errors.New("referenced resource does not exist")
+
+	ErrInvalidCredentials = errors.New("invalid credentials")
+	ErrPermissionDenied   = errors.New("permission denied")
+	ErrInvalidArgument    = errors.New("invalid argument")
+
+	ErrVersionMismatch = errors.New("resource was changed by another request")
+	ErrPatchTestFailed = errors.New("patch test failed")
+	ErrInUse           = errors.New("resource is in use")
+	ErrIncomplete      = errors.New("resource is incomplete")
+	ErrInvalidState    = errors.New("resource does not allow this change in its current state")
+
+	ErrUnsupportedMedia = errors.New("unsupported media type")
+	ErrMediaTooLarge    = errors.New("media is too large")
+)
@@ -0,0 +1,25 @@
+package entity
+
+import (
+	"time"
+
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// APIKey never holds the key itself, only its hash and a short prefix that
+// lets a person tell keys apart. DealerID is zero for platform keys, which
+// belong to no dealer.
+type APIKey struct {
+	ID        int
+	DealerID  int
+	Name      string
+	Prefix    string
+	KeyHash   string
+	Roles     []valueobjects.Role
+	CreatedAt time.Time
+	RevokedAt *time.Time
+}
+
+func (ref APIKey) IsRevoked() bool {
+	return ref.RevokedAt != nil
+}
@@ -0,0 +1,75 @@
+package entity
+
+import (
+	"math"
+	"strings"
+	"time"
+
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// Coupon is a promotion of a dealer, redeemed by its Code when buying a
+// vehicle. DiscountValue is a percentage of the price or a fixed amount,
+// following DiscountType.
+//
+// The zero value of the other fields does not restrict the coupon: MaxUses
+// limits how many sales may redeem it, ValidFrom and ValidUntil bound when it
+// may be redeemed, and Brand, Model, MinYear and MaxYear the vehicles it
+// applies to.
+type Coupon struct {
+	ID            int
+	DealerID      int
+	Code          valueobjects.CouponCode
+	DiscountType  valueobjects.DiscountType
+	DiscountValue float64
+	MaxUses       int
+	Uses          int
+	ValidFrom     *time.Time
+	ValidUntil    *time.Time
+	Brand         string
+	Model         string
+	MinYear       int
+	MaxYear       int
+	CreatedAt     time.Time
+	UpdatedAt     time.Time
+}
+
+// IsValidAt tells whether the coupon may be redeemed at now, ValidUntil being
+// the first instant it no longer may.
+func (ref Coupon) IsValidAt(now time.Time) bool {
+	if ref.ValidFrom != nil && now.Before(*ref.ValidFrom) {
+		return false
+	}
+
+	return ref.ValidUntil == nil || now.Before(*ref.ValidUntil)
+}
+
+// AppliesTo tells whether the vehicle is eligible, brands and models being
+// compared regardless of case.
+func (ref Coupon) AppliesTo(vehicle Vehicle) bool {
+	if ref.Brand != "" && !strings.EqualFold(ref.Brand, strings.TrimSpace(vehicle.Brand)) {
+		return false
+	}
+
+	if ref.Model != "" && !strings.EqualFold(ref.Model, strings.TrimSpace(vehicle.Model)) {
+		return false
+	}
+
+	if ref.MinYear > 0 && vehicle.Year < ref.MinYear {
+		return false
+	}
+
+	return ref.MaxYear == 0 || vehicle.Year <= ref.MaxYear
+}
+
+// DiscountOn is the amount taken off price, rounded to cents and never above
+// the price itself.
+func (ref Coupon) DiscountOn(price float64) float64 {
+	discount := ref.DiscountValue
+
+	if ref.DiscountType == valueobjects.DiscountTypePercentage {
+		discount = price * ref.DiscountValue / 100
+	}
+
+	return math.Min(math.Round(discount*100)/100, price)
+}
@@ -0,0 +1,11 @@
+package entity
+
+import "time"
+
+// Dealer is a dealership hosted on the platform, the tenant every vehicle,
+// sale and dealer credential belongs to.
+type Dealer struct {
+	ID        int
+	Name      string
+	CreatedAt time.Time
+}
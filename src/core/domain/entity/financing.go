@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// FinancingChoice is what the buyer picks when financing a purchase: the plan
+// of the dealer, the DownPayment paid upfront and the number of Months the
+// rest is paid in.
+type FinancingChoice struct {
+	PlanID      int
+	DownPayment float64
+	Months      int
+}
+
+// Financing is how a sale is paid when financed. The terms of the plan are
+// copied from it, so later changes to the plan do not change the sale. The
+// DownPayment is paid along with the approval of the sale and the Principal
+// in Months installments.
+type Financing struct {
+	PlanID              int
+	AmortizationSystem  valueobjects.AmortizationSystem
+	MonthlyInterestRate float64
+	DownPayment         float64
+	Principal           float64
+	Months              int
+}
+
+// Installment is one month of the amortization table of a financed sale.
+// Amount is what is paid, Interest plus Amortization, the part of the
+// principal paid off, and Balance the principal left once it is paid.
+type Installment struct {
+	SaleID       int
+	Number       int
+	DueDate      time.Time
+	Amount       float64
+	Interest     float64
+	Amortization float64
+	Balance      float64
+	PaidAt       *time.Time
+}
+
+func (ref Installment) IsPaid() bool {
+	return ref.PaidAt != nil
+}
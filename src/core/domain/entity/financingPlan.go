@@ -0,0 +1,30 @@
+package entity
+
+import (
+	"math"
+	"time"
+
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// FinancingPlan is a way a dealer offers to finance its vehicles: the buyer
+// picks up to MaxMonths installments, amortized under AmortizationSystem at
+// MonthlyInterestRate percent a month, after a down payment of at least
+// MinDownPaymentPercentage percent of the amount due.
+type FinancingPlan struct {
+	ID                       int
+	DealerID                 int
+	Name                     string
+	AmortizationSystem       valueobjects.AmortizationSystem
+	MonthlyInterestRate      float64
+	MaxMonths                int
+	MinDownPaymentPercentage float64
+	CreatedAt                time.Time
+	UpdatedAt                time.Time
+}
+
+// MinDownPaymentOn is the smallest down payment the plan takes on amount,
+// rounded to cents.
+func (ref FinancingPlan) MinDownPaymentOn(amount float64) float64 {
+	return math.Round(amount*ref.MinDownPaymentPercentage) / 100
+}
@@ -0,0 +1,35 @@
+package entity
+
+import valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+
+// FinancingSimulation is what financing a vehicle at its current Price would
+// cost under each amortization system. Principal is the price less the
+// DownPayment, and AmountFinanced the principal plus the Fees financed along
+// with it, amortized in Months installments at MonthlyInterestRate percent.
+type FinancingSimulation struct {
+	EntityID            string
+	Price               float64
+	DownPayment         float64
+	Principal           float64
+	Fees                float64
+	AmountFinanced      float64
+	Months              int
+	MonthlyInterestRate float64
+	Options             []FinancingOption
+}
+
+// FinancingOption is the simulation under one amortization system.
+// MonthlyPayment is the first installment, the only one under PRICE and the
+// highest under SAC. TotalCost is everything paid for the vehicle, down
+// payment included, and the CET, the effective total cost, is the rate, in
+// percent, at which the installments pay off the principal.
+type FinancingOption struct {
+	AmortizationSystem valueobjects.AmortizationSystem
+	MonthlyPayment     float64
+	LastPayment        float64
+	TotalInterest      float64
+	TotalCost          float64
+	MonthlyCET         float64
+	AnnualCET          float64
+	Installments       []Installment
+}
@@ -0,0 +1,47 @@
+package entity
+
+import (
+	"time"
+
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// Offer is a proposal to buy a vehicle for Amount rather than for its price.
+// BuyerSubject is the principal that made it, the only one allowed to answer a
+// CounterAmount of the dealer. An open offer expires at ExpiresAt, and an
+// accepted one keeps the PaymentID of its sale.
+type Offer struct {
+	ID                  int
+	EntityID            string
+	VehicleID           string
+	DealerID            int
+	BuyerSubject        string
+	BuyerDocumentNumber string
+	Amount              float64
+	CounterAmount       *float64
+	Status              valueobjects.OfferStatus
+	PaymentID           string
+	ExpiresAt           time.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// StatusAt is the status of the offer at now, an open offer past ExpiresAt
+// being expired even though it was never written so.
+func (ref Offer) StatusAt(now time.Time) valueobjects.OfferStatus {
+	if ref.Status.IsOpen() && !now.Before(ref.ExpiresAt) {
+		return valueobjects.OfferStatusExpired
+	}
+
+	return ref.Status
+}
+
+// Price is the amount the vehicle is sold for when the offer is accepted: the
+// counter of the dealer when there is one.
+func (ref Offer) Price() float64 {
+	if ref.CounterAmount != nil {
+		return *ref.CounterAmount
+	}
+
+	return ref.Amount
+}
@@ -0,0 +1,11 @@
+package entity
+
+// Payment is what is charged through the payments platform for a sale: the
+// Amount due, of which the DownPayment is paid at once and the rest in the
+// Installments of a financing, when there are any.
+type Payment struct {
+	Amount       float64
+	Status       string
+	DownPayment  float64
+	Installments []Installment
+}
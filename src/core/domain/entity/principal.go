@@ -0,0 +1,27 @@
+package entity
+
+import (
+	"slices"
+
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// Principal is the authenticated caller of a request, either an API key or
+// the subject of a bearer token. DealerID is zero when the caller is not
+// bound to a dealer.
+type Principal struct {
+	Subject  string
+	Method   string
+	DealerID int
+	Roles    []valueobjects.Role
+}
+
+func (ref Principal) HasAnyRole(roles ...valueobjects.Role) bool {
+	for _, role := range roles {
+		if slices.Contains(ref.Roles, role) {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,11 @@
+package entity
+
+// Purchase is what is asked when buying a vehicle: the buyer, a CouponCode to
+// redeem, a TradeIn taken as part of the payment and the Financing of the
+// rest, all optional.
+type Purchase struct {
+	BuyerDocumentNumber string
+	CouponCode          string
+	TradeIn             *TradeIn
+	Financing           *FinancingChoice
+}
@@ -1,19 +1,41 @@
 package entity
 
 import (
+	"math"
 	"time"
 
 	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 )
 
+// Sale keeps the ListPrice of the vehicle when it was bought apart from the
+// Discount given, through a coupon or a negotiated offer, and the Price that
+// was charged. CouponCode is the coupon redeemed, if any, TradeIn the vehicle
+// taken as part of the payment and Financing how the amount due is paid when
+// it is not paid at once.
 type Sale struct {
 	ID                  int
 	EntityID            string
+	DealerID            int
 	PaymentID           string
 	BuyerDocumentNumber string
+	ListPrice           float64
+	Discount            float64
 	Price               float64
+	CouponCode          string
+	TradeIn             *TradeIn
+	Financing           *Financing
 	Status              valueobjects.SaleStatusType
 	SoldAt              *time.Time
 	CreatedAt           time.Time
 	UpdatedAt           time.Time
 }
+
+// AmountDue is the part of the price paid through the payments platform, what
+// the trade-in does not cover.
+func (ref Sale) AmountDue() float64 {
+	if ref.TradeIn == nil {
+		return ref.Price
+	}
+
+	return math.Round((ref.Price-ref.TradeIn.AppraisedValue)*100) / 100
+}
@@ -0,0 +1,39 @@
+package entity
+
+import (
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// TradeIn is the vehicle a buyer hands over as part of the payment of a sale,
+// taken for the AppraisedValue agreed by the staff member AppraisedBy.
+// VehicleID is the draft vehicle it becomes in the inventory of the dealer
+// once the sale is approved, empty until then.
+type TradeIn struct {
+	Brand          string
+	Model          string
+	Year           int
+	Color          string
+	Mileage        int
+	LicensePlate   valueobjects.LicensePlate
+	VIN            valueobjects.VIN
+	AppraisedValue float64
+	AppraisedBy    string
+	VehicleID      string
+}
+
+// Vehicle is the used vehicle the trade-in becomes in the dealer, priced at
+// its appraised value until the staff prices it for sale.
+func (ref TradeIn) Vehicle(dealerID int) Vehicle {
+	return Vehicle{
+		DealerID:     dealerID,
+		Brand:        ref.Brand,
+		Model:        ref.Model,
+		Year:         ref.Year,
+		Color:        ref.Color,
+		Price:        ref.AppraisedValue,
+		Mileage:      ref.Mileage,
+		Condition:    valueobjects.VehicleConditionUsed,
+		LicensePlate: ref.LicensePlate,
+		VIN:          ref.VIN,
+	}
+}
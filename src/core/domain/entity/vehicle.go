@@ -1,15 +1,63 @@
 package entity
 
-import "time"
+import (
+	"time"
 
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// Vehicle carries the Version it was read at. Updates only apply when the
+// stored vehicle is still at that version, and then increment it.
+//
+// Brand, model, year, color and price are required. The other attributes are
+// optional, their zero value meaning they were not informed. The Slug follows
+// the year, brand, model and color, and changes along with them.
+//
+// A published vehicle is only offered from PublishedAt on, which may be a
+// scheduled time in the future.
 type Vehicle struct {
-	ID        int
-	EntityID  string
-	Brand     string
-	Model     string
-	Year      int
-	Color     string
-	Price     float64
-	CreatedAt time.Time
-	UpdatedAt time.Time
+	ID                 int
+	EntityID           string
+	Slug               valueobjects.Slug
+	DealerID           int
+	Brand              string
+	Model              string
+	Year               int
+	Color              string
+	Price              float64
+	Mileage            int
+	FuelType           valueobjects.FuelType
+	Transmission       valueobjects.Transmission
+	BodyType           valueobjects.BodyType
+	Condition          valueobjects.VehicleCondition
+	Doors              int
+	EngineDisplacement int
+	LicensePlate       valueobjects.LicensePlate
+	VIN                valueobjects.VIN
+	Status             valueobjects.ListingStatus
+	PublishedAt        *time.Time
+	Version            int
+	CreatedAt          time.Time
+	UpdatedAt          time.Time
+}
+
+// IsPublishedAt tells whether the vehicle is offered in the catalog at now.
+func (ref Vehicle) IsPublishedAt(now time.Time) bool {
+	return ref.Status == valueobjects.ListingStatusPublished && ref.PublishedAt != nil && !ref.PublishedAt.After(now)
+}
+
+// VehicleFilter narrows a vehicle search. Zero fields do not filter, and
+// MaxMileage is a pointer since zero kilometers is a filter of its own.
+// PublishedOnly keeps the vehicles offered by the time of the search, leaving
+// out the ones scheduled for later.
+type VehicleFilter struct {
+	IsSold        *bool
+	PublishedOnly bool
+	Status        valueobjects.ListingStatus
+	FuelType      valueobjects.FuelType
+	Transmission  valueobjects.Transmission
+	BodyType      valueobjects.BodyType
+	Condition     valueobjects.VehicleCondition
+	Doors         int
+	MaxMileage    *int
 }
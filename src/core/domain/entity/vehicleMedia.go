@@ -0,0 +1,22 @@
+package entity
+
+import "time"
+
+// VehicleMedia is a photo of a vehicle. The image and its thumbnail are kept
+// by the media storage under StorageKey and ThumbnailKey. Position orders the
+// photos of a vehicle from zero, and the one marked IsCover is shown first.
+type VehicleMedia struct {
+	ID           int
+	EntityID     string
+	VehicleID    string
+	DealerID     int
+	ContentType  string
+	Size         int64
+	Width        int
+	Height       int
+	Position     int
+	IsCover      bool
+	StorageKey   string
+	ThumbnailKey string
+	CreatedAt    time.Time
+}
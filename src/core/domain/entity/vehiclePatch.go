@@ -0,0 +1,34 @@
+package entity
+
+import valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+
+// Fields of a vehicle a patch may change, named as in the API.
+const (
+	VehicleFieldBrand              = "brand"
+	VehicleFieldModel              = "model"
+	VehicleFieldYear               = "year"
+	VehicleFieldColor              = "color"
+	VehicleFieldPrice              = "price"
+	VehicleFieldMileage            = "mileage"
+	VehicleFieldFuelType           = "fuel_type"
+	VehicleFieldTransmission       = "transmission"
+	VehicleFieldBodyType           = "body_type"
+	VehicleFieldCondition          = "condition"
+	VehicleFieldDoors              = "doors"
+	VehicleFieldEngineDisplacement = "engine_displacement"
+	VehicleFieldLicensePlate       = "license_plate"
+	VehicleFieldVIN                = "vin"
+)
+
+// VehiclePatch lists changes to apply to a vehicle in order, so a test sees
+// the changes listed before it.
+type VehiclePatch []VehicleChange
+
+// VehicleChange holds an int Value for year, mileage, doors and engine
+// displacement, a float64 for price and a string for every other field. Value
+// is unused when removing the field.
+type VehicleChange struct {
+	Operation valueobjects.PatchOperation
+	Field     string
+	Value     any
+}
@@ -0,0 +1,39 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// AmortizationSystem tells how a financing is paid off: PRICE pays the same
+// amount every month, SAC pays off the same part of the principal every month,
+// the installments decreasing along with the interest.
+type AmortizationSystem string
+
+const (
+	AmortizationSystemPrice AmortizationSystem = "PRICE"
+	AmortizationSystemSAC   AmortizationSystem = "SAC"
+)
+
+var amortizationSystems = map[AmortizationSystem]bool{
+	AmortizationSystemPrice: true,
+	AmortizationSystemSAC:   true,
+}
+
+func (ref AmortizationSystem) String() string {
+	return string(ref)
+}
+
+func (ref AmortizationSystem) IsValid() bool {
+	return amortizationSystems[ref]
+}
+
+// ParseAmortizationSystem accepts the amortization system names in any case.
+func ParseAmortizationSystem(value string) (AmortizationSystem, error) {
+	parsed := AmortizationSystem(strings.ToUpper(strings.TrimSpace(value)))
+	if !parsed.IsValid() {
+		return "", fmt.Errorf("unknown amortization system %q", value)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,50 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+)
+
+type BodyType string
+
+const (
+	BodyTypeHatch       BodyType = "HATCH"
+	BodyTypeSedan       BodyType = "SEDAN"
+	BodyTypeSUV         BodyType = "SUV"
+	BodyTypePickup      BodyType = "PICKUP"
+	BodyTypeCoupe       BodyType = "COUPE"
+	BodyTypeConvertible BodyType = "CONVERTIBLE"
+	BodyTypeWagon       BodyType = "WAGON"
+	BodyTypeMinivan     BodyType = "MINIVAN"
+	BodyTypeVan         BodyType = "VAN"
+)
+
+var bodyTypes = map[BodyType]bool{
+	BodyTypeHatch:       true,
+	BodyTypeSedan:       true,
+	BodyTypeSUV:         true,
+	BodyTypePickup:      true,
+	BodyTypeCoupe:       true,
+	BodyTypeConvertible: true,
+	BodyTypeWagon:       true,
+	BodyTypeMinivan:     true,
+	BodyTypeVan:         true,
+}
+
+func (ref BodyType) String() string {
+	return string(ref)
+}
+
+func (ref BodyType) IsValid() bool {
+	return bodyTypes[ref]
+}
+
+// ParseBodyType accepts the body type names in any case.
+func ParseBodyType(value string) (BodyType, error) {
+	parsed := BodyType(strings.ToUpper(strings.TrimSpace(value)))
+	if !parsed.IsValid() {
+		return "", fmt.Errorf("unknown body type %q", value)
+	}
+
+	return parsed, nil
+}
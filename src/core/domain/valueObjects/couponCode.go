@@ -0,0 +1,29 @@
+package valueobjects
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// CouponCode is the code buyers type to redeem a coupon, in upper case, made
+// of letters, digits, hyphens and underscores.
+type CouponCode string
+
+var couponCode = regexp.MustCompile(`^[A-Z0-9_-]{3,32}$`)
+
+func (ref CouponCode) String() string {
+	return string(ref)
+}
+
+// ParseCouponCode accepts codes in any case, so BLACKFRIDAY and blackfriday
+// redeem the same coupon.
+func ParseCouponCode(value string) (CouponCode, error) {
+	code := strings.ToUpper(strings.TrimSpace(value))
+
+	if !couponCode.MatchString(code) {
+		return "", fmt.Errorf("invalid coupon code %q", value)
+	}
+
+	return CouponCode(code), nil
+}
@@ -0,0 +1,26 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseCouponCode(t *testing.T) {
+	t.Run("should parse code in any case", func(t *testing.T) {
+		for _, value := range []string{"BLACK-FRIDAY_10", "black-friday_10", " Black-Friday_10 "} {
+			actual, err := ParseCouponCode(value)
+
+			assert.Nil(t, err, value)
+			assert.Equal(t, CouponCode("BLACK-FRIDAY_10"), actual, value)
+		}
+	})
+
+	t.Run("should not parse invalid code", func(t *testing.T) {
+		for _, value := range []string{"", "AB", "BLACK FRIDAY", "DESCONTO10%", "ABCDEFGHIJKLMNOPQRSTUVWXYZ0123456"} {
+			_, err := ParseCouponCode(value)
+
+			assert.NotNil(t, err, value)
+		}
+	})
+}
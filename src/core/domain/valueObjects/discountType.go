@@ -0,0 +1,38 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DiscountType tells how the value of a coupon is taken off the price: as a
+// percentage of it or as a fixed amount.
+type DiscountType string
+
+const (
+	DiscountTypePercentage DiscountType = "PERCENTAGE"
+	DiscountTypeFixed      DiscountType = "FIXED"
+)
+
+var discountTypes = map[DiscountType]bool{
+	DiscountTypePercentage: true,
+	DiscountTypeFixed:      true,
+}
+
+func (ref DiscountType) String() string {
+	return string(ref)
+}
+
+func (ref DiscountType) IsValid() bool {
+	return discountTypes[ref]
+}
+
+// ParseDiscountType accepts the discount type names in any case.
+func ParseDiscountType(value string) (DiscountType, error) {
+	parsed := DiscountType(strings.ToUpper(strings.TrimSpace(value)))
+	if !parsed.IsValid() {
+		return "", fmt.Errorf("unknown discount type %q", value)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,44 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+)
+
+type FuelType string
+
+const (
+	FuelTypeFlex     FuelType = "FLEX"
+	FuelTypeGasoline FuelType = "GASOLINE"
+	FuelTypeEthanol  FuelType = "ETHANOL"
+	FuelTypeDiesel   FuelType = "DIESEL"
+	FuelTypeElectric FuelType = "ELECTRIC"
+	FuelTypeHybrid   FuelType = "HYBRID"
+)
+
+var fuelTypes = map[FuelType]bool{
+	FuelTypeFlex:     true,
+	FuelTypeGasoline: true,
+	FuelTypeEthanol:  true,
+	FuelTypeDiesel:   true,
+	FuelTypeElectric: true,
+	FuelTypeHybrid:   true,
+}
+
+func (ref FuelType) String() string {
+	return string(ref)
+}
+
+func (ref FuelType) IsValid() bool {
+	return fuelTypes[ref]
+}
+
+// ParseFuelType accepts the fuel type names in any case.
+func ParseFuelType(value string) (FuelType, error) {
+	parsed := FuelType(strings.ToUpper(strings.TrimSpace(value)))
+	if !parsed.IsValid() {
+		return "", fmt.Errorf("unknown fuel type %q", value)
+	}
+
+	return parsed, nil
+}
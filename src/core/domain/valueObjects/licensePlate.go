@@ -0,0 +1,38 @@
+package valueobjects
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// LicensePlate is a Brazilian plate without separators, either in the legacy
+// format, as ABC1234, or in the Mercosul one, as ABC1D23.
+type LicensePlate string
+
+var (
+	legacyPlate   = regexp.MustCompile(`^[A-Z]{3}[0-9]{4}$`)
+	mercosulPlate = regexp.MustCompile(`^[A-Z]{3}[0-9][A-Z][0-9]{2}$`)
+)
+
+func (ref LicensePlate) String() string {
+	return string(ref)
+}
+
+// IsMercosul tells whether the plate is in the Mercosul format.
+func (ref LicensePlate) IsMercosul() bool {
+	return mercosulPlate.MatchString(string(ref))
+}
+
+// ParseLicensePlate accepts plates in any case and with the hyphen or space
+// of the legacy format, as ABC-1234.
+func ParseLicensePlate(value string) (LicensePlate, error) {
+	plate := strings.ToUpper(strings.TrimSpace(value))
+	plate = strings.NewReplacer("-", "", " ", "").Replace(plate)
+
+	if !legacyPlate.MatchString(plate) && !mercosulPlate.MatchString(plate) {
+		return "", fmt.Errorf("invalid license plate %q", value)
+	}
+
+	return LicensePlate(plate), nil
+}
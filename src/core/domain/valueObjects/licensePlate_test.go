@@ -0,0 +1,35 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseLicensePlate(t *testing.T) {
+	t.Run("should parse legacy plate", func(t *testing.T) {
+		for _, value := range []string{"ABC1234", "abc-1234", " ABC 1234 "} {
+			actual, err := ParseLicensePlate(value)
+
+			assert.Nil(t, err, value)
+			assert.Equal(t, LicensePlate("ABC1234"), actual, value)
+			assert.False(t, actual.IsMercosul(), value)
+		}
+	})
+
+	t.Run("should parse mercosul plate", func(t *testing.T) {
+		actual, err := ParseLicensePlate("abc1d23")
+
+		assert.Nil(t, err)
+		assert.Equal(t, LicensePlate("ABC1D23"), actual)
+		assert.True(t, actual.IsMercosul())
+	})
+
+	t.Run("should not parse invalid plate", func(t *testing.T) {
+		for _, value := range []string{"", "AB1234", "ABC12345", "1BC1234", "ABC1DD3", "ABC-1D23X"} {
+			_, err := ParseLicensePlate(value)
+
+			assert.NotNil(t, err, value)
+		}
+	})
+}
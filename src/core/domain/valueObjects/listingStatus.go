@@ -0,0 +1,40 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+)
+
+// ListingStatus tells whether a vehicle is offered in the catalog. Vehicles
+// start as drafts, are published once ready and unlisted when taken down.
+type ListingStatus string
+
+const (
+	ListingStatusDraft     ListingStatus = "DRAFT"
+	ListingStatusPublished ListingStatus = "PUBLISHED"
+	ListingStatusUnlisted  ListingStatus = "UNLISTED"
+)
+
+var listingStatuses = map[ListingStatus]bool{
+	ListingStatusDraft:     true,
+	ListingStatusPublished: true,
+	ListingStatusUnlisted:  true,
+}
+
+func (ref ListingStatus) String() string {
+	return string(ref)
+}
+
+func (ref ListingStatus) IsValid() bool {
+	return listingStatuses[ref]
+}
+
+// ParseListingStatus accepts the listing status names in any case.
+func ParseListingStatus(value string) (ListingStatus, error) {
+	parsed := ListingStatus(strings.ToUpper(strings.TrimSpace(value)))
+	if !parsed.IsValid() {
+		return "", fmt.Errorf("unknown listing status %q", value)
+	}
+
+	return parsed, nil
+}
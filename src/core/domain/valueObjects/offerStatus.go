@@ -0,0 +1,37 @@
+package valueobjects
+
+import "slices"
+
+// OfferStatus is the state of an offer in the negotiation of a vehicle. An
+// offer starts PENDING, and may be COUNTERED by the dealer once before being
+// ACCEPTED or REJECTED. Open offers become EXPIRED when nobody answers them in
+// time.
+type OfferStatus string
+
+const (
+	OfferStatusPending   OfferStatus = "PENDING"
+	OfferStatusCountered OfferStatus = "COUNTERED"
+	OfferStatusAccepted  OfferStatus = "ACCEPTED"
+	OfferStatusRejected  OfferStatus = "REJECTED"
+	OfferStatusExpired   OfferStatus = "EXPIRED"
+)
+
+// offerTransitions lists the statuses each status may move to. Accepted,
+// rejected and expired offers are final.
+var offerTransitions = map[OfferStatus][]OfferStatus{
+	OfferStatusPending:   {OfferStatusCountered, OfferStatusAccepted, OfferStatusRejected, OfferStatusExpired},
+	OfferStatusCountered: {OfferStatusAccepted, OfferStatusRejected, OfferStatusExpired},
+}
+
+func (ref OfferStatus) String() string {
+	return string(ref)
+}
+
+// IsOpen tells whether the offer still waits for an answer.
+func (ref OfferStatus) IsOpen() bool {
+	return ref == OfferStatusPending || ref == OfferStatusCountered
+}
+
+func (ref OfferStatus) CanBecome(next OfferStatus) bool {
+	return slices.Contains(offerTransitions[ref], next)
+}
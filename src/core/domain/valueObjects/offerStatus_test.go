@@ -0,0 +1,32 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOfferStatusCanBecome(t *testing.T) {
+	t.Run("should answer open offers", func(t *testing.T) {
+		assert.True(t, OfferStatusPending.CanBecome(OfferStatusCountered))
+		assert.True(t, OfferStatusPending.CanBecome(OfferStatusAccepted))
+		assert.True(t, OfferStatusCountered.CanBecome(OfferStatusAccepted))
+		assert.True(t, OfferStatusCountered.CanBecome(OfferStatusRejected))
+		assert.True(t, OfferStatusCountered.CanBecome(OfferStatusExpired))
+	})
+
+	t.Run("should counter an offer only once", func(t *testing.T) {
+		assert.False(t, OfferStatusCountered.CanBecome(OfferStatusCountered))
+		assert.False(t, OfferStatusCountered.CanBecome(OfferStatusPending))
+	})
+
+	t.Run("should not change final offers", func(t *testing.T) {
+		for _, status := range []OfferStatus{OfferStatusAccepted, OfferStatusRejected, OfferStatusExpired} {
+			assert.False(t, status.IsOpen(), status)
+
+			for _, next := range []OfferStatus{OfferStatusPending, OfferStatusCountered, OfferStatusAccepted, OfferStatusRejected, OfferStatusExpired} {
+				assert.False(t, status.CanBecome(next), status)
+			}
+		}
+	})
+}
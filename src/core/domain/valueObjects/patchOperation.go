@@ -0,0 +1,15 @@
+package valueobjects
+
+// PatchOperation is what a change of a patch does to its field. Merge patches
+// only replace and remove fields, JSON Patch documents may also test them.
+type PatchOperation string
+
+const (
+	PatchOperationReplace PatchOperation = "replace"
+	PatchOperationRemove  PatchOperation = "remove"
+	PatchOperationTest    PatchOperation = "test"
+)
+
+func (ref PatchOperation) String() string {
+	return string(ref)
+}
@@ -0,0 +1,52 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Role string
+
+// RoleAdmin and RoleDealerStaff act on the dealer of the principal, while
+// RolePlatformAdmin acts on every dealer.
+const (
+	RolePlatformAdmin  Role = "PLATFORM_ADMIN"
+	RoleAdmin          Role = "ADMIN"
+	RoleDealerStaff    Role = "DEALER_STAFF"
+	RoleBuyer          Role = "BUYER"
+	RolePaymentsSystem Role = "PAYMENTS_SYSTEM"
+)
+
+var roles = map[Role]bool{
+	RolePlatformAdmin:  true,
+	RoleAdmin:          true,
+	RoleDealerStaff:    true,
+	RoleBuyer:          true,
+	RolePaymentsSystem: true,
+}
+
+func (ref Role) String() string {
+	return string(ref)
+}
+
+// IsDealerRole tells whether the role only makes sense bound to a dealer.
+func (ref Role) IsDealerRole() bool {
+	return ref == RoleAdmin || ref == RoleDealerStaff
+}
+
+// IsPlatformRole tells whether the role reaches every dealer, so only a
+// platform admin may grant it.
+func (ref Role) IsPlatformRole() bool {
+	return ref == RolePlatformAdmin || ref == RolePaymentsSystem
+}
+
+// ParseRole accepts the role names in any case, as they come from tokens and
+// command line flags.
+func ParseRole(value string) (Role, error) {
+	role := Role(strings.ToUpper(strings.TrimSpace(value)))
+	if !roles[role] {
+		return "", fmt.Errorf("unknown role %q", value)
+	}
+
+	return role, nil
+}
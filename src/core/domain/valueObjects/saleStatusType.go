@@ -5,6 +5,7 @@ type SaleStatusType string
 const (
 	SaleStatusTypeApproved SaleStatusType = "APPROVED"
 	SaleStatusTypePending  SaleStatusType = "PENDING"
+	SaleStatusTypeRejected SaleStatusType = "REJECTED"
 )
 
 func (ref SaleStatusType) String() string {
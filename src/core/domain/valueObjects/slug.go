@@ -0,0 +1,58 @@
+package valueobjects
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/text/runes"
+	"golang.org/x/text/transform"
+	"golang.org/x/text/unicode/norm"
+)
+
+// Slug names a vehicle in URLs, as in 2021-toyota-corolla-prata-3f9a1c. The
+// suffix is taken from a hash of the entity id, so vehicles alike still get
+// distinct slugs.
+type Slug string
+
+const slugSuffixLength = 6
+
+func (ref Slug) String() string {
+	return string(ref)
+}
+
+// NewVehicleSlug lowercases the words of the vehicle, drops their accents and
+// joins them with hyphens, leaving out anything but ASCII letters and digits.
+func NewVehicleSlug(year int, brand, model, color, entityID string) Slug {
+	hash := sha256.Sum256([]byte(entityID))
+
+	words := []string{strconv.Itoa(year), brand, model, color, hex.EncodeToString(hash[:])[:slugSuffixLength]}
+
+	var slug strings.Builder
+
+	for _, word := range words {
+		for _, part := range strings.FieldsFunc(removeAccents(word), isNotSlugRune) {
+			if slug.Len() > 0 {
+				slug.WriteByte('-')
+			}
+			slug.WriteString(strings.ToLower(part))
+		}
+	}
+
+	return Slug(slug.String())
+}
+
+func removeAccents(value string) string {
+	stripped, _, err := transform.String(transform.Chain(norm.NFD, runes.Remove(runes.In(unicode.Mn))), value)
+	if err != nil {
+		return value
+	}
+
+	return stripped
+}
+
+func isNotSlugRune(r rune) bool {
+	return !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9')
+}
@@ -0,0 +1,30 @@
+package valueobjects
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewVehicleSlug(t *testing.T) {
+	t.Run("should join the words of the vehicle with a suffix from its id", func(t *testing.T) {
+		actual := NewVehicleSlug(2021, "Toyota", "Corolla", "Prata", "some-entity-id")
+
+		assert.Regexp(t, `^2021-toyota-corolla-prata-[0-9a-f]{6}$`, actual.String())
+	})
+
+	t.Run("should drop accents and symbols", func(t *testing.T) {
+		actual := NewVehicleSlug(2019, "Citroën", "C4  Cactus / Feel", "Azul Céu", "some-entity-id")
+
+		assert.True(t, strings.HasPrefix(actual.String(), "2019-citroen-c4-cactus-feel-azul-ceu-"), actual)
+	})
+
+	t.Run("should give vehicles alike distinct slugs", func(t *testing.T) {
+		first := NewVehicleSlug(2021, "Toyota", "Corolla", "Prata", "first-entity-id")
+		second := NewVehicleSlug(2021, "Toyota", "Corolla", "Prata", "second-entity-id")
+
+		assert.NotEqual(t, first, second)
+		assert.Equal(t, first, NewVehicleSlug(2021, "Toyota", "Corolla", "Prata", "first-entity-id"))
+	})
+}
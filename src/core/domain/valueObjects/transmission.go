@@ -0,0 +1,42 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+)
+
+type Transmission string
+
+// TransmissionAutomated is an automated manual gearbox, sold in Brazil apart
+// from the torque converter automatics.
+const (
+	TransmissionManual    Transmission = "MANUAL"
+	TransmissionAutomatic Transmission = "AUTOMATIC"
+	TransmissionAutomated Transmission = "AUTOMATED"
+	TransmissionCVT       Transmission = "CVT"
+)
+
+var transmissions = map[Transmission]bool{
+	TransmissionManual:    true,
+	TransmissionAutomatic: true,
+	TransmissionAutomated: true,
+	TransmissionCVT:       true,
+}
+
+func (ref Transmission) String() string {
+	return string(ref)
+}
+
+func (ref Transmission) IsValid() bool {
+	return transmissions[ref]
+}
+
+// ParseTransmission accepts the transmission names in any case.
+func ParseTransmission(value string) (Transmission, error) {
+	parsed := Transmission(strings.ToUpper(strings.TrimSpace(value)))
+	if !parsed.IsValid() {
+		return "", fmt.Errorf("unknown transmission %q", value)
+	}
+
+	return parsed, nil
+}
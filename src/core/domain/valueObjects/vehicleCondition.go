@@ -0,0 +1,40 @@
+package valueobjects
+
+import (
+	"fmt"
+	"strings"
+)
+
+type VehicleCondition string
+
+// VehicleConditionCertified is a used vehicle inspected and warranted by the
+// dealer.
+const (
+	VehicleConditionNew       VehicleCondition = "NEW"
+	VehicleConditionUsed      VehicleCondition = "USED"
+	VehicleConditionCertified VehicleCondition = "CERTIFIED"
+)
+
+var vehicleConditions = map[VehicleCondition]bool{
+	VehicleConditionNew:       true,
+	VehicleConditionUsed:      true,
+	VehicleConditionCertified: true,
+}
+
+func (ref VehicleCondition) String() string {
+	return string(ref)
+}
+
+func (ref VehicleCondition) IsValid() bool {
+	return vehicleConditions[ref]
+}
+
+// ParseVehicleCondition accepts the vehicle condition names in any case.
+func ParseVehicleCondition(value string) (VehicleCondition, error) {
+	parsed := VehicleCondition(strings.ToUpper(strings.TrimSpace(value)))
+	if !parsed.IsValid() {
+		return "", fmt.Errorf("unknown vehicle condition %q", value)
+	}
+
+	return parsed, nil
+}
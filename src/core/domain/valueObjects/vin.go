@@ -0,0 +1,29 @@
+package valueobjects
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// VIN is the 17 character vehicle identification number of ISO 3779, which
+// leaves out the letters I, O and Q. The check digit is not verified, as only
+// North American VINs carry one.
+type VIN string
+
+var vinFormat = regexp.MustCompile(`^[A-HJ-NPR-Z0-9]{17}$`)
+
+func (ref VIN) String() string {
+	return string(ref)
+}
+
+// ParseVIN accepts VINs in any case.
+func ParseVIN(value string) (VIN, error) {
+	vin := strings.ToUpper(strings.TrimSpace(value))
+
+	if !vinFormat.MatchString(vin) {
+		return "", fmt.Errorf("invalid vin %q", value)
+	}
+
+	return VIN(vin), nil
+}
@@ -0,0 +1,24 @@
+package valueobjects
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseVIN(t *testing.T) {
+	t.Run("should parse vin in any case", func(t *testing.T) {
+		actual, err := ParseVIN(" 9bwzzz377vt004251 ")
+
+		assert.Nil(t, err)
+		assert.Equal(t, VIN("9BWZZZ377VT004251"), actual)
+	})
+
+	t.Run("should not parse invalid vin", func(t *testing.T) {
+		for _, value := range []string{"", "9BWZZZ377VT00425", "9BWZZZ377VT0042510", "9BWZZZ377VT00425I", "9BWZZZ377VT00425O", "9BWZZZ377VT00425Q", "9BWZZZ377VT00425-"} {
+			_, err := ParseVIN(value)
+
+			assert.NotNil(t, err, value)
+		}
+	})
+}
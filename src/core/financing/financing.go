@@ -0,0 +1,147 @@
+// Package financing builds the amortization tables of financed sales and the
+// simulations offered before buying. Amounts are worked out as decimals, with
+// rationals kept in cents, and rounded to the cent once per installment, the
+// last one taking what is left, so a table always pays off its principal to
+// the cent.
+package financing
+
+import (
+	"fmt"
+	"math/big"
+	"strconv"
+	"time"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// Schedule amortizes principal in months monthly installments under system, at
+// monthlyInterestRate percent a month. The n-th installment is due n months
+// after start, on the same day of the month or on its last day when shorter.
+func Schedule(system valueobjects.AmortizationSystem, principal, monthlyInterestRate float64, months int, start time.Time) ([]entity.Installment, error) {
+	if !system.IsValid() {
+		return nil, fmt.Errorf("%w: unknown amortization system %q", domainerrors.ErrInvalidArgument, system)
+	}
+
+	if principal <= 0 || months <= 0 || monthlyInterestRate < 0 {
+		return nil, fmt.Errorf("%w: financing needs a positive principal and months and a rate not below zero", domainerrors.ErrInvalidArgument)
+	}
+
+	balance, err := toCents(principal)
+	if err != nil {
+		return nil, err
+	}
+
+	rate, err := decimal(monthlyInterestRate)
+	if err != nil {
+		return nil, err
+	}
+
+	rate.Quo(rate, big.NewRat(100, 1))
+
+	var payment, amortization int64
+
+	switch system {
+	case valueobjects.AmortizationSystemPrice:
+		payment = pricePayment(balance, rate, months)
+	case valueobjects.AmortizationSystemSAC:
+		amortization = round(big.NewRat(balance, int64(months)))
+	}
+
+	installments := make([]entity.Installment, 0, months)
+
+	for number := 1; number <= months; number++ {
+		interest := round(new(big.Rat).Mul(new(big.Rat).SetInt64(balance), rate))
+
+		if system == valueobjects.AmortizationSystemPrice {
+			amortization = payment - interest
+		}
+
+		paidOff := amortization
+		if number == months || paidOff > balance {
+			paidOff = balance
+		}
+
+		balance -= paidOff
+
+		installments = append(installments, entity.Installment{
+			Number:       number,
+			DueDate:      addMonths(start, number),
+			Amount:       fromCents(interest + paidOff),
+			Interest:     fromCents(interest),
+			Amortization: fromCents(paidOff),
+			Balance:      fromCents(balance),
+		})
+	}
+
+	return installments, nil
+}
+
+// pricePayment is the fixed installment of the Price system, in cents:
+// principal * rate / (1 - (1 + rate)^-months).
+func pricePayment(principal int64, rate *big.Rat, months int) int64 {
+	if rate.Sign() == 0 {
+		return round(big.NewRat(principal, int64(months)))
+	}
+
+	growth := new(big.Rat).Add(big.NewRat(1, 1), rate)
+	factor := big.NewRat(1, 1)
+
+	for range months {
+		factor.Mul(factor, growth)
+	}
+
+	payment := new(big.Rat).Mul(new(big.Rat).SetInt64(principal), rate)
+	payment.Mul(payment, factor)
+	payment.Quo(payment, new(big.Rat).Sub(factor, big.NewRat(1, 1)))
+
+	return round(payment)
+}
+
+// decimal reads value as the decimal it is written as, 1.99 being 199/100
+// rather than the binary fraction closest to it. NaN and the infinities have
+// no decimal and are refused.
+func decimal(value float64) (*big.Rat, error) {
+	rat, ok := new(big.Rat).SetString(strconv.FormatFloat(value, 'f', -1, 64))
+	if !ok {
+		return nil, fmt.Errorf("%w: %v is not a finite number", domainerrors.ErrInvalidArgument, value)
+	}
+
+	return rat, nil
+}
+
+func toCents(value float64) (int64, error) {
+	rat, err := decimal(value)
+	if err != nil {
+		return 0, err
+	}
+
+	return round(rat.Mul(rat, big.NewRat(100, 1))), nil
+}
+
+func fromCents(cents int64) float64 {
+	return float64(cents) / 100
+}
+
+// round rounds value to an integer, halves away from zero.
+func round(value *big.Rat) int64 {
+	twice := new(big.Int).Lsh(value.Num(), 1)
+
+	if value.Sign() < 0 {
+		twice.Sub(twice, value.Denom())
+	} else {
+		twice.Add(twice, value.Denom())
+	}
+
+	return twice.Quo(twice, new(big.Int).Lsh(value.Denom(), 1)).Int64()
+}
+
+func addMonths(date time.Time, months int) time.Time {
+	year, month, day := date.UTC().Date()
+
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, time.UTC)
+	last := first.AddDate(0, 1, -1).Day()
+
+	return time.Date(first.Year(), first.Month(), min(day, last), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,97 @@
+package financing
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestSchedule(t *testing.T) {
+	start := time.Date(2026, time.January, 31, 15, 4, 5, 0, time.UTC)
+
+	t.Run("should amortize with fixed installments under price", func(t *testing.T) {
+		actual, err := Schedule(valueobjects.AmortizationSystemPrice, 10000, 1, 12, start)
+
+		assert.Nil(t, err)
+		assert.Len(t, actual, 12)
+		assert.Equal(t, entity.Installment{
+			Number:       1,
+			DueDate:      time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC),
+			Amount:       888.49,
+			Interest:     100,
+			Amortization: 788.49,
+			Balance:      9211.51,
+		}, actual[0])
+		assert.Equal(t, time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC), actual[1].DueDate)
+
+		for _, installment := range actual[:11] {
+			assert.Equal(t, 888.49, installment.Amount)
+		}
+
+		last := actual[11]
+		assert.Equal(t, time.Date(2027, time.January, 31, 0, 0, 0, 0, time.UTC), last.DueDate)
+		assert.InDelta(t, 888.49, last.Amount, 0.05)
+		assert.Zero(t, last.Balance)
+		assert.Equal(t, 10000.0, totalAmortization(actual))
+	})
+
+	t.Run("should amortize the same part of the principal under sac", func(t *testing.T) {
+		actual, err := Schedule(valueobjects.AmortizationSystemSAC, 10000, 1, 12, start)
+
+		assert.Nil(t, err)
+		assert.Len(t, actual, 12)
+		assert.Equal(t, 933.33, actual[0].Amount)
+		assert.Equal(t, 100.0, actual[0].Interest)
+		assert.Equal(t, 833.33, actual[0].Amortization)
+		assert.Equal(t, 925.0, actual[1].Amount)
+		assert.Equal(t, 833.37, actual[11].Amortization)
+		assert.Equal(t, 841.70, actual[11].Amount)
+		assert.Zero(t, actual[11].Balance)
+		assert.Equal(t, 10000.0, totalAmortization(actual))
+	})
+
+	t.Run("should split the principal evenly without interest", func(t *testing.T) {
+		actual, err := Schedule(valueobjects.AmortizationSystemPrice, 1000, 0, 3, start)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []float64{333.33, 333.33, 333.34}, []float64{actual[0].Amount, actual[1].Amount, actual[2].Amount})
+		assert.Zero(t, actual[2].Balance)
+	})
+
+	t.Run("should not amortize without principal or months", func(t *testing.T) {
+		_, err := Schedule(valueobjects.AmortizationSystemPrice, 0, 1, 12, start)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+
+		_, err = Schedule(valueobjects.AmortizationSystemSAC, 10000, 1, 0, start)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+	})
+
+	t.Run("should not amortize amounts that are not finite", func(t *testing.T) {
+		_, err := Schedule(valueobjects.AmortizationSystemPrice, math.NaN(), 1, 12, start)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+
+		_, err = Schedule(valueobjects.AmortizationSystemPrice, 10000, math.Inf(1), 12, start)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+	})
+
+	t.Run("should not amortize under unknown system", func(t *testing.T) {
+		_, err := Schedule("GERMAN", 10000, 1, 12, start)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+	})
+}
+
+func totalAmortization(installments []entity.Installment) float64 {
+	var cents int64
+	for _, installment := range installments {
+		amortization, _ := toCents(installment.Amortization)
+		cents += amortization
+	}
+
+	return fromCents(cents)
+}
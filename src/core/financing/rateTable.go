@@ -0,0 +1,82 @@
+package financing
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// Rate is the monthly interest rate, in percent, of the terms up to MaxMonths.
+type Rate struct {
+	MaxMonths           int
+	MonthlyInterestRate float64
+}
+
+// RateTable holds the rates by term, ordered by MaxMonths. A term takes the
+// rate of the shortest entry covering it.
+type RateTable []Rate
+
+// ParseRateTable reads a table written as "months:rate" pairs separated by
+// commas, such as "12:1.49,24:1.59".
+func ParseRateTable(value string) (RateTable, error) {
+	var table RateTable
+
+	for _, pair := range strings.Split(value, ",") {
+		months, rate, found := strings.Cut(strings.TrimSpace(pair), ":")
+		if !found {
+			return nil, fmt.Errorf("rate %q is not written as months:rate", pair)
+		}
+
+		maxMonths, err := strconv.Atoi(strings.TrimSpace(months))
+		if err != nil || maxMonths <= 0 {
+			return nil, fmt.Errorf("rate %q must have a positive number of months", pair)
+		}
+
+		monthlyInterestRate, err := strconv.ParseFloat(strings.TrimSpace(rate), 64)
+		if err != nil || math.IsNaN(monthlyInterestRate) || math.IsInf(monthlyInterestRate, 0) || monthlyInterestRate < 0 || monthlyInterestRate >= 100 {
+			return nil, fmt.Errorf("rate %q must be from 0 to below 100 percent", pair)
+		}
+
+		table = append(table, Rate{MaxMonths: maxMonths, MonthlyInterestRate: monthlyInterestRate})
+	}
+
+	slices.SortFunc(table, func(a, b Rate) int {
+		return a.MaxMonths - b.MaxMonths
+	})
+
+	for i := 1; i < len(table); i++ {
+		if table[i].MaxMonths == table[i-1].MaxMonths {
+			return nil, fmt.Errorf("rate table has %d months twice", table[i].MaxMonths)
+		}
+	}
+
+	if len(table) == 0 {
+		return nil, errors.New("rate table is empty")
+	}
+
+	return table, nil
+}
+
+// RateFor returns the monthly interest rate of a term of months, false when
+// the term is longer than the table reaches.
+func (ref RateTable) RateFor(months int) (float64, bool) {
+	for _, rate := range ref {
+		if months <= rate.MaxMonths {
+			return rate.MonthlyInterestRate, true
+		}
+	}
+
+	return 0, false
+}
+
+// MaxMonths is the longest term the table has a rate for.
+func (ref RateTable) MaxMonths() int {
+	if len(ref) == 0 {
+		return 0
+	}
+
+	return ref[len(ref)-1].MaxMonths
+}
@@ -0,0 +1,53 @@
+package financing
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseRateTable(t *testing.T) {
+	t.Run("should parse rates ordered by months", func(t *testing.T) {
+		actual, err := ParseRateTable("24:1.59, 12:1.49,48:1.79")
+
+		assert.Nil(t, err)
+		assert.Equal(t, RateTable{
+			{MaxMonths: 12, MonthlyInterestRate: 1.49},
+			{MaxMonths: 24, MonthlyInterestRate: 1.59},
+			{MaxMonths: 48, MonthlyInterestRate: 1.79},
+		}, actual)
+	})
+
+	t.Run("should not parse malformed tables", func(t *testing.T) {
+		for _, value := range []string{"", "12", "0:1.49", "x:1.49", "12:abc", "12:-1", "12:100", "12:NaN", "12:Inf", "12:-Inf", "12:1.49,12:1.59"} {
+			actual, err := ParseRateTable(value)
+
+			assert.Nil(t, actual, value)
+			assert.NotNil(t, err, value)
+		}
+	})
+}
+
+func TestRateTableRateFor(t *testing.T) {
+	table := RateTable{
+		{MaxMonths: 12, MonthlyInterestRate: 1.49},
+		{MaxMonths: 24, MonthlyInterestRate: 1.59},
+	}
+
+	t.Run("should take the rate of the shortest term covering the months", func(t *testing.T) {
+		for months, expected := range map[int]float64{1: 1.49, 12: 1.49, 13: 1.59, 24: 1.59} {
+			actual, ok := table.RateFor(months)
+
+			assert.True(t, ok)
+			assert.Equal(t, expected, actual)
+		}
+
+		assert.Equal(t, 24, table.MaxMonths())
+	})
+
+	t.Run("should not have a rate for terms beyond the table", func(t *testing.T) {
+		_, ok := table.RateFor(25)
+
+		assert.False(t, ok)
+	})
+}
@@ -0,0 +1,185 @@
+package financing
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// cetIterations halves the range of the CET that many times, well below the
+// hundredth of a percent it is shown with.
+const cetIterations = 40
+
+// simulatedSystems are the amortization systems a simulation compares.
+var simulatedSystems = []valueobjects.AmortizationSystem{
+	valueobjects.AmortizationSystemPrice,
+	valueobjects.AmortizationSystemSAC,
+}
+
+// Terms are what simulations are made on: the Rates by term and the costs
+// financed along with the principal, a RegistrationFee and the IOF tax, of
+// IOFPercentage percent of the principal.
+type Terms struct {
+	Rates           RateTable
+	RegistrationFee float64
+	IOFPercentage   float64
+}
+
+// Simulate finances price less downPayment in months installments under each
+// amortization system, at the rate the table has for the term. Installments
+// are due monthly from start, as in Schedule.
+func Simulate(terms Terms, price, downPayment float64, months int, start time.Time) (*entity.FinancingSimulation, error) {
+	priceCents, err := toCents(price)
+	if err != nil {
+		return nil, err
+	}
+
+	downPaymentCents, err := toCents(downPayment)
+	if err != nil {
+		return nil, err
+	}
+
+	if priceCents <= 0 {
+		return nil, fmt.Errorf("%w: vehicle has no price to finance", domainerrors.ErrInvalidArgument)
+	}
+
+	if downPaymentCents < 0 || downPaymentCents >= priceCents {
+		return nil, fmt.Errorf("%w: down payment must be from 0 to below the price of %.2f", domainerrors.ErrInvalidArgument, price)
+	}
+
+	rate, ok := terms.Rates.RateFor(months)
+	if months <= 0 || !ok {
+		return nil, fmt.Errorf("%w: months must be from 1 to %d", domainerrors.ErrInvalidArgument, terms.Rates.MaxMonths())
+	}
+
+	principal := priceCents - downPaymentCents
+
+	registrationFee, err := toCents(terms.RegistrationFee)
+	if err != nil {
+		return nil, err
+	}
+
+	iof, err := decimal(terms.IOFPercentage)
+	if err != nil {
+		return nil, err
+	}
+
+	iof.Mul(iof, new(big.Rat).SetInt64(principal))
+	fees := registrationFee + round(iof.Quo(iof, big.NewRat(100, 1)))
+
+	simulation := &entity.FinancingSimulation{
+		Price:               fromCents(priceCents),
+		DownPayment:         fromCents(downPaymentCents),
+		Principal:           fromCents(principal),
+		Fees:                fromCents(fees),
+		AmountFinanced:      fromCents(principal + fees),
+		Months:              months,
+		MonthlyInterestRate: rate,
+	}
+
+	for _, system := range simulatedSystems {
+		installments, err := Schedule(system, fromCents(principal+fees), rate, months, start)
+		if err != nil {
+			return nil, err
+		}
+
+		payments := make([]int64, len(installments))
+		var paid, interest int64
+
+		for i, installment := range installments {
+			if payments[i], err = toCents(installment.Amount); err != nil {
+				return nil, err
+			}
+
+			installmentInterest, err := toCents(installment.Interest)
+			if err != nil {
+				return nil, err
+			}
+
+			paid += payments[i]
+			interest += installmentInterest
+		}
+
+		monthlyCET, annualCET := cet(principal, payments)
+
+		simulation.Options = append(simulation.Options, entity.FinancingOption{
+			AmortizationSystem: system,
+			MonthlyPayment:     installments[0].Amount,
+			LastPayment:        installments[len(installments)-1].Amount,
+			TotalInterest:      fromCents(interest),
+			TotalCost:          fromCents(downPaymentCents + paid),
+			MonthlyCET:         monthlyCET,
+			AnnualCET:          annualCET,
+			Installments:       installments,
+		})
+	}
+
+	return simulation, nil
+}
+
+// cet is the effective total cost of a financing that releases released cents
+// and is paid off by payments, one a month: the monthly rate at which the
+// payments, discounted, are worth what was released, found by bisection, and
+// its annual equivalent. Both are in percent, rounded to two decimals.
+func cet(released int64, payments []int64) (monthly, annual float64) {
+	low, high := new(big.Rat), big.NewRat(1, 1)
+
+	for worthMore(high, released, payments) {
+		high.Mul(high, big.NewRat(2, 1))
+	}
+
+	for range cetIterations {
+		middle := new(big.Rat).Add(low, high)
+		middle.Quo(middle, big.NewRat(2, 1))
+
+		if worthMore(middle, released, payments) {
+			low = middle
+		} else {
+			high = middle
+		}
+	}
+
+	rate := new(big.Rat).Add(low, high)
+	rate.Quo(rate, big.NewRat(2, 1))
+
+	yearly := new(big.Rat).Sub(power(new(big.Rat).Add(big.NewRat(1, 1), rate), 12), big.NewRat(1, 1))
+
+	return percent(rate), percent(yearly)
+}
+
+// worthMore tells whether payments discounted at rate are worth more than
+// released. Both sides are carried to the last payment, which keeps the
+// comparison free of divisions.
+func worthMore(rate *big.Rat, released int64, payments []int64) bool {
+	growth := new(big.Rat).Add(big.NewRat(1, 1), rate)
+
+	carried := new(big.Rat)
+	for _, payment := range payments {
+		carried.Mul(carried, growth)
+		carried.Add(carried, new(big.Rat).SetInt64(payment))
+	}
+
+	owed := power(growth, len(payments))
+	owed.Mul(owed, new(big.Rat).SetInt64(released))
+
+	return carried.Cmp(owed) > 0
+}
+
+func power(base *big.Rat, exponent int) *big.Rat {
+	result := big.NewRat(1, 1)
+
+	for range exponent {
+		result.Mul(result, base)
+	}
+
+	return result
+}
+
+// percent writes rate in percent, rounded to two decimals.
+func percent(rate *big.Rat) float64 {
+	return fromCents(round(new(big.Rat).Mul(rate, big.NewRat(10000, 1))))
+}
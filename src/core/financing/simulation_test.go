@@ -0,0 +1,118 @@
+package financing
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestSimulate(t *testing.T) {
+	start := time.Date(2026, time.January, 31, 15, 4, 5, 0, time.UTC)
+
+	terms := Terms{
+		Rates: RateTable{
+			{MaxMonths: 12, MonthlyInterestRate: 1.49},
+			{MaxMonths: 24, MonthlyInterestRate: 1.59},
+		},
+		RegistrationFee: 500,
+		IOFPercentage:   3,
+	}
+
+	t.Run("should finance the fees along with the principal under both systems", func(t *testing.T) {
+		actual, err := Simulate(terms, 60000, 12000, 12, start)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 48000.0, actual.Principal)
+		assert.Equal(t, 1940.0, actual.Fees)
+		assert.Equal(t, 49940.0, actual.AmountFinanced)
+		assert.Equal(t, 1.49, actual.MonthlyInterestRate)
+		assert.Len(t, actual.Options, 2)
+
+		price := actual.Options[0]
+		assert.Equal(t, valueobjects.AmortizationSystemPrice, price.AmortizationSystem)
+		assert.Equal(t, 4575.65, price.MonthlyPayment)
+		assert.Equal(t, 4575.62, price.LastPayment)
+		assert.Equal(t, 4967.77, price.TotalInterest)
+		assert.Equal(t, 66907.77, price.TotalCost)
+		assert.Equal(t, 2.13, price.MonthlyCET)
+		assert.Equal(t, 28.8, price.AnnualCET)
+		assert.Len(t, price.Installments, 12)
+		assert.Equal(t, time.Date(2026, time.February, 28, 0, 0, 0, 0, time.UTC), price.Installments[0].DueDate)
+
+		sac := actual.Options[1]
+		assert.Equal(t, valueobjects.AmortizationSystemSAC, sac.AmortizationSystem)
+		assert.Greater(t, sac.MonthlyPayment, sac.LastPayment)
+		assert.Less(t, sac.TotalInterest, price.TotalInterest)
+		assert.Equal(t, 49940.0, totalAmortization(sac.Installments))
+	})
+
+	t.Run("should have the rate as cet without fees", func(t *testing.T) {
+		actual, err := Simulate(Terms{Rates: terms.Rates}, 60000, 0, 24, start)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1.59, actual.MonthlyInterestRate)
+
+		for _, option := range actual.Options {
+			assert.Equal(t, 1.59, option.MonthlyCET)
+			assert.Equal(t, 20.84, option.AnnualCET)
+		}
+	})
+
+	t.Run("should have no cost beyond the price without interest", func(t *testing.T) {
+		actual, err := Simulate(Terms{Rates: RateTable{{MaxMonths: 10, MonthlyInterestRate: 0}}}, 1000, 100, 3, start)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1000.0, actual.Options[0].TotalCost)
+		assert.Zero(t, actual.Options[0].MonthlyCET)
+		assert.Zero(t, actual.Options[0].AnnualCET)
+	})
+
+	t.Run("should not simulate down payments out of the price", func(t *testing.T) {
+		for _, downPayment := range []float64{-1, 60000, 70000} {
+			actual, err := Simulate(terms, 60000, downPayment, 12, start)
+
+			assert.Nil(t, actual)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		}
+	})
+
+	t.Run("should not simulate terms out of the rate table", func(t *testing.T) {
+		for _, months := range []int{0, 25} {
+			actual, err := Simulate(terms, 60000, 12000, months, start)
+
+			assert.Nil(t, actual)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		}
+	})
+
+	t.Run("should not simulate amounts that are not finite", func(t *testing.T) {
+		for _, value := range []float64{math.NaN(), math.Inf(1), math.Inf(-1)} {
+			actual, err := Simulate(terms, 60000, value, 12, start)
+
+			assert.Nil(t, actual)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+
+			actual, err = Simulate(terms, value, 0, 12, start)
+
+			assert.Nil(t, actual)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+
+			actual, err = Simulate(Terms{Rates: terms.Rates, IOFPercentage: value}, 60000, 0, 12, start)
+
+			assert.Nil(t, actual)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		}
+	})
+
+	t.Run("should not simulate vehicle without price", func(t *testing.T) {
+		actual, err := Simulate(terms, 0, 0, 12, start)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+	})
+}
@@ -0,0 +1,40 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type APIKey struct {
+	ID        int        `json:"id"`
+	DealerID  int        `json:"dealer_id,omitempty"`
+	Name      string     `json:"name"`
+	Prefix    string     `json:"prefix"`
+	Roles     []string   `json:"roles"`
+	CreatedAt time.Time  `json:"created_at"`
+	RevokedAt *time.Time `json:"revoked_at,omitempty"`
+}
+
+// CreatedAPIKey is the only response carrying the key itself.
+type CreatedAPIKey struct {
+	APIKey
+	Key string `json:"key"`
+}
+
+func APIKeyFromDomain(apiKey entity.APIKey) APIKey {
+	roles := make([]string, len(apiKey.Roles))
+	for i, role := range apiKey.Roles {
+		roles[i] = role.String()
+	}
+
+	return APIKey{
+		ID:        apiKey.ID,
+		DealerID:  apiKey.DealerID,
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		Roles:     roles,
+		CreatedAt: apiKey.CreatedAt,
+		RevokedAt: apiKey.RevokedAt,
+	}
+}
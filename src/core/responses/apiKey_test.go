@@ -0,0 +1,37 @@
+package responses
+
+import (
+	"testing"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeyFromDomain(t *testing.T) {
+	now := time.Now()
+
+	apiKey := entity.APIKey{
+		ID:        1,
+		DealerID:  3,
+		Name:      "backoffice",
+		Prefix:    "vps_abcdefgh",
+		KeyHash:   "hash",
+		Roles:     []valueobjects.Role{valueobjects.RoleAdmin, valueobjects.RoleDealerStaff},
+		CreatedAt: now,
+	}
+
+	expected := APIKey{
+		ID:        1,
+		DealerID:  3,
+		Name:      "backoffice",
+		Prefix:    "vps_abcdefgh",
+		Roles:     []string{"ADMIN", "DEALER_STAFF"},
+		CreatedAt: now,
+	}
+
+	actual := APIKeyFromDomain(apiKey)
+
+	assert.Equal(t, expected, actual)
+}
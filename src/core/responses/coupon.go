@@ -0,0 +1,45 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type Coupon struct {
+	ID            int        `json:"id"`
+	DealerID      int        `json:"dealer_id"`
+	Code          string     `json:"code"`
+	DiscountType  string     `json:"discount_type" enums:"PERCENTAGE,FIXED"`
+	DiscountValue float64    `json:"discount_value"`
+	MaxUses       int        `json:"max_uses"`
+	Uses          int        `json:"uses"`
+	ValidFrom     *time.Time `json:"valid_from,omitempty"`
+	ValidUntil    *time.Time `json:"valid_until,omitempty"`
+	Brand         string     `json:"brand,omitempty"`
+	Model         string     `json:"model,omitempty"`
+	MinYear       int        `json:"min_year,omitempty"`
+	MaxYear       int        `json:"max_year,omitempty"`
+	CreatedAt     time.Time  `json:"created_at"`
+	UpdatedAt     time.Time  `json:"updated_at"`
+}
+
+func CouponFromDomain(coupon entity.Coupon) Coupon {
+	return Coupon{
+		ID:            coupon.ID,
+		DealerID:      coupon.DealerID,
+		Code:          coupon.Code.String(),
+		DiscountType:  coupon.DiscountType.String(),
+		DiscountValue: coupon.DiscountValue,
+		MaxUses:       coupon.MaxUses,
+		Uses:          coupon.Uses,
+		ValidFrom:     coupon.ValidFrom,
+		ValidUntil:    coupon.ValidUntil,
+		Brand:         coupon.Brand,
+		Model:         coupon.Model,
+		MinYear:       coupon.MinYear,
+		MaxYear:       coupon.MaxYear,
+		CreatedAt:     coupon.CreatedAt,
+		UpdatedAt:     coupon.UpdatedAt,
+	}
+}
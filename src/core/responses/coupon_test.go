@@ -0,0 +1,50 @@
+package responses
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestCouponFromDomain(t *testing.T) {
+	now := time.Now()
+	validUntil := now.Add(24 * time.Hour)
+
+	coupon := entity.Coupon{
+		ID:            1,
+		DealerID:      3,
+		Code:          "BLACKFRIDAY",
+		DiscountType:  valueobjects.DiscountTypePercentage,
+		DiscountValue: 10,
+		MaxUses:       100,
+		Uses:          4,
+		ValidUntil:    &validUntil,
+		Brand:         "Fiat",
+		MinYear:       2018,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	expected := Coupon{
+		ID:            1,
+		DealerID:      3,
+		Code:          "BLACKFRIDAY",
+		DiscountType:  "PERCENTAGE",
+		DiscountValue: 10,
+		MaxUses:       100,
+		Uses:          4,
+		ValidUntil:    &validUntil,
+		Brand:         "Fiat",
+		MinYear:       2018,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	actual := CouponFromDomain(coupon)
+
+	assert.Equal(t, expected, actual)
+}
@@ -0,0 +1,21 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type Dealer struct {
+	ID        int       `json:"id"`
+	Name      string    `json:"name"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+func DealerFromDomain(dealer entity.Dealer) Dealer {
+	return Dealer{
+		ID:        dealer.ID,
+		Name:      dealer.Name,
+		CreatedAt: dealer.CreatedAt,
+	}
+}
@@ -0,0 +1,33 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type FinancingPlan struct {
+	ID                       int       `json:"id"`
+	DealerID                 int       `json:"dealer_id"`
+	Name                     string    `json:"name"`
+	AmortizationSystem       string    `json:"amortization_system" enums:"PRICE,SAC"`
+	MonthlyInterestRate      float64   `json:"monthly_interest_rate"`
+	MaxMonths                int       `json:"max_months"`
+	MinDownPaymentPercentage float64   `json:"min_down_payment_percentage"`
+	CreatedAt                time.Time `json:"created_at"`
+	UpdatedAt                time.Time `json:"updated_at"`
+}
+
+func FinancingPlanFromDomain(plan entity.FinancingPlan) FinancingPlan {
+	return FinancingPlan{
+		ID:                       plan.ID,
+		DealerID:                 plan.DealerID,
+		Name:                     plan.Name,
+		AmortizationSystem:       plan.AmortizationSystem.String(),
+		MonthlyInterestRate:      plan.MonthlyInterestRate,
+		MaxMonths:                plan.MaxMonths,
+		MinDownPaymentPercentage: plan.MinDownPaymentPercentage,
+		CreatedAt:                plan.CreatedAt,
+		UpdatedAt:                plan.UpdatedAt,
+	}
+}
@@ -0,0 +1,43 @@
+package responses
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestFinancingPlanFromDomain(t *testing.T) {
+	now := time.Now()
+
+	plan := entity.FinancingPlan{
+		ID:                       1,
+		DealerID:                 3,
+		Name:                     "48x Price",
+		AmortizationSystem:       valueobjects.AmortizationSystemPrice,
+		MonthlyInterestRate:      1.49,
+		MaxMonths:                48,
+		MinDownPaymentPercentage: 20,
+		CreatedAt:                now,
+		UpdatedAt:                now,
+	}
+
+	expected := FinancingPlan{
+		ID:                       1,
+		DealerID:                 3,
+		Name:                     "48x Price",
+		AmortizationSystem:       "PRICE",
+		MonthlyInterestRate:      1.49,
+		MaxMonths:                48,
+		MinDownPaymentPercentage: 20,
+		CreatedAt:                now,
+		UpdatedAt:                now,
+	}
+
+	actual := FinancingPlanFromDomain(plan)
+
+	assert.Equal(t, expected, actual)
+}
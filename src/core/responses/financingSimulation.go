@@ -0,0 +1,86 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+// FinancingSimulation compares the amortization systems on the same terms.
+// Rates and the CET are in percent.
+type FinancingSimulation struct {
+	VehicleID           string            `json:"vehicle_id"`
+	Price               float64           `json:"price"`
+	DownPayment         float64           `json:"down_payment"`
+	Principal           float64           `json:"principal"`
+	Fees                float64           `json:"fees"`
+	AmountFinanced      float64           `json:"amount_financed"`
+	Months              int               `json:"months"`
+	MonthlyInterestRate float64           `json:"monthly_interest_rate"`
+	Options             []FinancingOption `json:"options"`
+}
+
+// FinancingOption has MonthlyPayment as the first installment, the highest
+// one under SAC.
+type FinancingOption struct {
+	AmortizationSystem string                 `json:"amortization_system" enums:"PRICE,SAC"`
+	MonthlyPayment     float64                `json:"monthly_payment"`
+	LastPayment        float64                `json:"last_payment"`
+	TotalInterest      float64                `json:"total_interest"`
+	TotalCost          float64                `json:"total_cost"`
+	MonthlyCET         float64                `json:"cet_monthly"`
+	AnnualCET          float64                `json:"cet_annual"`
+	Installments       []SimulatedInstallment `json:"installments"`
+}
+
+// SimulatedInstallment has its DueDate as a date, YYYY-MM-DD.
+type SimulatedInstallment struct {
+	Number       int     `json:"number"`
+	DueDate      string  `json:"due_date" example:"2026-11-19"`
+	Amount       float64 `json:"amount"`
+	Interest     float64 `json:"interest"`
+	Amortization float64 `json:"amortization"`
+	Balance      float64 `json:"balance"`
+}
+
+func FinancingSimulationFromDomain(simulation entity.FinancingSimulation) FinancingSimulation {
+	response := FinancingSimulation{
+		VehicleID:           simulation.EntityID,
+		Price:               simulation.Price,
+		DownPayment:         simulation.DownPayment,
+		Principal:           simulation.Principal,
+		Fees:                simulation.Fees,
+		AmountFinanced:      simulation.AmountFinanced,
+		Months:              simulation.Months,
+		MonthlyInterestRate: simulation.MonthlyInterestRate,
+		Options:             make([]FinancingOption, len(simulation.Options)),
+	}
+
+	for i, option := range simulation.Options {
+		installments := make([]SimulatedInstallment, len(option.Installments))
+
+		for j, installment := range option.Installments {
+			installments[j] = SimulatedInstallment{
+				Number:       installment.Number,
+				DueDate:      installment.DueDate.Format(time.DateOnly),
+				Amount:       installment.Amount,
+				Interest:     installment.Interest,
+				Amortization: installment.Amortization,
+				Balance:      installment.Balance,
+			}
+		}
+
+		response.Options[i] = FinancingOption{
+			AmortizationSystem: option.AmortizationSystem.String(),
+			MonthlyPayment:     option.MonthlyPayment,
+			LastPayment:        option.LastPayment,
+			TotalInterest:      option.TotalInterest,
+			TotalCost:          option.TotalCost,
+			MonthlyCET:         option.MonthlyCET,
+			AnnualCET:          option.AnnualCET,
+			Installments:       installments,
+		}
+	}
+
+	return response
+}
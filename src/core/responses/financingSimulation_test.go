@@ -0,0 +1,68 @@
+package responses
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestFinancingSimulationFromDomain(t *testing.T) {
+	entityID := uuid.NewString()
+
+	simulation := entity.FinancingSimulation{
+		EntityID:            entityID,
+		Price:               1100,
+		DownPayment:         100,
+		Principal:           1000,
+		AmountFinanced:      1000,
+		Months:              1,
+		MonthlyInterestRate: 1,
+		Options: []entity.FinancingOption{
+			{
+				AmortizationSystem: valueobjects.AmortizationSystemPrice,
+				MonthlyPayment:     1010,
+				LastPayment:        1010,
+				TotalInterest:      10,
+				TotalCost:          1110,
+				MonthlyCET:         1,
+				AnnualCET:          12.68,
+				Installments: []entity.Installment{
+					{Number: 1, DueDate: time.Date(2026, 11, 19, 0, 0, 0, 0, time.UTC), Amount: 1010, Interest: 10, Amortization: 1000},
+				},
+			},
+		},
+	}
+
+	expected := FinancingSimulation{
+		VehicleID:           entityID,
+		Price:               1100,
+		DownPayment:         100,
+		Principal:           1000,
+		AmountFinanced:      1000,
+		Months:              1,
+		MonthlyInterestRate: 1,
+		Options: []FinancingOption{
+			{
+				AmortizationSystem: "PRICE",
+				MonthlyPayment:     1010,
+				LastPayment:        1010,
+				TotalInterest:      10,
+				TotalCost:          1110,
+				MonthlyCET:         1,
+				AnnualCET:          12.68,
+				Installments: []SimulatedInstallment{
+					{Number: 1, DueDate: "2026-11-19", Amount: 1010, Interest: 10, Amortization: 1000},
+				},
+			},
+		},
+	}
+
+	actual := FinancingSimulationFromDomain(simulation)
+
+	assert.Equal(t, expected, actual)
+}
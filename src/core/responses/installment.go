@@ -0,0 +1,64 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+const (
+	installmentStatusPending = "PENDING"
+	installmentStatusPaid    = "PAID"
+)
+
+// SaleInstallments is the amortization table of a sale. A sale paid in cash
+// has no Financing and no Installments.
+type SaleInstallments struct {
+	SaleID       int           `json:"sale_id"`
+	Financing    *Financing    `json:"financing,omitempty"`
+	Installments []Installment `json:"installments"`
+}
+
+// Installment has its DueDate as a date, YYYY-MM-DD.
+type Installment struct {
+	Number       int        `json:"number"`
+	DueDate      string     `json:"due_date" example:"2026-11-19"`
+	Amount       float64    `json:"amount"`
+	Interest     float64    `json:"interest"`
+	Amortization float64    `json:"amortization"`
+	Balance      float64    `json:"balance"`
+	Status       string     `json:"status" enums:"PENDING,PAID"`
+	PaidAt       *time.Time `json:"paid_at,omitempty"`
+}
+
+func SaleInstallmentsFromDomain(sale entity.Sale, installments []entity.Installment) SaleInstallments {
+	response := SaleInstallments{
+		SaleID:       sale.ID,
+		Financing:    financingFromDomain(sale.Financing),
+		Installments: make([]Installment, len(installments)),
+	}
+
+	for i, installment := range installments {
+		response.Installments[i] = InstallmentFromDomain(installment)
+	}
+
+	return response
+}
+
+func InstallmentFromDomain(installment entity.Installment) Installment {
+	status := installmentStatusPending
+	if installment.IsPaid() {
+		status = installmentStatusPaid
+	}
+
+	return Installment{
+		Number:       installment.Number,
+		DueDate:      installment.DueDate.Format(time.DateOnly),
+		Amount:       installment.Amount,
+		Interest:     installment.Interest,
+		Amortization: installment.Amortization,
+		Balance:      installment.Balance,
+		Status:       status,
+		PaidAt:       installment.PaidAt,
+	}
+}
@@ -0,0 +1,39 @@
+package responses
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+// Offer exposes the payment of the sale made when the offer was accepted,
+// which is the one the buyer pays.
+type Offer struct {
+	EntityID            string    `json:"offer_id"`
+	VehicleID           string    `json:"vehicle_id"`
+	DealerID            int       `json:"dealer_id"`
+	BuyerDocumentNumber string    `json:"buyer_document_number"`
+	Amount              float64   `json:"amount"`
+	CounterAmount       *float64  `json:"counter_amount,omitempty"`
+	Status              string    `json:"status" enums:"PENDING,COUNTERED,ACCEPTED,REJECTED,EXPIRED"`
+	PaymentID           string    `json:"payment_id,omitempty"`
+	ExpiresAt           time.Time `json:"expires_at"`
+	CreatedAt           time.Time `json:"created_at"`
+	UpdatedAt           time.Time `json:"updated_at"`
+}
+
+func OfferFromDomain(offer entity.Offer) Offer {
+	return Offer{
+		EntityID:            offer.EntityID,
+		VehicleID:           offer.VehicleID,
+		DealerID:            offer.DealerID,
+		BuyerDocumentNumber: offer.BuyerDocumentNumber,
+		Amount:              offer.Amount,
+		CounterAmount:       offer.CounterAmount,
+		Status:              offer.Status.String(),
+		PaymentID:           offer.PaymentID,
+		ExpiresAt:           offer.ExpiresAt,
+		CreatedAt:           offer.CreatedAt,
+		UpdatedAt:           offer.UpdatedAt,
+	}
+}
@@ -0,0 +1,55 @@
+package responses
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestOfferFromDomain(t *testing.T) {
+	entityID := uuid.NewString()
+	vehicleID := uuid.NewString()
+	paymentID := uuid.NewString()
+	counterAmount := 19000.0
+
+	now := time.Now()
+
+	offer := entity.Offer{
+		ID:                  1,
+		EntityID:            entityID,
+		VehicleID:           vehicleID,
+		DealerID:            3,
+		BuyerSubject:        "api_key:2",
+		BuyerDocumentNumber: "12345678900",
+		Amount:              18000,
+		CounterAmount:       &counterAmount,
+		Status:              valueobjects.OfferStatusAccepted,
+		PaymentID:           paymentID,
+		ExpiresAt:           now.Add(time.Hour),
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+
+	expected := Offer{
+		EntityID:            entityID,
+		VehicleID:           vehicleID,
+		DealerID:            3,
+		BuyerDocumentNumber: "12345678900",
+		Amount:              18000,
+		CounterAmount:       &counterAmount,
+		Status:              "ACCEPTED",
+		PaymentID:           paymentID,
+		ExpiresAt:           now.Add(time.Hour),
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+
+	actual := OfferFromDomain(offer)
+
+	assert.Equal(t, expected, actual)
+}
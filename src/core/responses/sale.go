@@ -6,13 +6,24 @@ import (
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
 )
 
+// Sale shows the price breakdown of the sale: Price is ListPrice less
+// Discount, and AmountDue the part of it paid through the payment, what the
+// trade-in does not cover. A financed AmountDue is split into the down payment
+// and the installments told by Financing.
 type Sale struct {
 	ID                  int        `json:"id,omitempty"`
 	VehicleID           string     `json:"vehicle_id"`
+	DealerID            int        `json:"dealer_id"`
 	PaymentID           string     `json:"payment_id"`
 	BuyerDocumentNumber string     `json:"buyer_document_number"`
 	Status              string     `json:"status"`
+	ListPrice           float64    `json:"list_price"`
+	Discount            float64    `json:"discount"`
 	Price               float64    `json:"price"`
+	CouponCode          string     `json:"coupon_code,omitempty"`
+	TradeIn             *TradeIn   `json:"trade_in,omitempty"`
+	AmountDue           float64    `json:"amount_due"`
+	Financing           *Financing `json:"financing,omitempty"`
 	SoldAt              *time.Time `json:"sold_at,omitempty"`
 }
 
@@ -20,10 +31,77 @@ func SaleFromDomain(sale entity.Sale) Sale {
 	return Sale{
 		ID:                  sale.ID,
 		VehicleID:           sale.EntityID,
+		DealerID:            sale.DealerID,
 		PaymentID:           sale.PaymentID,
 		BuyerDocumentNumber: sale.BuyerDocumentNumber,
 		Status:              sale.Status.String(),
+		ListPrice:           sale.ListPrice,
+		Discount:            sale.Discount,
 		Price:               sale.Price,
+		CouponCode:          sale.CouponCode,
+		TradeIn:             tradeInFromDomain(sale.TradeIn),
+		AmountDue:           sale.AmountDue(),
+		Financing:           financingFromDomain(sale.Financing),
 		SoldAt:              sale.SoldAt,
 	}
 }
+
+// TradeIn links the vehicle it became, in VehicleID, once the sale is
+// approved.
+type TradeIn struct {
+	Brand          string  `json:"brand"`
+	Model          string  `json:"model"`
+	Year           int     `json:"year"`
+	Color          string  `json:"color"`
+	Mileage        int     `json:"mileage,omitempty"`
+	LicensePlate   string  `json:"license_plate,omitempty"`
+	VIN            string  `json:"vin,omitempty"`
+	AppraisedValue float64 `json:"appraised_value"`
+	AppraisedBy    string  `json:"appraised_by"`
+	VehicleID      string  `json:"vehicle_id,omitempty"`
+}
+
+func tradeInFromDomain(tradeIn *entity.TradeIn) *TradeIn {
+	if tradeIn == nil {
+		return nil
+	}
+
+	return &TradeIn{
+		Brand:          tradeIn.Brand,
+		Model:          tradeIn.Model,
+		Year:           tradeIn.Year,
+		Color:          tradeIn.Color,
+		Mileage:        tradeIn.Mileage,
+		LicensePlate:   tradeIn.LicensePlate.String(),
+		VIN:            tradeIn.VIN.String(),
+		AppraisedValue: tradeIn.AppraisedValue,
+		AppraisedBy:    tradeIn.AppraisedBy,
+		VehicleID:      tradeIn.VehicleID,
+	}
+}
+
+// Financing tells the terms a financed sale was made with. The installments
+// are listed apart, in SaleInstallments.
+type Financing struct {
+	PlanID              int     `json:"plan_id"`
+	AmortizationSystem  string  `json:"amortization_system" enums:"PRICE,SAC"`
+	MonthlyInterestRate float64 `json:"monthly_interest_rate"`
+	DownPayment         float64 `json:"down_payment"`
+	Principal           float64 `json:"principal"`
+	Months              int     `json:"months"`
+}
+
+func financingFromDomain(financing *entity.Financing) *Financing {
+	if financing == nil {
+		return nil
+	}
+
+	return &Financing{
+		PlanID:              financing.PlanID,
+		AmortizationSystem:  financing.AmortizationSystem.String(),
+		MonthlyInterestRate: financing.MonthlyInterestRate,
+		DownPayment:         financing.DownPayment,
+		Principal:           financing.Principal,
+		Months:              financing.Months,
+	}
+}
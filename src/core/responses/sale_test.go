@@ -22,8 +22,12 @@ func TestSaleFromDomain(t *testing.T) {
 	sale := entity.Sale{
 		ID:                  1,
 		EntityID:            entityID,
+		DealerID:            3,
 		BuyerDocumentNumber: documentNumber,
-		Price:               80000,
+		ListPrice:           80000,
+		Discount:            8000,
+		Price:               72000,
+		CouponCode:          "BLACKFRIDAY",
 		SoldAt:              &now,
 		PaymentID:           paymentID,
 		Status:              status,
@@ -32,8 +36,13 @@ func TestSaleFromDomain(t *testing.T) {
 	expected := Sale{
 		ID:                  1,
 		VehicleID:           entityID,
+		DealerID:            3,
 		BuyerDocumentNumber: documentNumber,
-		Price:               80000,
+		ListPrice:           80000,
+		Discount:            8000,
+		Price:               72000,
+		CouponCode:          "BLACKFRIDAY",
+		AmountDue:           72000,
 		SoldAt:              &now,
 		PaymentID:           paymentID,
 		Status:              status.String(),
@@ -43,3 +52,99 @@ func TestSaleFromDomain(t *testing.T) {
 
 	assert.Equal(t, expected, actual)
 }
+
+func TestSaleFromDomainWithTradeIn(t *testing.T) {
+	vehicleID := uuid.NewString()
+
+	sale := entity.Sale{
+		ID:        1,
+		ListPrice: 80000,
+		Price:     80000,
+		TradeIn: &entity.TradeIn{
+			Brand:          "Ford",
+			Model:          "Ka",
+			Year:           2015,
+			Color:          "Red",
+			LicensePlate:   "ABC1D23",
+			AppraisedValue: 20000,
+			AppraisedBy:    "api_key:3",
+			VehicleID:      vehicleID,
+		},
+	}
+
+	expected := &TradeIn{
+		Brand:          "Ford",
+		Model:          "Ka",
+		Year:           2015,
+		Color:          "Red",
+		LicensePlate:   "ABC1D23",
+		AppraisedValue: 20000,
+		AppraisedBy:    "api_key:3",
+		VehicleID:      vehicleID,
+	}
+
+	actual := SaleFromDomain(sale)
+
+	assert.Equal(t, expected, actual.TradeIn)
+	assert.Equal(t, float64(60000), actual.AmountDue)
+}
+
+func TestSaleFromDomainWithFinancing(t *testing.T) {
+	sale := entity.Sale{
+		ID:        1,
+		ListPrice: 80000,
+		Price:     80000,
+		Financing: &entity.Financing{
+			PlanID:              2,
+			AmortizationSystem:  valueobjects.AmortizationSystemPrice,
+			MonthlyInterestRate: 1.49,
+			DownPayment:         20000,
+			Principal:           60000,
+			Months:              48,
+		},
+	}
+
+	expected := &Financing{
+		PlanID:              2,
+		AmortizationSystem:  "PRICE",
+		MonthlyInterestRate: 1.49,
+		DownPayment:         20000,
+		Principal:           60000,
+		Months:              48,
+	}
+
+	actual := SaleFromDomain(sale)
+
+	assert.Equal(t, expected, actual.Financing)
+	assert.Equal(t, float64(80000), actual.AmountDue)
+}
+
+func TestSaleInstallmentsFromDomain(t *testing.T) {
+	paidAt := time.Date(2026, 11, 18, 14, 30, 0, 0, time.UTC)
+
+	sale := entity.Sale{
+		ID: 1,
+		Financing: &entity.Financing{
+			PlanID:             2,
+			AmortizationSystem: valueobjects.AmortizationSystemSAC,
+			Principal:          1000,
+			Months:             2,
+		},
+	}
+
+	installments := []entity.Installment{
+		{SaleID: 1, Number: 1, DueDate: time.Date(2026, 11, 19, 0, 0, 0, 0, time.UTC), Amount: 510, Interest: 10, Amortization: 500, Balance: 500, PaidAt: &paidAt},
+		{SaleID: 1, Number: 2, DueDate: time.Date(2026, 12, 19, 0, 0, 0, 0, time.UTC), Amount: 505, Interest: 5, Amortization: 500},
+	}
+
+	expected := []Installment{
+		{Number: 1, DueDate: "2026-11-19", Amount: 510, Interest: 10, Amortization: 500, Balance: 500, Status: "PAID", PaidAt: &paidAt},
+		{Number: 2, DueDate: "2026-12-19", Amount: 505, Interest: 5, Amortization: 500, Status: "PENDING"},
+	}
+
+	actual := SaleInstallmentsFromDomain(sale, installments)
+
+	assert.Equal(t, 1, actual.SaleID)
+	assert.Equal(t, "SAC", actual.Financing.AmortizationSystem)
+	assert.Equal(t, expected, actual.Installments)
+}
@@ -7,27 +7,59 @@ import (
 )
 
 type Vehicle struct {
-	ID        int       `json:"id"`
-	EntityID  string    `json:"vehicle_id"`
-	Brand     string    `json:"brand"`
-	Model     string    `json:"model"`
-	Year      int       `json:"year"`
-	Color     string    `json:"color"`
-	Price     float64   `json:"price"`
-	CreatedAt time.Time `json:"created_at"`
-	UpdatedAt time.Time `json:"updated_at"`
+	ID                 int            `json:"id"`
+	EntityID           string         `json:"vehicle_id"`
+	Slug               string         `json:"slug" example:"2021-toyota-corolla-prata-3f9a1c"`
+	DealerID           int            `json:"dealer_id"`
+	Brand              string         `json:"brand"`
+	Model              string         `json:"model"`
+	Year               int            `json:"year"`
+	Color              string         `json:"color"`
+	Price              float64        `json:"price"`
+	Mileage            int            `json:"mileage"`
+	FuelType           string         `json:"fuel_type,omitempty"`
+	Transmission       string         `json:"transmission,omitempty"`
+	BodyType           string         `json:"body_type,omitempty"`
+	Condition          string         `json:"condition,omitempty"`
+	Doors              int            `json:"doors,omitempty"`
+	EngineDisplacement int            `json:"engine_displacement,omitempty"`
+	LicensePlate       string         `json:"license_plate,omitempty"`
+	VIN                string         `json:"vin,omitempty"`
+	Status             string         `json:"status" enums:"DRAFT,PUBLISHED,UNLISTED"`
+	PublishedAt        *time.Time     `json:"published_at,omitempty"`
+	Media              []VehicleMedia `json:"media"`
+	Version            int            `json:"version"`
+	CreatedAt          time.Time      `json:"created_at"`
+	UpdatedAt          time.Time      `json:"updated_at"`
 }
 
+// VehicleFromDomain leaves Media empty, the media of the vehicle being read
+// separately.
 func VehicleFromDomain(vehicle entity.Vehicle) Vehicle {
 	return Vehicle{
-		ID:        vehicle.ID,
-		EntityID:  vehicle.EntityID,
-		Brand:     vehicle.Brand,
-		Model:     vehicle.Model,
-		Year:      vehicle.Year,
-		Color:     vehicle.Color,
-		Price:     vehicle.Price,
-		CreatedAt: vehicle.CreatedAt,
-		UpdatedAt: vehicle.UpdatedAt,
+		ID:                 vehicle.ID,
+		EntityID:           vehicle.EntityID,
+		Slug:               vehicle.Slug.String(),
+		DealerID:           vehicle.DealerID,
+		Brand:              vehicle.Brand,
+		Model:              vehicle.Model,
+		Year:               vehicle.Year,
+		Color:              vehicle.Color,
+		Price:              vehicle.Price,
+		Mileage:            vehicle.Mileage,
+		FuelType:           vehicle.FuelType.String(),
+		Transmission:       vehicle.Transmission.String(),
+		BodyType:           vehicle.BodyType.String(),
+		Condition:          vehicle.Condition.String(),
+		Doors:              vehicle.Doors,
+		EngineDisplacement: vehicle.EngineDisplacement,
+		LicensePlate:       vehicle.LicensePlate.String(),
+		VIN:                vehicle.VIN.String(),
+		Status:             vehicle.Status.String(),
+		PublishedAt:        vehicle.PublishedAt,
+		Media:              []VehicleMedia{},
+		Version:            vehicle.Version,
+		CreatedAt:          vehicle.CreatedAt,
+		UpdatedAt:          vehicle.UpdatedAt,
 	}
 }
@@ -0,0 +1,42 @@
+package responses
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+// VehicleMedia links to the file of the media and to its thumbnail rather than
+// exposing where they are stored.
+type VehicleMedia struct {
+	EntityID     string    `json:"media_id"`
+	VehicleID    string    `json:"vehicle_id"`
+	ContentType  string    `json:"content_type"`
+	Size         int64     `json:"size"`
+	Width        int       `json:"width"`
+	Height       int       `json:"height"`
+	Position     int       `json:"position"`
+	IsCover      bool      `json:"is_cover"`
+	URL          string    `json:"url"`
+	ThumbnailURL string    `json:"thumbnail_url"`
+	CreatedAt    time.Time `json:"created_at"`
+}
+
+func VehicleMediaFromDomain(media entity.VehicleMedia) VehicleMedia {
+	path := "/vehicles/" + url.PathEscape(media.VehicleID) + "/media/" + url.PathEscape(media.EntityID)
+
+	return VehicleMedia{
+		EntityID:     media.EntityID,
+		VehicleID:    media.VehicleID,
+		ContentType:  media.ContentType,
+		Size:         media.Size,
+		Width:        media.Width,
+		Height:       media.Height,
+		Position:     media.Position,
+		IsCover:      media.IsCover,
+		URL:          path,
+		ThumbnailURL: path + "/thumbnail",
+		CreatedAt:    media.CreatedAt,
+	}
+}
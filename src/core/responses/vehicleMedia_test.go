@@ -0,0 +1,48 @@
+package responses
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+func TestVehicleMediaFromDomain(t *testing.T) {
+	now := time.Now()
+
+	media := entity.VehicleMedia{
+		ID:           1,
+		EntityID:     "some-media",
+		VehicleID:    "some vehicle",
+		DealerID:     3,
+		ContentType:  "image/jpeg",
+		Size:         2048,
+		Width:        1280,
+		Height:       960,
+		Position:     1,
+		IsCover:      true,
+		StorageKey:   "vehicles/3/some-media.jpg",
+		ThumbnailKey: "vehicles/3/some-media_thumbnail.jpg",
+		CreatedAt:    now,
+	}
+
+	expected := VehicleMedia{
+		EntityID:     "some-media",
+		VehicleID:    "some vehicle",
+		ContentType:  "image/jpeg",
+		Size:         2048,
+		Width:        1280,
+		Height:       960,
+		Position:     1,
+		IsCover:      true,
+		URL:          "/vehicles/some%20vehicle/media/some-media",
+		ThumbnailURL: "/vehicles/some%20vehicle/media/some-media/thumbnail",
+		CreatedAt:    now,
+	}
+
+	actual := VehicleMediaFromDomain(media)
+
+	assert.Equal(t, expected, actual)
+}
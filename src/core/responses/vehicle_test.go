@@ -8,6 +8,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 )
 
 func TestVehicleFromDomain(t *testing.T) {
@@ -16,27 +17,52 @@ func TestVehicleFromDomain(t *testing.T) {
 	now := time.Now()
 
 	vehicle := entity.Vehicle{
-		ID:        1,
-		EntityID:  entityID,
-		Brand:     "Some Brand",
-		Model:     "Some Model",
-		Year:      2025,
-		Color:     "Gray",
-		Price:     80000,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:                 1,
+		EntityID:           entityID,
+		Slug:               "2025-some-brand-some-model-black-3f9a1c",
+		DealerID:           3,
+		Brand:              "Some Brand",
+		Model:              "Some Model",
+		Year:               2025,
+		Color:              "Gray",
+		Price:              80000,
+		Mileage:            12000,
+		FuelType:           valueobjects.FuelTypeFlex,
+		Transmission:       valueobjects.TransmissionAutomatic,
+		BodyType:           valueobjects.BodyTypeSUV,
+		Condition:          valueobjects.VehicleConditionUsed,
+		Doors:              4,
+		EngineDisplacement: 1600,
+		LicensePlate:       "ABC1D23",
+		VIN:                "9BWZZZ377VT004251",
+		Version:            2,
+		CreatedAt:          now,
+		UpdatedAt:          now,
 	}
 
 	expected := Vehicle{
-		ID:        1,
-		EntityID:  entityID,
-		Brand:     "Some Brand",
-		Model:     "Some Model",
-		Year:      2025,
-		Color:     "Gray",
-		Price:     80000,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:                 1,
+		EntityID:           entityID,
+		Slug:               "2025-some-brand-some-model-black-3f9a1c",
+		DealerID:           3,
+		Brand:              "Some Brand",
+		Model:              "Some Model",
+		Year:               2025,
+		Color:              "Gray",
+		Price:              80000,
+		Mileage:            12000,
+		FuelType:           "FLEX",
+		Transmission:       "AUTOMATIC",
+		BodyType:           "SUV",
+		Condition:          "USED",
+		Doors:              4,
+		EngineDisplacement: 1600,
+		LicensePlate:       "ABC1D23",
+		VIN:                "9BWZZZ377VT004251",
+		Media:              []VehicleMedia{},
+		Version:            2,
+		CreatedAt:          now,
+		UpdatedAt:          now,
 	}
 
 	actual := VehicleFromDomain(vehicle)
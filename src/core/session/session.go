@@ -0,0 +1,37 @@
+// Package session carries the authenticated principal of a request through
+// the context, from the presentation layer down to the use cases.
+package session
+
+import (
+	"context"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type principalKey struct{}
+
+func WithPrincipal(ctx context.Context, principal entity.Principal) context.Context {
+	return context.WithValue(ctx, principalKey{}, principal)
+}
+
+func PrincipalFromContext(ctx context.Context) (entity.Principal, bool) {
+	principal, ok := ctx.Value(principalKey{}).(entity.Principal)
+	return principal, ok
+}
+
+// Subject identifies the caller in audit logs, "anonymous" when there is none.
+func Subject(ctx context.Context) string {
+	if principal, ok := PrincipalFromContext(ctx); ok {
+		return principal.Subject
+	}
+
+	return "anonymous"
+}
+
+// HasAnyRole tells whether the caller holds any of the roles, never for
+// anonymous callers.
+func HasAnyRole(ctx context.Context, roles ...valueobjects.Role) bool {
+	principal, ok := PrincipalFromContext(ctx)
+	return ok && principal.HasAnyRole(roles...)
+}
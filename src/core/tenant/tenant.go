@@ -0,0 +1,79 @@
+// Package tenant carries the dealer a request is scoped to through the
+// context, so every repository query can be restricted to it. Repositories
+// refuse to run without a scope, which keeps a forgotten call site from
+// reaching every dealer by accident.
+package tenant
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+)
+
+var ErrMissingScope = errors.New("tenant scope is missing from the context")
+
+// Scope is either one dealer or, when DealerID is zero, every dealer.
+type Scope struct {
+	DealerID int
+}
+
+func (ref Scope) IsAll() bool {
+	return ref.DealerID == 0
+}
+
+// Allows tells whether a row of the dealer is visible in the scope.
+func (ref Scope) Allows(dealerID int) bool {
+	return ref.IsAll() || ref.DealerID == dealerID
+}
+
+// Resolve returns the dealer a new row belongs to: the dealer of the scope, or
+// the given one when the scope reaches every dealer. A dealer out of the scope
+// is reported as missing, the same as a dealer that does not exist.
+func (ref Scope) Resolve(dealerID int) (int, error) {
+	if ref.IsAll() {
+		return dealerID, nil
+	}
+
+	if dealerID != 0 && dealerID != ref.DealerID {
+		return 0, fmt.Errorf("%w: dealer %d", domainerrors.ErrReferenceNotFound, dealerID)
+	}
+
+	return ref.DealerID, nil
+}
+
+type scopeKey struct{}
+
+func WithDealer(ctx context.Context, dealerID int) context.Context {
+	return context.WithValue(ctx, scopeKey{}, Scope{DealerID: dealerID})
+}
+
+// WithAllDealers is meant for platform admins and for background work that is
+// not done on behalf of a dealer.
+func WithAllDealers(ctx context.Context) context.Context {
+	return context.WithValue(ctx, scopeKey{}, Scope{})
+}
+
+func FromContext(ctx context.Context) (Scope, error) {
+	scope, ok := ctx.Value(scopeKey{}).(Scope)
+	if !ok {
+		return Scope{}, ErrMissingScope
+	}
+
+	return scope, nil
+}
+
+// RequireAllDealers guards the writes only a platform wide caller may do.
+func RequireAllDealers(ctx context.Context) error {
+	scope, err := FromContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	if !scope.IsAll() {
+		return fmt.Errorf("%w: dealer %d can not act on other dealers", domainerrors.ErrPermissionDenied, scope.DealerID)
+	}
+
+	return nil
+}
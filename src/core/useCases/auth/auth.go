@@ -0,0 +1,225 @@
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"strings"
+	"time"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+const (
+	MethodAPIKey = "api_key"
+	MethodToken  = "jwt"
+
+	apiKeyPrefix    = "vps_"
+	apiKeyBytes     = 32
+	apiKeyShownSize = 12
+)
+
+type authService struct {
+	apiKeyRepository interfaces.APIKeyRepository
+	tokenVerifier    interfaces.TokenVerifier
+	timeGenerator    func() time.Time
+}
+
+// NewAuthService accepts a nil tokenVerifier, in which case bearer tokens are
+// always rejected and only API keys authenticate.
+func NewAuthService(apiKeyRepository interfaces.APIKeyRepository, tokenVerifier interfaces.TokenVerifier, timeGenerator func() time.Time) interfaces.AuthService {
+	return &authService{
+		apiKeyRepository: apiKeyRepository,
+		tokenVerifier:    tokenVerifier,
+		timeGenerator:    timeGenerator,
+	}
+}
+
+func (ref *authService) AuthenticateAPIKey(ctx context.Context, key string) (*entity.Principal, error) {
+	apiKey, err := ref.apiKeyRepository.GetByHash(ctx, HashAPIKey(key))
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKey == nil {
+		return nil, domainerrors.ErrInvalidCredentials
+	}
+
+	if apiKey.IsRevoked() {
+		logger.FromContext(ctx).WarnContext(ctx, "revoked api key used", "api_key_id", apiKey.ID)
+		return nil, domainerrors.ErrInvalidCredentials
+	}
+
+	return &entity.Principal{
+		Subject:  fmt.Sprintf("%s:%d", MethodAPIKey, apiKey.ID),
+		Method:   MethodAPIKey,
+		DealerID: apiKey.DealerID,
+		Roles:    bindRoles(apiKey.DealerID, apiKey.Roles),
+	}, nil
+}
+
+func (ref *authService) AuthenticateToken(ctx context.Context, token string) (*entity.Principal, error) {
+	if ref.tokenVerifier == nil {
+		return nil, fmt.Errorf("%w: bearer tokens are not enabled", domainerrors.ErrInvalidCredentials)
+	}
+
+	principal, err := ref.tokenVerifier.Verify(ctx, token)
+	if err != nil {
+		logger.FromContext(ctx).DebugContext(ctx, "bearer token rejected", "error", err)
+		return nil, fmt.Errorf("%w: %s", domainerrors.ErrInvalidCredentials, err)
+	}
+
+	principal.Method = MethodToken
+	principal.Roles = bindRoles(principal.DealerID, principal.Roles)
+
+	return principal, nil
+}
+
+// bindRoles drops the dealer roles of a caller bound to no dealer, so a token
+// or key missing its dealer fails closed instead of reaching every dealer.
+func bindRoles(dealerID int, roles []valueobjects.Role) []valueobjects.Role {
+	if dealerID != 0 {
+		return roles
+	}
+
+	bound := make([]valueobjects.Role, 0, len(roles))
+	for _, role := range roles {
+		if !role.IsDealerRole() {
+			bound = append(bound, role)
+		}
+	}
+
+	return bound
+}
+
+// CreateAPIKey returns the stored key along with the plain key, which is the
+// only time it is available. Inside a dealer scope the key always belongs to
+// that dealer and can not carry platform roles.
+func (ref *authService) CreateAPIKey(ctx context.Context, name string, dealerID int, roles []valueobjects.Role) (*entity.APIKey, string, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, "", fmt.Errorf("%w: api key name is required", domainerrors.ErrInvalidArgument)
+	}
+
+	secret := make([]byte, apiKeyBytes)
+	if _, err := rand.Read(secret); err != nil {
+		return nil, "", err
+	}
+
+	key := apiKeyPrefix + base64.RawURLEncoding.EncodeToString(secret)
+
+	apiKey, err := ref.createAPIKey(ctx, name, key, dealerID, roles)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return apiKey, key, nil
+}
+
+// EnsureAPIKey stores a platform key chosen by the operator unless it is
+// already stored, which lets a deployment bootstrap its first admin key. A
+// revoked key stays revoked.
+func (ref *authService) EnsureAPIKey(ctx context.Context, name string, key string, roles []valueobjects.Role) (*entity.APIKey, error) {
+	if len(key) < apiKeyShownSize*2 {
+		return nil, fmt.Errorf("api key must have at least %d characters", apiKeyShownSize*2)
+	}
+
+	apiKey, err := ref.apiKeyRepository.GetByHash(ctx, HashAPIKey(key))
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKey != nil {
+		return apiKey, nil
+	}
+
+	return ref.createAPIKey(ctx, name, key, 0, roles)
+}
+
+func (ref *authService) createAPIKey(ctx context.Context, name string, key string, dealerID int, roles []valueobjects.Role) (*entity.APIKey, error) {
+	dealerID, err := checkGrant(ctx, dealerID, roles)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKey, err := ref.apiKeyRepository.Create(ctx, entity.APIKey{
+		DealerID: dealerID,
+		Name:     name,
+		Prefix:   key[:apiKeyShownSize],
+		KeyHash:  HashAPIKey(key),
+		Roles:    roles,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).InfoContext(ctx, "api key created",
+		"api_key_id", apiKey.ID,
+		"name", name,
+		"dealer_id", dealerID,
+		"principal", session.Subject(ctx),
+	)
+
+	return apiKey, nil
+}
+
+// checkGrant returns the dealer the key belongs to once the roles are known to
+// be grantable from the tenant scope in ctx.
+func checkGrant(ctx context.Context, dealerID int, roles []valueobjects.Role) (int, error) {
+	if len(roles) == 0 {
+		return 0, fmt.Errorf("%w: api key needs at least one role", domainerrors.ErrInvalidArgument)
+	}
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	if dealerID, err = scope.Resolve(dealerID); err != nil {
+		return 0, err
+	}
+
+	for _, role := range roles {
+		if role.IsPlatformRole() && !scope.IsAll() {
+			return 0, fmt.Errorf("%w: only platform admins grant %s", domainerrors.ErrPermissionDenied, role)
+		}
+
+		if role.IsDealerRole() && dealerID == 0 {
+			return 0, fmt.Errorf("%w: %s needs a dealer", domainerrors.ErrInvalidArgument, role)
+		}
+	}
+
+	return dealerID, nil
+}
+
+func (ref *authService) SearchAPIKeys(ctx context.Context) ([]entity.APIKey, error) {
+	return ref.apiKeyRepository.Search(ctx)
+}
+
+func (ref *authService) RevokeAPIKey(ctx context.Context, id int) (*entity.APIKey, error) {
+	apiKey, err := ref.apiKeyRepository.Revoke(ctx, id, ref.timeGenerator())
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKey != nil {
+		logger.FromContext(ctx).InfoContext(ctx, "api key revoked", "api_key_id", id, "principal", session.Subject(ctx))
+	}
+
+	return apiKey, nil
+}
+
+// HashAPIKey is a plain SHA-256: keys are 256 random bits, so a slow password
+// hash would add latency to every request without adding safety.
+func HashAPIKey(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
@@ -0,0 +1,362 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+)
+
+func TestAuthenticateAPIKey(t *testing.T) {
+	ctx := context.TODO()
+	key := "vps_some-key"
+	unexpectedError := errors.New("unexpected error")
+	now := time.Now()
+
+	t.Run("should not authenticate when failed to get api key", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		apiKeyRepositoryMocked.On("GetByHash", ctx, HashAPIKey(key)).
+			Return(nil, unexpectedError)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, time.Now)
+
+		actual, err := service.AuthenticateAPIKey(ctx, key)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+	})
+
+	t.Run("should not authenticate unknown api key", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		apiKeyRepositoryMocked.On("GetByHash", ctx, HashAPIKey(key)).
+			Return(nil, nil)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, time.Now)
+
+		actual, err := service.AuthenticateAPIKey(ctx, key)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidCredentials)
+	})
+
+	t.Run("should not authenticate revoked api key", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		apiKeyRepositoryMocked.On("GetByHash", ctx, HashAPIKey(key)).
+			Return(&entity.APIKey{ID: 1, RevokedAt: &now}, nil)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, time.Now)
+
+		actual, err := service.AuthenticateAPIKey(ctx, key)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidCredentials)
+	})
+
+	t.Run("should authenticate api key successfully", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		roles := []valueobjects.Role{valueobjects.RoleDealerStaff}
+
+		apiKeyRepositoryMocked.On("GetByHash", ctx, HashAPIKey(key)).
+			Return(&entity.APIKey{ID: 7, DealerID: 3, Roles: roles}, nil)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, time.Now)
+
+		expected := &entity.Principal{
+			Subject:  "api_key:7",
+			Method:   MethodAPIKey,
+			DealerID: 3,
+			Roles:    roles,
+		}
+
+		actual, err := service.AuthenticateAPIKey(ctx, key)
+
+		assert.Equal(t, expected, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should drop dealer roles of api key without dealer", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		roles := []valueobjects.Role{valueobjects.RoleAdmin, valueobjects.RoleBuyer}
+
+		apiKeyRepositoryMocked.On("GetByHash", ctx, HashAPIKey(key)).
+			Return(&entity.APIKey{ID: 7, Roles: roles}, nil)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, time.Now)
+
+		actual, err := service.AuthenticateAPIKey(ctx, key)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []valueobjects.Role{valueobjects.RoleBuyer}, actual.Roles)
+	})
+}
+
+func TestAuthenticateToken(t *testing.T) {
+	ctx := context.TODO()
+	token := "some.jwt.token"
+
+	t.Run("should not authenticate token when tokens are not enabled", func(t *testing.T) {
+		service := NewAuthService(mocks.NewAPIKeyRepository(t), nil, time.Now)
+
+		actual, err := service.AuthenticateToken(ctx, token)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidCredentials)
+	})
+
+	t.Run("should not authenticate token when verification fails", func(t *testing.T) {
+		tokenVerifierMocked := mocks.NewTokenVerifier(t)
+
+		tokenVerifierMocked.On("Verify", ctx, token).
+			Return(nil, errors.New("token is expired"))
+
+		service := NewAuthService(mocks.NewAPIKeyRepository(t), tokenVerifierMocked, time.Now)
+
+		actual, err := service.AuthenticateToken(ctx, token)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidCredentials)
+	})
+
+	t.Run("should authenticate token successfully", func(t *testing.T) {
+		tokenVerifierMocked := mocks.NewTokenVerifier(t)
+
+		tokenVerifierMocked.On("Verify", ctx, token).
+			Return(&entity.Principal{Subject: "user-1", Roles: []valueobjects.Role{valueobjects.RoleBuyer}}, nil)
+
+		service := NewAuthService(mocks.NewAPIKeyRepository(t), tokenVerifierMocked, time.Now)
+
+		actual, err := service.AuthenticateToken(ctx, token)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "user-1", actual.Subject)
+		assert.Equal(t, MethodToken, actual.Method)
+	})
+
+	t.Run("should drop dealer roles of token without dealer", func(t *testing.T) {
+		tokenVerifierMocked := mocks.NewTokenVerifier(t)
+
+		tokenVerifierMocked.On("Verify", ctx, token).
+			Return(&entity.Principal{Subject: "user-1", Roles: []valueobjects.Role{valueobjects.RoleDealerStaff}}, nil)
+
+		service := NewAuthService(mocks.NewAPIKeyRepository(t), tokenVerifierMocked, time.Now)
+
+		actual, err := service.AuthenticateToken(ctx, token)
+
+		assert.Nil(t, err)
+		assert.Empty(t, actual.Roles)
+	})
+}
+
+func TestCreateAPIKey(t *testing.T) {
+	ctx := tenant.WithAllDealers(context.TODO())
+	dealerID := 3
+	roles := []valueobjects.Role{valueobjects.RoleAdmin}
+	unexpectedError := errors.New("unexpected error")
+
+	t.Run("should not create api key without name", func(t *testing.T) {
+		service := NewAuthService(mocks.NewAPIKeyRepository(t), nil, time.Now)
+
+		actual, key, err := service.CreateAPIKey(ctx, " ", dealerID, roles)
+
+		assert.Nil(t, actual)
+		assert.Empty(t, key)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should not create api key without roles", func(t *testing.T) {
+		service := NewAuthService(mocks.NewAPIKeyRepository(t), nil, time.Now)
+
+		actual, key, err := service.CreateAPIKey(ctx, "backoffice", dealerID, nil)
+
+		assert.Nil(t, actual)
+		assert.Empty(t, key)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should not create api key when failed to create", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		apiKeyRepositoryMocked.On("Create", ctx, mock.Anything).
+			Return(nil, unexpectedError)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, time.Now)
+
+		actual, key, err := service.CreateAPIKey(ctx, "backoffice", dealerID, roles)
+
+		assert.Nil(t, actual)
+		assert.Empty(t, key)
+		assert.Equal(t, unexpectedError, err)
+	})
+
+	t.Run("should create api key storing only its hash", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		var stored entity.APIKey
+
+		apiKeyRepositoryMocked.On("Create", ctx, mock.Anything).
+			Run(func(args mock.Arguments) { stored = args.Get(1).(entity.APIKey) }).
+			Return(func(_ context.Context, apiKey entity.APIKey) *entity.APIKey {
+				apiKey.ID = 1
+				return &apiKey
+			}, nil)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, time.Now)
+
+		actual, key, err := service.CreateAPIKey(ctx, "backoffice", dealerID, roles)
+
+		assert.Nil(t, err)
+		assert.True(t, strings.HasPrefix(key, apiKeyPrefix))
+		assert.Equal(t, HashAPIKey(key), stored.KeyHash)
+		assert.Equal(t, key[:apiKeyShownSize], stored.Prefix)
+		assert.NotContains(t, stored.KeyHash, key)
+		assert.Equal(t, dealerID, stored.DealerID)
+		assert.Equal(t, 1, actual.ID)
+		assert.Equal(t, roles, actual.Roles)
+	})
+
+	t.Run("should not create dealer api key without dealer", func(t *testing.T) {
+		service := NewAuthService(mocks.NewAPIKeyRepository(t), nil, time.Now)
+
+		actual, key, err := service.CreateAPIKey(ctx, "backoffice", 0, roles)
+
+		assert.Nil(t, actual)
+		assert.Empty(t, key)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+	})
+
+	t.Run("should not grant platform role inside a dealer scope", func(t *testing.T) {
+		service := NewAuthService(mocks.NewAPIKeyRepository(t), nil, time.Now)
+
+		dealerCtx := tenant.WithDealer(ctx, dealerID)
+
+		actual, key, err := service.CreateAPIKey(dealerCtx, "payments", 0, []valueobjects.Role{valueobjects.RolePaymentsSystem})
+
+		assert.Nil(t, actual)
+		assert.Empty(t, key)
+		assert.ErrorIs(t, err, domainerrors.ErrPermissionDenied)
+	})
+
+	t.Run("should create api key for the dealer of the scope", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		dealerCtx := tenant.WithDealer(ctx, dealerID)
+
+		apiKeyRepositoryMocked.On("Create", dealerCtx, mock.MatchedBy(func(apiKey entity.APIKey) bool {
+			return apiKey.DealerID == dealerID
+		})).
+			Return(&entity.APIKey{ID: 1, DealerID: dealerID, Roles: roles}, nil)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, time.Now)
+
+		actual, _, err := service.CreateAPIKey(dealerCtx, "backoffice", 0, roles)
+
+		assert.Nil(t, err)
+		assert.Equal(t, dealerID, actual.DealerID)
+	})
+}
+
+func TestEnsureAPIKey(t *testing.T) {
+	ctx := tenant.WithAllDealers(context.TODO())
+	key := "vps_bootstrap-admin-key-0123456789"
+	roles := []valueobjects.Role{valueobjects.RolePlatformAdmin}
+
+	t.Run("should not ensure short api key", func(t *testing.T) {
+		service := NewAuthService(mocks.NewAPIKeyRepository(t), nil, time.Now)
+
+		actual, err := service.EnsureAPIKey(ctx, "bootstrap", "short", roles)
+
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should keep api key already stored", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		stored := &entity.APIKey{ID: 1, Roles: roles}
+
+		apiKeyRepositoryMocked.On("GetByHash", ctx, HashAPIKey(key)).
+			Return(stored, nil)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, time.Now)
+
+		actual, err := service.EnsureAPIKey(ctx, "bootstrap", key, roles)
+
+		assert.Equal(t, stored, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should create api key not stored yet", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		expected := entity.APIKey{
+			Name:    "bootstrap",
+			Prefix:  key[:apiKeyShownSize],
+			KeyHash: HashAPIKey(key),
+			Roles:   roles,
+		}
+
+		apiKeyRepositoryMocked.On("GetByHash", ctx, HashAPIKey(key)).
+			Return(nil, nil)
+
+		apiKeyRepositoryMocked.On("Create", ctx, expected).
+			Return(&expected, nil)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, time.Now)
+
+		actual, err := service.EnsureAPIKey(ctx, "bootstrap", key, roles)
+
+		assert.Equal(t, &expected, actual)
+		assert.Nil(t, err)
+	})
+}
+
+func TestRevokeAPIKey(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Now()
+	unexpectedError := errors.New("unexpected error")
+
+	timeGenerator := func() time.Time { return now }
+
+	t.Run("should not revoke api key when failed to revoke", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		apiKeyRepositoryMocked.On("Revoke", ctx, 1, now).
+			Return(nil, unexpectedError)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, timeGenerator)
+
+		actual, err := service.RevokeAPIKey(ctx, 1)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+	})
+
+	t.Run("should revoke api key successfully", func(t *testing.T) {
+		apiKeyRepositoryMocked := mocks.NewAPIKeyRepository(t)
+
+		apiKeyRepositoryMocked.On("Revoke", ctx, 1, now).
+			Return(&entity.APIKey{ID: 1, RevokedAt: &now}, nil)
+
+		service := NewAuthService(apiKeyRepositoryMocked, nil, timeGenerator)
+
+		actual, err := service.RevokeAPIKey(ctx, 1)
+
+		assert.Nil(t, err)
+		assert.True(t, actual.IsRevoked())
+	})
+}
@@ -0,0 +1,115 @@
+package coupon
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+type couponService struct {
+	couponRepository interfaces.CouponRepository
+}
+
+func NewCouponService(couponRepository interfaces.CouponRepository) interfaces.CouponService {
+	return &couponService{
+		couponRepository: couponRepository,
+	}
+}
+
+// Create normalizes the code and the eligibility rules before creating the
+// coupon, so they compare the same way as the codes typed by buyers.
+func (ref *couponService) Create(ctx context.Context, coupon entity.Coupon) (*entity.Coupon, error) {
+	coupon, err := normalizeCoupon(coupon)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := ref.couponRepository.Create(ctx, coupon)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).InfoContext(ctx, "coupon created",
+		"coupon_id", created.ID,
+		"dealer_id", created.DealerID,
+		"code", created.Code,
+		"principal", session.Subject(ctx),
+	)
+
+	return created, nil
+}
+
+func (ref *couponService) GetByID(ctx context.Context, id int) (*entity.Coupon, error) {
+	return ref.couponRepository.GetByID(ctx, id)
+}
+
+func (ref *couponService) Search(ctx context.Context) ([]entity.Coupon, error) {
+	return ref.couponRepository.Search(ctx)
+}
+
+// Delete only stops the coupon from being redeemed, sales keep the code they
+// were made with.
+func (ref *couponService) Delete(ctx context.Context, id int) (*entity.Coupon, error) {
+	deleted, err := ref.couponRepository.Delete(ctx, id)
+	if err != nil || deleted == nil {
+		return deleted, err
+	}
+
+	logger.FromContext(ctx).InfoContext(ctx, "coupon deleted",
+		"coupon_id", deleted.ID,
+		"dealer_id", deleted.DealerID,
+		"code", deleted.Code,
+		"principal", session.Subject(ctx),
+	)
+
+	return deleted, nil
+}
+
+func normalizeCoupon(coupon entity.Coupon) (entity.Coupon, error) {
+	code, err := valueobjects.ParseCouponCode(coupon.Code.String())
+	if err != nil {
+		return coupon, fmt.Errorf("%w: %s", domainerrors.ErrInvalidArgument, err)
+	}
+
+	coupon.Code = code
+
+	if coupon.DiscountType, err = valueobjects.ParseDiscountType(coupon.DiscountType.String()); err != nil {
+		return coupon, fmt.Errorf("%w: %s", domainerrors.ErrInvalidArgument, err)
+	}
+
+	if coupon.DiscountValue <= 0 {
+		return coupon, fmt.Errorf("%w: discount value must be positive", domainerrors.ErrInvalidArgument)
+	}
+
+	if coupon.DiscountType == valueobjects.DiscountTypePercentage && coupon.DiscountValue > 100 {
+		return coupon, fmt.Errorf("%w: percentage discount must not exceed 100", domainerrors.ErrInvalidArgument)
+	}
+
+	if coupon.MaxUses < 0 {
+		return coupon, fmt.Errorf("%w: max uses must not be negative", domainerrors.ErrInvalidArgument)
+	}
+
+	if coupon.ValidFrom != nil && coupon.ValidUntil != nil && !coupon.ValidFrom.Before(*coupon.ValidUntil) {
+		return coupon, fmt.Errorf("%w: valid from must be before valid until", domainerrors.ErrInvalidArgument)
+	}
+
+	if coupon.MinYear < 0 || coupon.MaxYear < 0 {
+		return coupon, fmt.Errorf("%w: years must not be negative", domainerrors.ErrInvalidArgument)
+	}
+
+	if coupon.MaxYear > 0 && coupon.MinYear > coupon.MaxYear {
+		return coupon, fmt.Errorf("%w: min year must not exceed max year", domainerrors.ErrInvalidArgument)
+	}
+
+	coupon.Brand = strings.TrimSpace(coupon.Brand)
+	coupon.Model = strings.TrimSpace(coupon.Model)
+
+	return coupon, nil
+}
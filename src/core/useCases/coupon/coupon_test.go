@@ -0,0 +1,137 @@
+package coupon
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestCreate(t *testing.T) {
+	ctx := context.TODO()
+
+	coupon := entity.Coupon{
+		DealerID:      1,
+		Code:          "BLACKFRIDAY",
+		DiscountType:  valueobjects.DiscountTypePercentage,
+		DiscountValue: 10,
+	}
+
+	now := time.Now()
+	later := now.Add(time.Hour)
+
+	invalid := map[string]func(coupon *entity.Coupon){
+		"invalid code":                  func(coupon *entity.Coupon) { coupon.Code = "BLACK FRIDAY" },
+		"unknown discount type":         func(coupon *entity.Coupon) { coupon.DiscountType = "FREE" },
+		"no discount value":             func(coupon *entity.Coupon) { coupon.DiscountValue = 0 },
+		"percentage above 100":          func(coupon *entity.Coupon) { coupon.DiscountValue = 100.5 },
+		"negative max uses":             func(coupon *entity.Coupon) { coupon.MaxUses = -1 },
+		"valid until before valid from": func(coupon *entity.Coupon) { coupon.ValidFrom, coupon.ValidUntil = &later, &now },
+		"min year above max year":       func(coupon *entity.Coupon) { coupon.MinYear, coupon.MaxYear = 2024, 2020 },
+	}
+
+	for name, change := range invalid {
+		t.Run("should not create coupon with "+name, func(t *testing.T) {
+			service := NewCouponService(mocks.NewCouponRepository(t))
+
+			invalidCoupon := coupon
+			change(&invalidCoupon)
+
+			actual, err := service.Create(ctx, invalidCoupon)
+
+			assert.Nil(t, actual)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		})
+	}
+
+	t.Run("should not create coupon when failed to create", func(t *testing.T) {
+		unexpectedError := errors.New("unexpected error")
+
+		couponRepositoryMocked := mocks.NewCouponRepository(t)
+
+		couponRepositoryMocked.On("Create", ctx, coupon).
+			Return(nil, unexpectedError)
+
+		service := NewCouponService(couponRepositoryMocked)
+
+		actual, err := service.Create(ctx, coupon)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+	})
+
+	t.Run("should create coupon with normalized code and discount type", func(t *testing.T) {
+		couponRepositoryMocked := mocks.NewCouponRepository(t)
+
+		couponRepositoryMocked.On("Create", ctx, coupon).
+			Return(&entity.Coupon{ID: 1, Code: coupon.Code}, nil)
+
+		service := NewCouponService(couponRepositoryMocked)
+
+		lowerCase := coupon
+		lowerCase.Code = " blackfriday "
+		lowerCase.DiscountType = "percentage"
+
+		actual, err := service.Create(ctx, lowerCase)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, actual.ID)
+	})
+
+	t.Run("should create fixed discount coupon above 100", func(t *testing.T) {
+		fixed := coupon
+		fixed.DiscountType = valueobjects.DiscountTypeFixed
+		fixed.DiscountValue = 5000
+
+		couponRepositoryMocked := mocks.NewCouponRepository(t)
+
+		couponRepositoryMocked.On("Create", ctx, fixed).
+			Return(&entity.Coupon{ID: 1}, nil)
+
+		service := NewCouponService(couponRepositoryMocked)
+
+		actual, err := service.Create(ctx, fixed)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, actual.ID)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("should return nil when coupon does not exist", func(t *testing.T) {
+		couponRepositoryMocked := mocks.NewCouponRepository(t)
+
+		couponRepositoryMocked.On("Delete", ctx, 1).
+			Return(nil, nil)
+
+		service := NewCouponService(couponRepositoryMocked)
+
+		actual, err := service.Delete(ctx, 1)
+
+		assert.Nil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("should delete coupon successfully", func(t *testing.T) {
+		couponRepositoryMocked := mocks.NewCouponRepository(t)
+
+		couponRepositoryMocked.On("Delete", ctx, 1).
+			Return(&entity.Coupon{ID: 1}, nil)
+
+		service := NewCouponService(couponRepositoryMocked)
+
+		actual, err := service.Delete(ctx, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, actual.ID)
+	})
+}
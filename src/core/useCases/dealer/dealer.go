@@ -0,0 +1,50 @@
+package dealer
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+type dealerService struct {
+	dealerRepository interfaces.DealerRepository
+}
+
+func NewDealerService(dealerRepository interfaces.DealerRepository) interfaces.DealerService {
+	return &dealerService{
+		dealerRepository: dealerRepository,
+	}
+}
+
+func (ref *dealerService) Create(ctx context.Context, dealer entity.Dealer) (*entity.Dealer, error) {
+	if strings.TrimSpace(dealer.Name) == "" {
+		return nil, fmt.Errorf("%w: dealer name is required", domainerrors.ErrInvalidArgument)
+	}
+
+	created, err := ref.dealerRepository.Create(ctx, dealer)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).InfoContext(ctx, "dealer created",
+		"dealer_id", created.ID,
+		"name", created.Name,
+		"principal", session.Subject(ctx),
+	)
+
+	return created, nil
+}
+
+func (ref *dealerService) GetByID(ctx context.Context, id int) (*entity.Dealer, error) {
+	return ref.dealerRepository.GetByID(ctx, id)
+}
+
+func (ref *dealerService) Search(ctx context.Context) ([]entity.Dealer, error) {
+	return ref.dealerRepository.Search(ctx)
+}
@@ -0,0 +1,75 @@
+package dealer
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+func TestCreate(t *testing.T) {
+	ctx := context.TODO()
+	dealer := entity.Dealer{Name: "Some Dealer"}
+
+	t.Run("should not create dealer without name", func(t *testing.T) {
+		service := NewDealerService(mocks.NewDealerRepository(t))
+
+		actual, err := service.Create(ctx, entity.Dealer{Name: " "})
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+	})
+
+	t.Run("should not create dealer when failed to create", func(t *testing.T) {
+		unexpectedError := errors.New("unexpected error")
+
+		dealerRepositoryMocked := mocks.NewDealerRepository(t)
+
+		dealerRepositoryMocked.On("Create", ctx, dealer).
+			Return(nil, unexpectedError)
+
+		service := NewDealerService(dealerRepositoryMocked)
+
+		actual, err := service.Create(ctx, dealer)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+	})
+
+	t.Run("should create dealer successfully", func(t *testing.T) {
+		dealerRepositoryMocked := mocks.NewDealerRepository(t)
+
+		dealerRepositoryMocked.On("Create", ctx, dealer).
+			Return(&entity.Dealer{ID: 1, Name: dealer.Name}, nil)
+
+		service := NewDealerService(dealerRepositoryMocked)
+
+		actual, err := service.Create(ctx, dealer)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, actual.ID)
+	})
+}
+
+func TestSearch(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("should search dealers successfully", func(t *testing.T) {
+		dealerRepositoryMocked := mocks.NewDealerRepository(t)
+
+		dealerRepositoryMocked.On("Search", ctx).
+			Return([]entity.Dealer{{ID: 1}, {ID: 2}}, nil)
+
+		service := NewDealerService(dealerRepositoryMocked)
+
+		actual, err := service.Search(ctx)
+
+		assert.Nil(t, err)
+		assert.Len(t, actual, 2)
+	})
+}
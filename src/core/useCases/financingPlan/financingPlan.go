@@ -0,0 +1,103 @@
+package financingplan
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+// maxMonths bounds the installments of a plan to ten years.
+const maxMonths = 120
+
+type financingPlanService struct {
+	financingPlanRepository interfaces.FinancingPlanRepository
+}
+
+func NewFinancingPlanService(financingPlanRepository interfaces.FinancingPlanRepository) interfaces.FinancingPlanService {
+	return &financingPlanService{
+		financingPlanRepository: financingPlanRepository,
+	}
+}
+
+func (ref *financingPlanService) Create(ctx context.Context, plan entity.FinancingPlan) (*entity.FinancingPlan, error) {
+	plan, err := normalizeFinancingPlan(plan)
+	if err != nil {
+		return nil, err
+	}
+
+	created, err := ref.financingPlanRepository.Create(ctx, plan)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).InfoContext(ctx, "financing plan created",
+		"financing_plan_id", created.ID,
+		"dealer_id", created.DealerID,
+		"amortization_system", created.AmortizationSystem,
+		"monthly_interest_rate", created.MonthlyInterestRate,
+		"principal", session.Subject(ctx),
+	)
+
+	return created, nil
+}
+
+func (ref *financingPlanService) GetByID(ctx context.Context, id int) (*entity.FinancingPlan, error) {
+	return ref.financingPlanRepository.GetByID(ctx, id)
+}
+
+func (ref *financingPlanService) Search(ctx context.Context) ([]entity.FinancingPlan, error) {
+	return ref.financingPlanRepository.Search(ctx)
+}
+
+// Delete only stops the plan from being chosen, financed sales keep the terms
+// they were made with.
+func (ref *financingPlanService) Delete(ctx context.Context, id int) (*entity.FinancingPlan, error) {
+	deleted, err := ref.financingPlanRepository.Delete(ctx, id)
+	if err != nil || deleted == nil {
+		return deleted, err
+	}
+
+	logger.FromContext(ctx).InfoContext(ctx, "financing plan deleted",
+		"financing_plan_id", deleted.ID,
+		"dealer_id", deleted.DealerID,
+		"principal", session.Subject(ctx),
+	)
+
+	return deleted, nil
+}
+
+func normalizeFinancingPlan(plan entity.FinancingPlan) (entity.FinancingPlan, error) {
+	plan.Name = strings.TrimSpace(plan.Name)
+
+	if plan.Name == "" {
+		return plan, fmt.Errorf("%w: name is required", domainerrors.ErrInvalidArgument)
+	}
+
+	system, err := valueobjects.ParseAmortizationSystem(plan.AmortizationSystem.String())
+	if err != nil {
+		return plan, fmt.Errorf("%w: %s", domainerrors.ErrInvalidArgument, err)
+	}
+
+	plan.AmortizationSystem = system
+
+	if plan.MonthlyInterestRate < 0 || plan.MonthlyInterestRate >= 100 {
+		return plan, fmt.Errorf("%w: monthly interest rate must be from 0 to below 100", domainerrors.ErrInvalidArgument)
+	}
+
+	if plan.MaxMonths <= 0 || plan.MaxMonths > maxMonths {
+		return plan, fmt.Errorf("%w: max months must be from 1 to %d", domainerrors.ErrInvalidArgument, maxMonths)
+	}
+
+	if plan.MinDownPaymentPercentage < 0 || plan.MinDownPaymentPercentage >= 100 {
+		return plan, fmt.Errorf("%w: min down payment percentage must be from 0 to below 100", domainerrors.ErrInvalidArgument)
+	}
+
+	return plan, nil
+}
@@ -0,0 +1,117 @@
+package financingplan
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestCreate(t *testing.T) {
+	ctx := context.TODO()
+
+	plan := entity.FinancingPlan{
+		DealerID:                 1,
+		Name:                     "48x",
+		AmortizationSystem:       valueobjects.AmortizationSystemPrice,
+		MonthlyInterestRate:      1.49,
+		MaxMonths:                48,
+		MinDownPaymentPercentage: 20,
+	}
+
+	invalid := map[string]func(plan *entity.FinancingPlan){
+		"no name":                          func(plan *entity.FinancingPlan) { plan.Name = " " },
+		"unknown amortization system":      func(plan *entity.FinancingPlan) { plan.AmortizationSystem = "GERMAN" },
+		"negative interest rate":           func(plan *entity.FinancingPlan) { plan.MonthlyInterestRate = -1 },
+		"no months":                        func(plan *entity.FinancingPlan) { plan.MaxMonths = 0 },
+		"more than ten years":              func(plan *entity.FinancingPlan) { plan.MaxMonths = 121 },
+		"down payment of the whole amount": func(plan *entity.FinancingPlan) { plan.MinDownPaymentPercentage = 100 },
+		"negative down payment percentage": func(plan *entity.FinancingPlan) { plan.MinDownPaymentPercentage = -5 },
+	}
+
+	for name, change := range invalid {
+		t.Run("should not create financing plan with "+name, func(t *testing.T) {
+			service := NewFinancingPlanService(mocks.NewFinancingPlanRepository(t))
+
+			invalidPlan := plan
+			change(&invalidPlan)
+
+			actual, err := service.Create(ctx, invalidPlan)
+
+			assert.Nil(t, actual)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		})
+	}
+
+	t.Run("should not create financing plan when failed to create", func(t *testing.T) {
+		unexpectedError := errors.New("unexpected error")
+
+		financingPlanRepositoryMocked := mocks.NewFinancingPlanRepository(t)
+
+		financingPlanRepositoryMocked.On("Create", ctx, plan).
+			Return(nil, unexpectedError)
+
+		service := NewFinancingPlanService(financingPlanRepositoryMocked)
+
+		actual, err := service.Create(ctx, plan)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+	})
+
+	t.Run("should create financing plan with normalized amortization system", func(t *testing.T) {
+		financingPlanRepositoryMocked := mocks.NewFinancingPlanRepository(t)
+
+		financingPlanRepositoryMocked.On("Create", ctx, plan).
+			Return(&entity.FinancingPlan{ID: 1}, nil)
+
+		service := NewFinancingPlanService(financingPlanRepositoryMocked)
+
+		lowerCase := plan
+		lowerCase.Name = " 48x "
+		lowerCase.AmortizationSystem = "price"
+
+		actual, err := service.Create(ctx, lowerCase)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, actual.ID)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("should return nil when financing plan does not exist", func(t *testing.T) {
+		financingPlanRepositoryMocked := mocks.NewFinancingPlanRepository(t)
+
+		financingPlanRepositoryMocked.On("Delete", ctx, 1).
+			Return(nil, nil)
+
+		service := NewFinancingPlanService(financingPlanRepositoryMocked)
+
+		actual, err := service.Delete(ctx, 1)
+
+		assert.Nil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("should delete financing plan successfully", func(t *testing.T) {
+		financingPlanRepositoryMocked := mocks.NewFinancingPlanRepository(t)
+
+		financingPlanRepositoryMocked.On("Delete", ctx, 1).
+			Return(&entity.FinancingPlan{ID: 1}, nil)
+
+		service := NewFinancingPlanService(financingPlanRepositoryMocked)
+
+		actual, err := service.Delete(ctx, 1)
+
+		assert.Nil(t, err)
+		assert.Equal(t, 1, actual.ID)
+	})
+}
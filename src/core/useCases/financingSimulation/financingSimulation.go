@@ -0,0 +1,44 @@
+package financingsimulation
+
+import (
+	"context"
+	"time"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/financing"
+)
+
+type financingSimulationService struct {
+	vehicleService interfaces.VehicleService
+	terms          financing.Terms
+	timeGenerator  func() time.Time
+}
+
+// NewFinancingSimulationService reads vehicles through vehicleService, so a
+// vehicle hidden from the caller can not be simulated either.
+func NewFinancingSimulationService(vehicleService interfaces.VehicleService, terms financing.Terms, timeGenerator func() time.Time) interfaces.FinancingSimulationService {
+	return &financingSimulationService{
+		vehicleService: vehicleService,
+		terms:          terms,
+		timeGenerator:  timeGenerator,
+	}
+}
+
+// Simulate works on the current price of the vehicle, so it may differ from a
+// coupon or an accepted offer the buyer has.
+func (ref *financingSimulationService) Simulate(ctx context.Context, entityID string, downPayment float64, months int) (*entity.FinancingSimulation, error) {
+	vehicle, err := ref.vehicleService.GetByID(ctx, entityID)
+	if err != nil || vehicle == nil {
+		return nil, err
+	}
+
+	simulation, err := financing.Simulate(ref.terms, vehicle.Price, downPayment, months, ref.timeGenerator())
+	if err != nil {
+		return nil, err
+	}
+
+	simulation.EntityID = vehicle.EntityID
+
+	return simulation, nil
+}
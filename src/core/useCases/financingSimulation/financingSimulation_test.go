@@ -0,0 +1,89 @@
+package financingsimulation
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/financing"
+)
+
+func TestSimulate(t *testing.T) {
+	ctx := context.TODO()
+	entityID := uuid.NewString()
+
+	now := time.Date(2026, time.October, 19, 10, 0, 0, 0, time.UTC)
+	timeGenerator := func() time.Time { return now }
+
+	terms := financing.Terms{
+		Rates: financing.RateTable{{MaxMonths: 48, MonthlyInterestRate: 1.5}},
+	}
+
+	t.Run("should return nil when vehicle is not found", func(t *testing.T) {
+		vehicleServiceMocked := mocks.NewVehicleService(t)
+
+		vehicleServiceMocked.On("GetByID", ctx, entityID).
+			Return(nil, nil)
+
+		service := NewFinancingSimulationService(vehicleServiceMocked, terms, timeGenerator)
+
+		actual, err := service.Simulate(ctx, entityID, 10000, 12)
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should return error when failed to get vehicle", func(t *testing.T) {
+		vehicleServiceMocked := mocks.NewVehicleService(t)
+
+		vehicleServiceMocked.On("GetByID", ctx, entityID).
+			Return(nil, errors.New("some error"))
+
+		service := NewFinancingSimulationService(vehicleServiceMocked, terms, timeGenerator)
+
+		actual, err := service.Simulate(ctx, entityID, 10000, 12)
+
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+	})
+
+	t.Run("should not simulate down payment of the whole price", func(t *testing.T) {
+		vehicleServiceMocked := mocks.NewVehicleService(t)
+
+		vehicleServiceMocked.On("GetByID", ctx, entityID).
+			Return(&entity.Vehicle{EntityID: entityID, Price: 50000}, nil)
+
+		service := NewFinancingSimulationService(vehicleServiceMocked, terms, timeGenerator)
+
+		actual, err := service.Simulate(ctx, entityID, 50000, 12)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+	})
+
+	t.Run("should simulate on the current price of the vehicle", func(t *testing.T) {
+		vehicleServiceMocked := mocks.NewVehicleService(t)
+
+		vehicleServiceMocked.On("GetByID", ctx, entityID).
+			Return(&entity.Vehicle{EntityID: entityID, Price: 50000}, nil)
+
+		service := NewFinancingSimulationService(vehicleServiceMocked, terms, timeGenerator)
+
+		actual, err := service.Simulate(ctx, entityID, 10000, 12)
+
+		assert.Nil(t, err)
+		assert.Equal(t, entityID, actual.EntityID)
+		assert.Equal(t, 50000.0, actual.Price)
+		assert.Equal(t, 40000.0, actual.Principal)
+		assert.Equal(t, 1.5, actual.MonthlyInterestRate)
+		assert.Len(t, actual.Options, 2)
+		assert.Equal(t, time.Date(2026, time.November, 19, 0, 0, 0, 0, time.UTC), actual.Options[0].Installments[0].DueDate)
+	})
+}
@@ -0,0 +1,416 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"image"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"net/http"
+	"slices"
+
+	"github.com/google/uuid"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+// extensions are the file extensions of the content types accepted, as
+// sniffed from the first bytes of the upload.
+var extensions = map[string]string{
+	"image/jpeg": ".jpg",
+	"image/png":  ".png",
+}
+
+// Limits bound the photos accepted for a vehicle. Uploads larger than MaxSize
+// bytes or with dimensions outside of the Min and Max bounds are refused, and
+// thumbnails fit in a ThumbnailSize square.
+type Limits struct {
+	MaxSize       int64
+	MinWidth      int
+	MinHeight     int
+	MaxWidth      int
+	MaxHeight     int
+	MaxPerVehicle int
+	ThumbnailSize int
+}
+
+type mediaService struct {
+	vehicleRepository interfaces.VehicleRepository
+	mediaRepository   interfaces.VehicleMediaRepository
+	storage           interfaces.MediaStorage
+	txManager         interfaces.TxManager
+	limits            Limits
+}
+
+func NewVehicleMediaService(
+	vehicleRepository interfaces.VehicleRepository,
+	mediaRepository interfaces.VehicleMediaRepository,
+	storage interfaces.MediaStorage,
+	txManager interfaces.TxManager,
+	limits Limits,
+) interfaces.VehicleMediaService {
+	return &mediaService{
+		vehicleRepository: vehicleRepository,
+		mediaRepository:   mediaRepository,
+		storage:           storage,
+		txManager:         txManager,
+		limits:            limits,
+	}
+}
+
+// Upload checks and stores a photo of the vehicle, returning nil when the
+// vehicle does not exist. The photo is appended after the others and becomes
+// the cover when it is the first one.
+func (ref *mediaService) Upload(ctx context.Context, vehicleID string, content io.Reader) (*entity.VehicleMedia, error) {
+	data, err := io.ReadAll(io.LimitReader(content, ref.limits.MaxSize+1))
+	if err != nil {
+		return nil, err
+	}
+
+	if int64(len(data)) > ref.limits.MaxSize {
+		return nil, fmt.Errorf("%w: media must be up to %d bytes", domainerrors.ErrMediaTooLarge, ref.limits.MaxSize)
+	}
+
+	contentType := http.DetectContentType(data)
+
+	extension, ok := extensions[contentType]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", domainerrors.ErrUnsupportedMedia, contentType)
+	}
+
+	config, _, err := image.DecodeConfig(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainerrors.ErrUnsupportedMedia, err)
+	}
+
+	if err = ref.checkDimensions(config.Width, config.Height); err != nil {
+		return nil, err
+	}
+
+	decoded, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", domainerrors.ErrUnsupportedMedia, err)
+	}
+
+	thumbnailData, err := thumbnail(decoded, ref.limits.ThumbnailSize)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *entity.VehicleMedia
+
+	err = interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		vehicle, existing, err := ref.lockMedia(ctx, vehicleID)
+		if err != nil || vehicle == nil {
+			return err
+		}
+
+		if len(existing) >= ref.limits.MaxPerVehicle {
+			return fmt.Errorf("%w: vehicle already has %d media", domainerrors.ErrInvalidArgument, len(existing))
+		}
+
+		mediaID := uuid.NewString()
+		prefix := fmt.Sprintf("vehicles/%d/%s", vehicle.DealerID, mediaID)
+
+		media := entity.VehicleMedia{
+			EntityID:     mediaID,
+			VehicleID:    vehicleID,
+			DealerID:     vehicle.DealerID,
+			ContentType:  contentType,
+			Size:         int64(len(data)),
+			Width:        config.Width,
+			Height:       config.Height,
+			Position:     len(existing),
+			IsCover:      len(existing) == 0,
+			StorageKey:   prefix + extension,
+			ThumbnailKey: prefix + "_thumbnail.jpg",
+		}
+
+		if created, err = ref.store(ctx, media, data, thumbnailData); err != nil {
+			return err
+		}
+
+		return ref.touch(ctx, *vehicle)
+	})
+	if err != nil {
+		if created != nil {
+			ref.deleteFiles(ctx, *created)
+		}
+		return nil, err
+	}
+
+	if created != nil {
+		logger.FromContext(ctx).InfoContext(ctx, "vehicle media uploaded",
+			"entity_id", created.EntityID,
+			"vehicle_id", vehicleID,
+			"content_type", contentType,
+			"size", created.Size,
+			"principal", session.Subject(ctx),
+		)
+	}
+
+	return created, nil
+}
+
+func (ref *mediaService) Search(ctx context.Context, vehicleIDs []string) ([]entity.VehicleMedia, error) {
+	return ref.mediaRepository.SearchByVehicleIDs(ctx, vehicleIDs)
+}
+
+// Open returns the media of the vehicle with its file, or its thumbnail, for
+// reading. It returns nil when the vehicle has no such media. Open does not
+// check the vehicle is visible to the caller, which is left to whoever serves
+// the file.
+func (ref *mediaService) Open(ctx context.Context, vehicleID, mediaID string, thumbnail bool) (*entity.VehicleMedia, io.ReadCloser, error) {
+	media, err := ref.mediaRepository.GetByID(ctx, mediaID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if media == nil || media.VehicleID != vehicleID {
+		return nil, nil, nil
+	}
+
+	key := media.StorageKey
+	if thumbnail {
+		key = media.ThumbnailKey
+	}
+
+	file, err := ref.storage.Open(ctx, key)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return media, file, nil
+}
+
+// SetCover makes the media the cover of the vehicle, returning the media of
+// the vehicle, or nil when the vehicle has no such media.
+func (ref *mediaService) SetCover(ctx context.Context, vehicleID, mediaID string) ([]entity.VehicleMedia, error) {
+	var media []entity.VehicleMedia
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		vehicle, current, err := ref.lockMedia(ctx, vehicleID)
+		if err != nil {
+			return err
+		}
+
+		if !slices.ContainsFunc(current, func(item entity.VehicleMedia) bool { return item.EntityID == mediaID }) {
+			return nil
+		}
+
+		for i := range current {
+			current[i].IsCover = current[i].EntityID == mediaID
+		}
+
+		if media, err = ref.save(ctx, current); err != nil {
+			return err
+		}
+
+		return ref.touch(ctx, *vehicle)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// Reorder sets the positions of the media of the vehicle to their order in
+// mediaIDs, which must list every media of the vehicle once. It returns nil
+// when the vehicle does not exist.
+func (ref *mediaService) Reorder(ctx context.Context, vehicleID string, mediaIDs []string) ([]entity.VehicleMedia, error) {
+	var media []entity.VehicleMedia
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		vehicle, current, err := ref.lockMedia(ctx, vehicleID)
+		if err != nil || vehicle == nil {
+			return err
+		}
+
+		positions := make(map[string]int, len(mediaIDs))
+		for i, id := range mediaIDs {
+			positions[id] = i
+		}
+
+		errNotPermutation := fmt.Errorf("%w: media ids must list the %d media of the vehicle once", domainerrors.ErrInvalidArgument, len(current))
+
+		if len(mediaIDs) != len(current) || len(positions) != len(current) {
+			return errNotPermutation
+		}
+
+		for i := range current {
+			position, ok := positions[current[i].EntityID]
+			if !ok {
+				return errNotPermutation
+			}
+
+			current[i].Position = position
+		}
+
+		if media, err = ref.save(ctx, current); err != nil {
+			return err
+		}
+
+		return ref.touch(ctx, *vehicle)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+// Delete removes the media of the vehicle, closing the gap it leaves in the
+// positions and moving the cover to the first media left when it was the
+// cover. The files are deleted once the transaction is committed.
+func (ref *mediaService) Delete(ctx context.Context, vehicleID, mediaID string) (*entity.VehicleMedia, error) {
+	var deleted *entity.VehicleMedia
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		vehicle, current, err := ref.lockMedia(ctx, vehicleID)
+		if err != nil {
+			return err
+		}
+
+		i := slices.IndexFunc(current, func(item entity.VehicleMedia) bool { return item.EntityID == mediaID })
+		if i < 0 {
+			return nil
+		}
+
+		if deleted, err = ref.mediaRepository.Delete(ctx, mediaID); err != nil || deleted == nil {
+			return err
+		}
+
+		remaining := slices.Delete(current, i, i+1)
+		for position := range remaining {
+			remaining[position].Position = position
+		}
+
+		if deleted.IsCover && len(remaining) > 0 {
+			remaining[0].IsCover = true
+		}
+
+		if _, err = ref.save(ctx, remaining); err != nil {
+			return err
+		}
+
+		return ref.touch(ctx, *vehicle)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if deleted != nil {
+		ref.deleteFiles(ctx, *deleted)
+
+		logger.FromContext(ctx).InfoContext(ctx, "vehicle media deleted",
+			"entity_id", mediaID,
+			"vehicle_id", vehicleID,
+			"principal", session.Subject(ctx),
+		)
+	}
+
+	return deleted, nil
+}
+
+func (ref *mediaService) checkDimensions(width, height int) error {
+	if width < ref.limits.MinWidth || height < ref.limits.MinHeight {
+		return fmt.Errorf("%w: media must be at least %dx%d, got %dx%d",
+			domainerrors.ErrInvalidArgument, ref.limits.MinWidth, ref.limits.MinHeight, width, height)
+	}
+
+	if width > ref.limits.MaxWidth || height > ref.limits.MaxHeight {
+		return fmt.Errorf("%w: media must be up to %dx%d, got %dx%d",
+			domainerrors.ErrInvalidArgument, ref.limits.MaxWidth, ref.limits.MaxHeight, width, height)
+	}
+
+	return nil
+}
+
+// store puts the files of media before creating it, returning media even on
+// error once a file may have been written, so the caller can clean it up.
+func (ref *mediaService) store(ctx context.Context, media entity.VehicleMedia, data, thumbnailData []byte) (*entity.VehicleMedia, error) {
+	if err := ref.storage.Put(ctx, media.StorageKey, bytes.NewReader(data)); err != nil {
+		return &media, err
+	}
+
+	if err := ref.storage.Put(ctx, media.ThumbnailKey, bytes.NewReader(thumbnailData)); err != nil {
+		return &media, err
+	}
+
+	created, err := ref.mediaRepository.Create(ctx, media)
+	if err != nil {
+		return &media, err
+	}
+
+	return created, nil
+}
+
+// lockMedia locks the vehicle and returns it with its media, or nil when the
+// vehicle does not exist.
+func (ref *mediaService) lockMedia(ctx context.Context, vehicleID string) (*entity.Vehicle, []entity.VehicleMedia, error) {
+	vehicle, err := ref.vehicleRepository.GetByID(ctx, vehicleID)
+	if err != nil || vehicle == nil {
+		return nil, nil, err
+	}
+
+	media, err := ref.mediaRepository.SearchByVehicleIDs(ctx, []string{vehicleID})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return vehicle, media, nil
+}
+
+// touch writes the vehicle as is to bump its version, as its media are part of
+// the representation the ETag stands for.
+func (ref *mediaService) touch(ctx context.Context, vehicle entity.Vehicle) error {
+	updated, err := ref.vehicleRepository.Update(ctx, vehicle.EntityID, vehicle)
+	if err != nil {
+		return err
+	}
+
+	if updated == nil {
+		return fmt.Errorf("%w: vehicle %q changed while updating its media", domainerrors.ErrVersionMismatch, vehicle.EntityID)
+	}
+
+	return nil
+}
+
+// save updates every media and returns them ordered by position.
+func (ref *mediaService) save(ctx context.Context, media []entity.VehicleMedia) ([]entity.VehicleMedia, error) {
+	saved := make([]entity.VehicleMedia, 0, len(media))
+
+	for _, item := range media {
+		updated, err := ref.mediaRepository.Update(ctx, item)
+		if err != nil {
+			return nil, err
+		}
+
+		if updated == nil {
+			return nil, fmt.Errorf("media %q disappeared while updating it", item.EntityID)
+		}
+
+		saved = append(saved, *updated)
+	}
+
+	slices.SortFunc(saved, func(a, b entity.VehicleMedia) int {
+		return a.Position - b.Position
+	})
+
+	return saved, nil
+}
+
+func (ref *mediaService) deleteFiles(ctx context.Context, media entity.VehicleMedia) {
+	for _, key := range []string{media.StorageKey, media.ThumbnailKey} {
+		if err := ref.storage.Delete(ctx, key); err != nil {
+			logger.FromContext(ctx).ErrorContext(ctx, "failed to delete media file", "key", key, "error", err)
+		}
+	}
+}
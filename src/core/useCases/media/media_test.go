@@ -0,0 +1,456 @@
+package media
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"image"
+	"image/png"
+	"io"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+var limits = Limits{
+	MaxSize:       1 << 20,
+	MinWidth:      64,
+	MinHeight:     48,
+	MaxWidth:      1024,
+	MaxHeight:     1024,
+	MaxPerVehicle: 2,
+	ThumbnailSize: 32,
+}
+
+func TestUpload(t *testing.T) {
+	ctx := context.TODO()
+	vehicleID := "1"
+	vehicle := &entity.Vehicle{EntityID: vehicleID, DealerID: 7}
+	unexpectedError := errors.New("unexpected error")
+
+	t.Run("should refuse media larger than the limit", func(t *testing.T) {
+		service := NewVehicleMediaService(nil, nil, nil, nil, Limits{MaxSize: 10})
+
+		actual, err := service.Upload(ctx, vehicleID, strings.NewReader(strings.Repeat("a", 11)))
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrMediaTooLarge)
+	})
+
+	t.Run("should refuse media that is not a jpeg or png image", func(t *testing.T) {
+		service := NewVehicleMediaService(nil, nil, nil, nil, limits)
+
+		for _, content := range []string{"plain text", "GIF89a", "\x89PNG\r\n\x1a\ncorrupted"} {
+			actual, err := service.Upload(ctx, vehicleID, strings.NewReader(content))
+
+			assert.Nil(t, actual, content)
+			assert.ErrorIs(t, err, domainerrors.ErrUnsupportedMedia, content)
+		}
+	})
+
+	t.Run("should refuse image out of the dimension bounds", func(t *testing.T) {
+		service := NewVehicleMediaService(nil, nil, nil, nil, limits)
+
+		for _, size := range [][2]int{{32, 480}, {640, 24}, {2048, 480}} {
+			actual, err := service.Upload(ctx, vehicleID, bytes.NewReader(newPNG(t, size[0], size[1])))
+
+			assert.Nil(t, actual, size)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument, size)
+		}
+	})
+
+	t.Run("should return nil when vehicle does not exist", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
+			Return(nil, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleMediaService(vehicleRepositoryMocked, nil, nil, txManagerMocked, limits)
+
+		actual, err := service.Upload(ctx, vehicleID, bytes.NewReader(newPNG(t, 640, 480)))
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should refuse media over the limit per vehicle", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		mediaRepositoryMocked := mocks.NewVehicleMediaRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
+			Return(vehicle, nil)
+
+		mediaRepositoryMocked.On("SearchByVehicleIDs", ctx, []string{vehicleID}).
+			Return([]entity.VehicleMedia{{EntityID: "a"}, {EntityID: "b"}}, nil)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleMediaService(vehicleRepositoryMocked, mediaRepositoryMocked, nil, txManagerMocked, limits)
+
+		actual, err := service.Upload(ctx, vehicleID, bytes.NewReader(newPNG(t, 640, 480)))
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		mediaRepositoryMocked.AssertNumberOfCalls(t, "Create", 0)
+	})
+
+	t.Run("should delete stored files when failed to create media", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		mediaRepositoryMocked := mocks.NewVehicleMediaRepository(t)
+		storageMocked := mocks.NewMediaStorage(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
+			Return(vehicle, nil)
+
+		mediaRepositoryMocked.On("SearchByVehicleIDs", ctx, []string{vehicleID}).
+			Return([]entity.VehicleMedia{}, nil)
+
+		storageMocked.On("Put", ctx, mock.AnythingOfType("string"), mock.Anything).
+			Return(nil)
+
+		mediaRepositoryMocked.On("Create", ctx, mock.AnythingOfType("entity.VehicleMedia")).
+			Return(nil, unexpectedError)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		storageMocked.On("Delete", ctx, mock.AnythingOfType("string")).
+			Return(nil)
+
+		service := NewVehicleMediaService(vehicleRepositoryMocked, mediaRepositoryMocked, storageMocked, txManagerMocked, limits)
+
+		actual, err := service.Upload(ctx, vehicleID, bytes.NewReader(newPNG(t, 640, 480)))
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+		storageMocked.AssertNumberOfCalls(t, "Put", 2)
+		storageMocked.AssertNumberOfCalls(t, "Delete", 2)
+	})
+
+	t.Run("should store first photo with thumbnail as cover", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		mediaRepositoryMocked := mocks.NewVehicleMediaRepository(t)
+		storageMocked := mocks.NewMediaStorage(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		content := newPNG(t, 640, 480)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
+			Return(vehicle, nil)
+
+		mediaRepositoryMocked.On("SearchByVehicleIDs", ctx, []string{vehicleID}).
+			Return([]entity.VehicleMedia{}, nil)
+
+		var thumbnail bytes.Buffer
+
+		storageMocked.On("Put", ctx, mock.MatchedBy(func(key string) bool { return strings.HasSuffix(key, ".png") }), mock.Anything).
+			Return(nil)
+
+		storageMocked.On("Put", ctx, mock.MatchedBy(func(key string) bool { return strings.HasSuffix(key, "_thumbnail.jpg") }), mock.Anything).
+			Run(func(args mock.Arguments) { io.Copy(&thumbnail, args.Get(2).(io.Reader)) }).
+			Return(nil)
+
+		mediaRepositoryMocked.On("Create", ctx, mock.AnythingOfType("entity.VehicleMedia")).
+			Return(func(_ context.Context, media entity.VehicleMedia) (*entity.VehicleMedia, error) {
+				return &media, nil
+			})
+
+		vehicleRepositoryMocked.On("Update", ctx, vehicleID, *vehicle).
+			Return(vehicle, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleMediaService(vehicleRepositoryMocked, mediaRepositoryMocked, storageMocked, txManagerMocked, limits)
+
+		actual, err := service.Upload(ctx, vehicleID, bytes.NewReader(content))
+
+		require.Nil(t, err)
+		assert.NotEmpty(t, actual.EntityID)
+		assert.Equal(t, vehicleID, actual.VehicleID)
+		assert.Equal(t, 7, actual.DealerID)
+		assert.Equal(t, "image/png", actual.ContentType)
+		assert.Equal(t, int64(len(content)), actual.Size)
+		assert.Equal(t, 640, actual.Width)
+		assert.Equal(t, 480, actual.Height)
+		assert.Equal(t, 0, actual.Position)
+		assert.True(t, actual.IsCover)
+		assert.Equal(t, "vehicles/7/"+actual.EntityID+".png", actual.StorageKey)
+		assert.Equal(t, "vehicles/7/"+actual.EntityID+"_thumbnail.jpg", actual.ThumbnailKey)
+
+		config, format, err := image.DecodeConfig(&thumbnail)
+		require.Nil(t, err)
+		assert.Equal(t, "jpeg", format)
+		assert.Equal(t, 32, config.Width)
+		assert.Equal(t, 24, config.Height)
+	})
+}
+
+func TestOpen(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("should return nil when media is of another vehicle", func(t *testing.T) {
+		mediaRepositoryMocked := mocks.NewVehicleMediaRepository(t)
+
+		mediaRepositoryMocked.On("GetByID", ctx, "a").
+			Return(&entity.VehicleMedia{EntityID: "a", VehicleID: "2"}, nil)
+
+		service := NewVehicleMediaService(nil, mediaRepositoryMocked, nil, nil, limits)
+
+		media, file, err := service.Open(ctx, "1", "a", false)
+
+		assert.Nil(t, media)
+		assert.Nil(t, file)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should open thumbnail of the media", func(t *testing.T) {
+		mediaRepositoryMocked := mocks.NewVehicleMediaRepository(t)
+		storageMocked := mocks.NewMediaStorage(t)
+
+		stored := &entity.VehicleMedia{EntityID: "a", VehicleID: "1", StorageKey: "a.jpg", ThumbnailKey: "a_thumbnail.jpg"}
+
+		mediaRepositoryMocked.On("GetByID", ctx, "a").
+			Return(stored, nil)
+
+		storageMocked.On("Open", ctx, "a_thumbnail.jpg").
+			Return(io.NopCloser(strings.NewReader("thumbnail")), nil)
+
+		service := NewVehicleMediaService(nil, mediaRepositoryMocked, storageMocked, nil, limits)
+
+		media, file, err := service.Open(ctx, "1", "a", true)
+
+		require.Nil(t, err)
+		assert.Equal(t, stored, media)
+
+		content, _ := io.ReadAll(file)
+		assert.Equal(t, "thumbnail", string(content))
+	})
+}
+
+func TestSetCover(t *testing.T) {
+	ctx := context.TODO()
+	vehicleID := "1"
+
+	t.Run("should return nil when vehicle has no such media", func(t *testing.T) {
+		vehicleRepositoryMocked, mediaRepositoryMocked, txManagerMocked := lockedVehicle(t, ctx, vehicleID, newMedia("a", 0, true))
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleMediaService(vehicleRepositoryMocked, mediaRepositoryMocked, nil, txManagerMocked, limits)
+
+		actual, err := service.SetCover(ctx, vehicleID, "b")
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+		mediaRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+	})
+
+	t.Run("should move the cover to the media", func(t *testing.T) {
+		vehicleRepositoryMocked, mediaRepositoryMocked, txManagerMocked := lockedVehicle(t, ctx, vehicleID, newMedia("a", 0, true), newMedia("b", 1, false))
+
+		updatedByMock(mediaRepositoryMocked, ctx)
+		touchedByMock(vehicleRepositoryMocked, ctx, vehicleID)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleMediaService(vehicleRepositoryMocked, mediaRepositoryMocked, nil, txManagerMocked, limits)
+
+		actual, err := service.SetCover(ctx, vehicleID, "b")
+
+		require.Nil(t, err)
+		assert.Equal(t, []entity.VehicleMedia{newMedia("a", 0, false), newMedia("b", 1, true)}, actual)
+	})
+
+	t.Run("should fail when vehicle changed while updating its media", func(t *testing.T) {
+		vehicleRepositoryMocked, mediaRepositoryMocked, txManagerMocked := lockedVehicle(t, ctx, vehicleID, newMedia("a", 0, true), newMedia("b", 1, false))
+
+		updatedByMock(mediaRepositoryMocked, ctx)
+
+		vehicleRepositoryMocked.On("Update", ctx, vehicleID, entity.Vehicle{EntityID: vehicleID}).
+			Return(nil, nil)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleMediaService(vehicleRepositoryMocked, mediaRepositoryMocked, nil, txManagerMocked, limits)
+
+		actual, err := service.SetCover(ctx, vehicleID, "b")
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrVersionMismatch)
+	})
+}
+
+func TestReorder(t *testing.T) {
+	ctx := context.TODO()
+	vehicleID := "1"
+
+	t.Run("should refuse ids that are not every media of the vehicle once", func(t *testing.T) {
+		for _, ids := range [][]string{{"a"}, {"a", "a"}, {"a", "c"}, {"a", "b", "c"}} {
+			vehicleRepositoryMocked, mediaRepositoryMocked, txManagerMocked := lockedVehicle(t, ctx, vehicleID, newMedia("a", 0, true), newMedia("b", 1, false))
+
+			txManagerMocked.On("Rollback", ctx).
+				Return(nil)
+
+			service := NewVehicleMediaService(vehicleRepositoryMocked, mediaRepositoryMocked, nil, txManagerMocked, limits)
+
+			actual, err := service.Reorder(ctx, vehicleID, ids)
+
+			assert.Nil(t, actual, ids)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument, ids)
+			mediaRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+		}
+	})
+
+	t.Run("should set positions in the order of the ids", func(t *testing.T) {
+		vehicleRepositoryMocked, mediaRepositoryMocked, txManagerMocked := lockedVehicle(t, ctx, vehicleID, newMedia("a", 0, true), newMedia("b", 1, false), newMedia("c", 2, false))
+
+		updatedByMock(mediaRepositoryMocked, ctx)
+		touchedByMock(vehicleRepositoryMocked, ctx, vehicleID)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleMediaService(vehicleRepositoryMocked, mediaRepositoryMocked, nil, txManagerMocked, limits)
+
+		actual, err := service.Reorder(ctx, vehicleID, []string{"c", "a", "b"})
+
+		require.Nil(t, err)
+		assert.Equal(t, []entity.VehicleMedia{newMedia("c", 0, false), newMedia("a", 1, true), newMedia("b", 2, false)}, actual)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.TODO()
+	vehicleID := "1"
+
+	t.Run("should return nil when vehicle has no such media", func(t *testing.T) {
+		vehicleRepositoryMocked, mediaRepositoryMocked, txManagerMocked := lockedVehicle(t, ctx, vehicleID)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleMediaService(vehicleRepositoryMocked, mediaRepositoryMocked, nil, txManagerMocked, limits)
+
+		actual, err := service.Delete(ctx, vehicleID, "a")
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+		mediaRepositoryMocked.AssertNumberOfCalls(t, "Delete", 0)
+	})
+
+	t.Run("should close the gap and move the cover when deleting the cover", func(t *testing.T) {
+		vehicleRepositoryMocked, mediaRepositoryMocked, txManagerMocked := lockedVehicle(t, ctx, vehicleID, newMedia("a", 0, true), newMedia("b", 1, false), newMedia("c", 2, false))
+		storageMocked := mocks.NewMediaStorage(t)
+
+		deleted := newMedia("a", 0, true)
+
+		mediaRepositoryMocked.On("Delete", ctx, "a").
+			Return(&deleted, nil)
+
+		mediaRepositoryMocked.On("Update", ctx, newMedia("b", 0, true)).
+			Return(func(_ context.Context, media entity.VehicleMedia) (*entity.VehicleMedia, error) { return &media, nil })
+
+		mediaRepositoryMocked.On("Update", ctx, newMedia("c", 1, false)).
+			Return(func(_ context.Context, media entity.VehicleMedia) (*entity.VehicleMedia, error) { return &media, nil })
+
+		touchedByMock(vehicleRepositoryMocked, ctx, vehicleID)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		storageMocked.On("Delete", ctx, deleted.StorageKey).
+			Return(nil)
+
+		storageMocked.On("Delete", ctx, deleted.ThumbnailKey).
+			Return(nil)
+
+		service := NewVehicleMediaService(vehicleRepositoryMocked, mediaRepositoryMocked, storageMocked, txManagerMocked, limits)
+
+		actual, err := service.Delete(ctx, vehicleID, "a")
+
+		require.Nil(t, err)
+		assert.Equal(t, &deleted, actual)
+	})
+}
+
+// lockedVehicle mocks the lock of the vehicle with the given media inside of a
+// transaction.
+func lockedVehicle(t *testing.T, ctx context.Context, vehicleID string, media ...entity.VehicleMedia) (*mocks.VehicleRepository, *mocks.VehicleMediaRepository, *mocks.TxManager) {
+	vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+	mediaRepositoryMocked := mocks.NewVehicleMediaRepository(t)
+	txManagerMocked := mocks.NewTxManager(t)
+
+	txManagerMocked.On("Begin", ctx).
+		Return(ctx, nil)
+
+	vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
+		Return(&entity.Vehicle{EntityID: vehicleID}, nil)
+
+	mediaRepositoryMocked.On("SearchByVehicleIDs", ctx, []string{vehicleID}).
+		Return(append([]entity.VehicleMedia{}, media...), nil)
+
+	return vehicleRepositoryMocked, mediaRepositoryMocked, txManagerMocked
+}
+
+func updatedByMock(mediaRepositoryMocked *mocks.VehicleMediaRepository, ctx context.Context) {
+	mediaRepositoryMocked.On("Update", ctx, mock.AnythingOfType("entity.VehicleMedia")).
+		Return(func(_ context.Context, media entity.VehicleMedia) (*entity.VehicleMedia, error) {
+			return &media, nil
+		})
+}
+
+// touchedByMock expects the version of the vehicle to be bumped.
+func touchedByMock(vehicleRepositoryMocked *mocks.VehicleRepository, ctx context.Context, vehicleID string) {
+	vehicleRepositoryMocked.On("Update", ctx, vehicleID, entity.Vehicle{EntityID: vehicleID}).
+		Return(&entity.Vehicle{EntityID: vehicleID, Version: 2}, nil)
+}
+
+func newMedia(id string, position int, isCover bool) entity.VehicleMedia {
+	return entity.VehicleMedia{
+		EntityID:     id,
+		VehicleID:    "1",
+		Position:     position,
+		IsCover:      isCover,
+		StorageKey:   id + ".jpg",
+		ThumbnailKey: id + "_thumbnail.jpg",
+	}
+}
+
+func newPNG(t *testing.T, width, height int) []byte {
+	t.Helper()
+
+	var buffer bytes.Buffer
+	require.Nil(t, png.Encode(&buffer, image.NewGray(image.Rect(0, 0, width, height))))
+
+	return buffer.Bytes()
+}
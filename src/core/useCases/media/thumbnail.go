@@ -0,0 +1,86 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+)
+
+const (
+	thumbnailQuality = 85
+	// maxSamples bounds the source pixels averaged on each axis of a thumbnail
+	// pixel, so large photos cost the same as medium ones.
+	maxSamples = 4
+)
+
+// thumbnail scales src down to fit a size by size square, keeping its aspect
+// ratio, and encodes it as JPEG. Each pixel is the average of the box of src
+// it covers. Images already fitting the square keep their size.
+func thumbnail(src image.Image, size int) ([]byte, error) {
+	bounds := src.Bounds()
+	width, height := fit(bounds.Dx(), bounds.Dy(), size)
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+
+	for y := range height {
+		top := bounds.Min.Y + y*bounds.Dy()/height
+		bottom := bounds.Min.Y + (y+1)*bounds.Dy()/height
+
+		for x := range width {
+			left := bounds.Min.X + x*bounds.Dx()/width
+			right := bounds.Min.X + (x+1)*bounds.Dx()/width
+
+			dst.SetRGBA(x, y, average(src, image.Rect(left, top, right, bottom)))
+		}
+	}
+
+	var buffer bytes.Buffer
+	if err := jpeg.Encode(&buffer, dst, &jpeg.Options{Quality: thumbnailQuality}); err != nil {
+		return nil, err
+	}
+
+	return buffer.Bytes(), nil
+}
+
+// fit returns the dimensions of a width by height image scaled down to fit a
+// size by size square.
+func fit(width, height, size int) (int, int) {
+	if width <= size && height <= size {
+		return width, height
+	}
+
+	if width >= height {
+		return size, max(1, height*size/width)
+	}
+
+	return max(1, width*size/height), size
+}
+
+func average(src image.Image, box image.Rectangle) color.RGBA {
+	stepX := max(1, box.Dx()/maxSamples)
+	stepY := max(1, box.Dy()/maxSamples)
+
+	var r, g, b, a, samples uint32
+
+	for y := box.Min.Y; y < box.Max.Y; y += stepY {
+		for x := box.Min.X; x < box.Max.X; x += stepX {
+			sr, sg, sb, sa := src.At(x, y).RGBA()
+			r += sr >> 8
+			g += sg >> 8
+			b += sb >> 8
+			a += sa >> 8
+			samples++
+		}
+	}
+
+	// JPEG has no alpha, so transparent pixels are laid over white.
+	background := 0xff - a/samples
+
+	return color.RGBA{
+		R: uint8(r/samples + background),
+		G: uint8(g/samples + background),
+		B: uint8(b/samples + background),
+		A: 0xff,
+	}
+}
@@ -0,0 +1,74 @@
+package media
+
+import (
+	"bytes"
+	"image"
+	"image/color"
+	"image/jpeg"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestFit(t *testing.T) {
+	t.Run("should scale down the largest side to size", func(t *testing.T) {
+		width, height := fit(1280, 960, 320)
+		assert.Equal(t, []int{320, 240}, []int{width, height})
+
+		width, height = fit(960, 1280, 320)
+		assert.Equal(t, []int{240, 320}, []int{width, height})
+
+		width, height = fit(8000, 10, 320)
+		assert.Equal(t, []int{320, 1}, []int{width, height})
+	})
+
+	t.Run("should keep images that already fit", func(t *testing.T) {
+		width, height := fit(200, 100, 320)
+
+		assert.Equal(t, []int{200, 100}, []int{width, height})
+	})
+}
+
+func TestThumbnail(t *testing.T) {
+	t.Run("should encode scaled down jpeg", func(t *testing.T) {
+		src := image.NewRGBA(image.Rect(0, 0, 640, 480))
+		fill(src, color.RGBA{R: 200, G: 20, B: 20, A: 255})
+
+		data, err := thumbnail(src, 320)
+		require.Nil(t, err)
+
+		decoded, err := jpeg.Decode(bytes.NewReader(data))
+		require.Nil(t, err)
+
+		assert.Equal(t, image.Rect(0, 0, 320, 240), decoded.Bounds())
+
+		r, g, b, _ := decoded.At(160, 120).RGBA()
+		assert.InDelta(t, 200, r>>8, 8)
+		assert.InDelta(t, 20, g>>8, 8)
+		assert.InDelta(t, 20, b>>8, 8)
+	})
+
+	t.Run("should lay transparent pixels over white", func(t *testing.T) {
+		src := image.NewNRGBA(image.Rect(0, 0, 100, 100))
+
+		data, err := thumbnail(src, 50)
+		require.Nil(t, err)
+
+		decoded, err := jpeg.Decode(bytes.NewReader(data))
+		require.Nil(t, err)
+
+		r, g, b, _ := decoded.At(25, 25).RGBA()
+		assert.InDelta(t, 255, r>>8, 4)
+		assert.InDelta(t, 255, g>>8, 4)
+		assert.InDelta(t, 255, b>>8, 4)
+	})
+}
+
+func fill(img *image.RGBA, c color.RGBA) {
+	for y := img.Bounds().Min.Y; y < img.Bounds().Max.Y; y++ {
+		for x := img.Bounds().Min.X; x < img.Bounds().Max.X; x++ {
+			img.SetRGBA(x, y, c)
+		}
+	}
+}
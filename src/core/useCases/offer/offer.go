@@ -0,0 +1,404 @@
+package offer
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/uuid"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+// managerRoles answer the offers made to the vehicles of their scope and see
+// every offer. Other callers only see the offers they made.
+var managerRoles = []valueobjects.Role{valueobjects.RolePlatformAdmin, valueobjects.RoleAdmin, valueobjects.RoleDealerStaff}
+
+type offerService struct {
+	vehicleRepository              interfaces.VehicleRepository
+	offerRepository                interfaces.OfferRepository
+	saleRepository                 interfaces.SaleRepository
+	vehiclePlatformPaymentsAdapter interfaces.VehiclePlatformPaymentsAdapter
+	txManager                      interfaces.TxManager
+	ttl                            time.Duration
+	timeGenerator                  func() time.Time
+}
+
+// NewOfferService gives open offers ttl to be answered, counting again from
+// a counter of the dealer.
+func NewOfferService(
+	vehicleRepository interfaces.VehicleRepository,
+	offerRepository interfaces.OfferRepository,
+	saleRepository interfaces.SaleRepository,
+	vehiclePlatformPaymentsAdapter interfaces.VehiclePlatformPaymentsAdapter,
+	txManager interfaces.TxManager,
+	ttl time.Duration,
+	timeGenerator func() time.Time,
+) interfaces.OfferService {
+	return &offerService{
+		vehicleRepository:              vehicleRepository,
+		offerRepository:                offerRepository,
+		saleRepository:                 saleRepository,
+		vehiclePlatformPaymentsAdapter: vehiclePlatformPaymentsAdapter,
+		txManager:                      txManager,
+		ttl:                            ttl,
+		timeGenerator:                  timeGenerator,
+	}
+}
+
+// Create makes an offer of the caller for a published vehicle, returning nil
+// when the vehicle is not found. The offer must be below the price of the
+// vehicle, which can be bought as is otherwise.
+func (ref *offerService) Create(ctx context.Context, offer entity.Offer) (*entity.Offer, error) {
+	if offer.Amount <= 0 {
+		return nil, fmt.Errorf("%w: offer amount must be positive", domainerrors.ErrInvalidArgument)
+	}
+
+	var created *entity.Offer
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		now := ref.timeGenerator()
+
+		vehicle, err := ref.vehicleRepository.GetByID(ctx, offer.VehicleID)
+		if err != nil || vehicle == nil || !vehicle.IsPublishedAt(now) {
+			return err
+		}
+
+		if offer.Amount >= vehicle.Price {
+			return fmt.Errorf("%w: offer must be below the price of %.2f", domainerrors.ErrInvalidArgument, vehicle.Price)
+		}
+
+		if _, err = ref.checkNotSold(ctx, vehicle.EntityID); err != nil {
+			return err
+		}
+
+		id, err := uuid.NewV7()
+		if err != nil {
+			return err
+		}
+
+		created, err = ref.offerRepository.Create(ctx, entity.Offer{
+			EntityID:            id.String(),
+			VehicleID:           vehicle.EntityID,
+			DealerID:            vehicle.DealerID,
+			BuyerSubject:        session.Subject(ctx),
+			BuyerDocumentNumber: offer.BuyerDocumentNumber,
+			Amount:              offer.Amount,
+			Status:              valueobjects.OfferStatusPending,
+			ExpiresAt:           now.Add(ref.ttl),
+		})
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if created != nil {
+		logger.FromContext(ctx).InfoContext(ctx, "offer created",
+			"entity_id", created.EntityID,
+			"vehicle_id", created.VehicleID,
+			"amount", created.Amount,
+			"principal", session.Subject(ctx),
+		)
+	}
+
+	return created, nil
+}
+
+// Search returns the negotiation history of the vehicle, or nil when the
+// vehicle does not exist. Offers past their expiration are reported expired.
+func (ref *offerService) Search(ctx context.Context, vehicleID string) ([]entity.Offer, error) {
+	vehicle, err := ref.vehicleRepository.GetByID(ctx, vehicleID)
+	if err != nil || vehicle == nil {
+		return nil, err
+	}
+
+	offers, err := ref.offerRepository.SearchByVehicleID(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := ref.timeGenerator()
+	isManager := session.HasAnyRole(ctx, managerRoles...)
+	subject := session.Subject(ctx)
+
+	visible := make([]entity.Offer, 0, len(offers))
+
+	for _, offer := range offers {
+		if !isManager && offer.BuyerSubject != subject {
+			continue
+		}
+
+		offer.Status = offer.StatusAt(now)
+		visible = append(visible, offer)
+	}
+
+	return visible, nil
+}
+
+// Accept sells the vehicle for the price of the offer, through the same
+// payment flow as buying it, and rejects the other open offers of the
+// vehicle. An offer above the price, after the price of the vehicle was
+// lowered, can not be accepted. As in a buy, the sale is reserved along with
+// the answer and the payment is only generated after that transaction
+// commits; when it can not be generated, the reservation is cancelled and the
+// offer left as it was.
+func (ref *offerService) Accept(ctx context.Context, vehicleID, offerID string) (*entity.Offer, error) {
+	var previous entity.Offer
+	var reserved *entity.Sale
+
+	accepted, err := ref.answer(ctx, vehicleID, offerID, valueobjects.OfferStatusAccepted, func(ctx context.Context, vehicle *entity.Vehicle, offer *entity.Offer) error {
+		if !vehicle.IsPublishedAt(ref.timeGenerator()) {
+			return fmt.Errorf("%w: vehicle %q is not published", domainerrors.ErrInvalidState, vehicle.EntityID)
+		}
+
+		if offer.Price() > vehicle.Price {
+			return fmt.Errorf("%w: offer is above the price of %.2f", domainerrors.ErrInvalidState, vehicle.Price)
+		}
+
+		rejected, err := ref.checkNotSold(ctx, vehicle.EntityID)
+		if err != nil {
+			return err
+		}
+
+		if rejected != nil {
+			if _, err = ref.saleRepository.DeleteRejected(ctx, rejected.ID); err != nil {
+				return err
+			}
+		}
+
+		log := logger.FromContext(ctx).With("entity_id", vehicle.EntityID, "offer_id", offer.EntityID, "principal", session.Subject(ctx))
+		log.InfoContext(ctx, "buy started", "price", offer.Price())
+
+		sale := entity.Sale{
+			EntityID:            vehicle.EntityID,
+			DealerID:            vehicle.DealerID,
+			BuyerDocumentNumber: offer.BuyerDocumentNumber,
+			ListPrice:           vehicle.Price,
+			Discount:            vehicle.Price - offer.Price(),
+			Price:               offer.Price(),
+			Status:              valueobjects.SaleStatusTypePending,
+		}
+
+		created, err := ref.saleRepository.Create(ctx, sale)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to create sale", "error", err)
+			return err
+		}
+
+		previous = *offer
+		reserved = created
+		return nil
+	})
+	if err != nil || accepted == nil {
+		return nil, err
+	}
+
+	log := logger.FromContext(ctx).With("entity_id", vehicleID, "offer_id", offerID, "sale_id", reserved.ID, "principal", session.Subject(ctx))
+
+	paymentID, err := ref.vehiclePlatformPaymentsAdapter.GeneratePayment(ctx, entity.Payment{
+		Amount: accepted.Price(),
+		Status: valueobjects.SaleStatusTypeApproved.String(),
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "failed to generate payment", "error", err)
+		ref.cancelAcceptance(ctx, *reserved, previous)
+		return nil, err
+	}
+
+	log = log.With("payment_id", paymentID)
+	log.InfoContext(ctx, "payment generated")
+
+	accepted.PaymentID = paymentID
+
+	err = interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		if _, err := ref.saleRepository.AttachPayment(ctx, reserved.ID, paymentID); err != nil {
+			return err
+		}
+
+		if _, err := ref.offerRepository.Update(ctx, *accepted); err != nil {
+			return err
+		}
+
+		return ref.rejectOthers(ctx, *accepted)
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "failed to attach payment to sale", "error", err)
+		ref.cancelAcceptance(ctx, *reserved, previous)
+		return nil, err
+	}
+
+	log.InfoContext(ctx, "sale created", "status", reserved.Status.String())
+
+	return accepted, nil
+}
+
+// cancelAcceptance deletes the sale reserved by Accept whose payment could not
+// be generated and puts the offer back as it was before the answer, so it can
+// be answered again. It runs even when the caller went away, and only logs a
+// failure.
+func (ref *offerService) cancelAcceptance(ctx context.Context, reserved entity.Sale, previous entity.Offer) {
+	ctx = context.WithoutCancel(ctx)
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		if _, err := ref.saleRepository.DeleteReservation(ctx, reserved.ID); err != nil {
+			return err
+		}
+
+		_, err := ref.offerRepository.Update(ctx, previous)
+		return err
+	})
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "failed to cancel sale reservation",
+			"entity_id", reserved.EntityID,
+			"offer_id", previous.EntityID,
+			"sale_id", reserved.ID,
+			"error", err,
+		)
+	}
+}
+
+func (ref *offerService) Reject(ctx context.Context, vehicleID, offerID string) (*entity.Offer, error) {
+	return ref.answer(ctx, vehicleID, offerID, valueobjects.OfferStatusRejected, nil)
+}
+
+// Counter proposes amount to the buyer, who has a new ttl to answer it. The
+// counter must be above the offer, which can be accepted as is otherwise, and
+// not above the price of the vehicle, so the sale never gets a negative
+// discount.
+func (ref *offerService) Counter(ctx context.Context, vehicleID, offerID string, amount float64) (*entity.Offer, error) {
+	return ref.answer(ctx, vehicleID, offerID, valueobjects.OfferStatusCountered, func(ctx context.Context, vehicle *entity.Vehicle, offer *entity.Offer) error {
+		if amount <= offer.Amount {
+			return fmt.Errorf("%w: counter must be above the offer of %.2f", domainerrors.ErrInvalidArgument, offer.Amount)
+		}
+
+		if amount > vehicle.Price {
+			return fmt.Errorf("%w: counter must not be above the price of %.2f", domainerrors.ErrInvalidArgument, vehicle.Price)
+		}
+
+		offer.CounterAmount = &amount
+		offer.ExpiresAt = ref.timeGenerator().Add(ref.ttl)
+		return nil
+	})
+}
+
+// answer moves the offer to next when the state machine allows it and the
+// caller is the one expected to answer: staff for pending offers, the buyer
+// for countered ones. apply, when not nil, makes the changes that come with
+// the answer. The vehicle is locked first, so answers to the offers of a
+// vehicle run one at a time. answer returns nil when the vehicle or the offer
+// does not exist.
+func (ref *offerService) answer(ctx context.Context, vehicleID, offerID string, next valueobjects.OfferStatus, apply func(ctx context.Context, vehicle *entity.Vehicle, offer *entity.Offer) error) (*entity.Offer, error) {
+	var answered *entity.Offer
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		vehicle, err := ref.vehicleRepository.GetByID(ctx, vehicleID)
+		if err != nil || vehicle == nil {
+			return err
+		}
+
+		offer, err := ref.offerRepository.GetByID(ctx, offerID)
+		if err != nil || offer == nil || offer.VehicleID != vehicleID {
+			return err
+		}
+
+		status := offer.StatusAt(ref.timeGenerator())
+		if !status.CanBecome(next) {
+			return fmt.Errorf("%w: offer %q is %s", domainerrors.ErrInvalidState, offerID, status)
+		}
+
+		if err = checkAnswerer(ctx, *offer); err != nil {
+			return err
+		}
+
+		if apply != nil {
+			if err = apply(ctx, vehicle, offer); err != nil {
+				return err
+			}
+		}
+
+		offer.Status = next
+
+		answered, err = ref.offerRepository.Update(ctx, *offer)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if answered != nil {
+		logger.FromContext(ctx).InfoContext(ctx, "offer answered",
+			"entity_id", answered.EntityID,
+			"vehicle_id", answered.VehicleID,
+			"status", answered.Status.String(),
+			"price", answered.Price(),
+			"principal", session.Subject(ctx),
+		)
+	}
+
+	return answered, nil
+}
+
+// checkAnswerer lets staff answer pending offers and the buyer that made the
+// offer answer the counter of the dealer.
+func checkAnswerer(ctx context.Context, offer entity.Offer) error {
+	if offer.Status == valueobjects.OfferStatusCountered {
+		if session.Subject(ctx) != offer.BuyerSubject {
+			return fmt.Errorf("%w: only the buyer answers the counter of offer %q", domainerrors.ErrPermissionDenied, offer.EntityID)
+		}
+
+		return nil
+	}
+
+	if !session.HasAnyRole(ctx, managerRoles...) {
+		return fmt.Errorf("%w: only staff answer offer %q", domainerrors.ErrPermissionDenied, offer.EntityID)
+	}
+
+	return nil
+}
+
+// rejectOthers closes the open offers of the vehicle other than accepted,
+// which can no longer be sold to them.
+func (ref *offerService) rejectOthers(ctx context.Context, accepted entity.Offer) error {
+	offers, err := ref.offerRepository.SearchByVehicleID(ctx, accepted.VehicleID)
+	if err != nil {
+		return err
+	}
+
+	now := ref.timeGenerator()
+
+	for _, offer := range offers {
+		if offer.EntityID == accepted.EntityID || !offer.StatusAt(now).IsOpen() {
+			continue
+		}
+
+		offer.Status = valueobjects.OfferStatusRejected
+
+		if _, err = ref.offerRepository.Update(ctx, offer); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// checkNotSold refuses to negotiate a vehicle that already has a sale, paid
+// or waiting for its payment. It returns the rejected sale of the vehicle, if
+// any, which the sale of an accepted offer replaces.
+func (ref *offerService) checkNotSold(ctx context.Context, vehicleID string) (*entity.Sale, error) {
+	sale, err := ref.saleRepository.GetByEntityID(ctx, vehicleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if sale == nil || sale.Status == valueobjects.SaleStatusTypeRejected {
+		return sale, nil
+	}
+
+	return nil, fmt.Errorf("%w: vehicle %q already has a sale", domainerrors.ErrInvalidState, vehicleID)
+}
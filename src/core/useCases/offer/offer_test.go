@@ -0,0 +1,685 @@
+package offer
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+)
+
+const ttl = 72 * time.Hour
+
+var (
+	now      = time.Date(2024, 5, 10, 12, 0, 0, 0, time.UTC)
+	buyerCtx = session.WithPrincipal(context.TODO(), entity.Principal{Subject: "api_key:2", Roles: []valueobjects.Role{valueobjects.RoleBuyer}})
+	staffCtx = session.WithPrincipal(context.TODO(), entity.Principal{Subject: "api_key:3", DealerID: 3, Roles: []valueobjects.Role{valueobjects.RoleDealerStaff}})
+)
+
+func clock() time.Time {
+	return now
+}
+
+func publishedVehicle(entityID string) *entity.Vehicle {
+	publishedAt := now.Add(-time.Hour)
+
+	return &entity.Vehicle{
+		ID:          1,
+		EntityID:    entityID,
+		DealerID:    3,
+		Brand:       "Some Brand",
+		Price:       20000,
+		Status:      valueobjects.ListingStatusPublished,
+		PublishedAt: &publishedAt,
+	}
+}
+
+func pendingOffer(vehicleID string) *entity.Offer {
+	return &entity.Offer{
+		ID:                  1,
+		EntityID:            uuid.NewString(),
+		VehicleID:           vehicleID,
+		DealerID:            3,
+		BuyerSubject:        "api_key:2",
+		BuyerDocumentNumber: "12345678900",
+		Amount:              18000,
+		Status:              valueobjects.OfferStatusPending,
+		ExpiresAt:           now.Add(time.Hour),
+	}
+}
+
+func newTxManager(t *testing.T) *mocks.TxManager {
+	txManagerMocked := mocks.NewTxManager(t)
+
+	txManagerMocked.On("Begin", mock.Anything).
+		Return(func(ctx context.Context) context.Context { return ctx }, nil)
+
+	txManagerMocked.On("Commit", mock.Anything).
+		Return(nil).Maybe()
+
+	txManagerMocked.On("Rollback", mock.Anything).
+		Return(nil).Maybe()
+
+	return txManagerMocked
+}
+
+func TestCreate(t *testing.T) {
+	vehicleID := uuid.NewString()
+
+	t.Run("should not create offer without a positive amount", func(t *testing.T) {
+		service := NewOfferService(nil, nil, nil, nil, nil, ttl, clock)
+
+		actual, err := service.Create(buyerCtx, entity.Offer{VehicleID: vehicleID})
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+	})
+
+	t.Run("should not create offer when vehicle is not published", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		vehicle := publishedVehicle(vehicleID)
+		vehicle.Status = valueobjects.ListingStatusDraft
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(vehicle, nil)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, newTxManager(t), ttl, clock)
+
+		actual, err := service.Create(buyerCtx, entity.Offer{VehicleID: vehicleID, Amount: 18000})
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+		offerRepositoryMocked.AssertNumberOfCalls(t, "Create", 0)
+	})
+
+	t.Run("should not create offer at or above the price of the vehicle", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		service := NewOfferService(vehicleRepositoryMocked, nil, nil, nil, newTxManager(t), ttl, clock)
+
+		actual, err := service.Create(buyerCtx, entity.Offer{VehicleID: vehicleID, Amount: 20000})
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+	})
+
+	t.Run("should not create offer when vehicle already has a sale", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		saleRepositoryMocked.On("GetByEntityID", mock.Anything, vehicleID).
+			Return(&entity.Sale{EntityID: vehicleID, Status: valueobjects.SaleStatusTypePending}, nil)
+
+		service := NewOfferService(vehicleRepositoryMocked, nil, saleRepositoryMocked, nil, newTxManager(t), ttl, clock)
+
+		actual, err := service.Create(buyerCtx, entity.Offer{VehicleID: vehicleID, Amount: 18000})
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidState)
+	})
+
+	t.Run("should create pending offer of the caller", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		saleRepositoryMocked.On("GetByEntityID", mock.Anything, vehicleID).
+			Return(nil, nil)
+
+		offerRepositoryMocked.On("Create", mock.Anything, mock.MatchedBy(func(offer entity.Offer) bool {
+			return offer.EntityID != "" &&
+				offer.VehicleID == vehicleID &&
+				offer.DealerID == 3 &&
+				offer.BuyerSubject == "api_key:2" &&
+				offer.BuyerDocumentNumber == "12345678900" &&
+				offer.Status == valueobjects.OfferStatusPending &&
+				offer.ExpiresAt.Equal(now.Add(ttl))
+		})).
+			Return(func(_ context.Context, offer entity.Offer) *entity.Offer { return &offer }, nil)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, saleRepositoryMocked, nil, newTxManager(t), ttl, clock)
+
+		actual, err := service.Create(buyerCtx, entity.Offer{VehicleID: vehicleID, BuyerDocumentNumber: "12345678900", Amount: 18000})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 18000.0, actual.Amount)
+	})
+}
+
+func TestSearch(t *testing.T) {
+	vehicleID := uuid.NewString()
+
+	t.Run("should not search offers of a vehicle that does not exist", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		vehicleRepositoryMocked.On("GetByID", buyerCtx, vehicleID).
+			Return(nil, nil)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, nil, ttl, clock)
+
+		actual, err := service.Search(buyerCtx, vehicleID)
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+		offerRepositoryMocked.AssertNumberOfCalls(t, "SearchByVehicleID", 0)
+	})
+
+	t.Run("should search only own offers for buyers and every offer for staff", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		own := pendingOffer(vehicleID)
+		own.ExpiresAt = now
+
+		other := pendingOffer(vehicleID)
+		other.BuyerSubject = "api_key:9"
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("SearchByVehicleID", mock.Anything, vehicleID).
+			Return([]entity.Offer{*own, *other}, nil)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, nil, ttl, clock)
+
+		actual, err := service.Search(buyerCtx, vehicleID)
+
+		assert.Nil(t, err)
+		assert.Len(t, actual, 1)
+		assert.Equal(t, own.EntityID, actual[0].EntityID)
+		assert.Equal(t, valueobjects.OfferStatusExpired, actual[0].Status)
+
+		actual, err = service.Search(staffCtx, vehicleID)
+
+		assert.Nil(t, err)
+		assert.Len(t, actual, 2)
+	})
+}
+
+func TestAccept(t *testing.T) {
+	vehicleID := uuid.NewString()
+	paymentID := uuid.NewString()
+
+	t.Run("should not accept offer of another vehicle", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		offer := pendingOffer(uuid.NewString())
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, newTxManager(t), ttl, clock)
+
+		actual, err := service.Accept(staffCtx, vehicleID, offer.EntityID)
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+		offerRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+	})
+
+	t.Run("should not accept expired offer", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		offer := pendingOffer(vehicleID)
+		offer.ExpiresAt = now.Add(-time.Minute)
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		txManagerMocked := newTxManager(t)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, txManagerMocked, ttl, clock)
+
+		actual, err := service.Accept(staffCtx, vehicleID, offer.EntityID)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidState)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 1)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
+	})
+
+	t.Run("should not let buyer accept own pending offer", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		offer := pendingOffer(vehicleID)
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		txManagerMocked := newTxManager(t)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, txManagerMocked, ttl, clock)
+
+		actual, err := service.Accept(buyerCtx, vehicleID, offer.EntityID)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrPermissionDenied)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 1)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
+	})
+
+	t.Run("should cancel acceptance when failed to generate payment", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		offer := pendingOffer(vehicleID)
+		unexpectedError := errors.New("unexpected error")
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", mock.Anything, vehicleID).
+			Return(nil, nil)
+
+		saleRepositoryMocked.On("Create", mock.Anything, mock.MatchedBy(func(sale entity.Sale) bool {
+			return sale.PaymentID == "" && sale.Price == 18000
+		})).
+			Return(&entity.Sale{ID: 7, EntityID: vehicleID}, nil)
+
+		offerRepositoryMocked.On("Update", mock.Anything, mock.MatchedBy(func(updated entity.Offer) bool {
+			return updated.Status == valueobjects.OfferStatusAccepted && updated.PaymentID == ""
+		})).
+			Return(func(_ context.Context, offer entity.Offer) *entity.Offer { return &offer }, nil).Once()
+
+		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", mock.Anything, entity.Payment{Amount: 18000.0, Status: "APPROVED"}).
+			Return("", unexpectedError)
+
+		saleRepositoryMocked.On("DeleteReservation", mock.Anything, 7).
+			Return(&entity.Sale{ID: 7, EntityID: vehicleID}, nil)
+
+		offerRepositoryMocked.On("Update", mock.Anything, mock.MatchedBy(func(restored entity.Offer) bool {
+			return restored.Status == valueobjects.OfferStatusPending && restored.ExpiresAt.Equal(offer.ExpiresAt)
+		})).
+			Return(func(_ context.Context, offer entity.Offer) *entity.Offer { return &offer }, nil).Once()
+
+		txManagerMocked := newTxManager(t)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, saleRepositoryMocked, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, ttl, clock)
+
+		actual, err := service.Accept(staffCtx, vehicleID, offer.EntityID)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+		saleRepositoryMocked.AssertNumberOfCalls(t, "DeleteReservation", 1)
+		saleRepositoryMocked.AssertNumberOfCalls(t, "AttachPayment", 0)
+		offerRepositoryMocked.AssertNumberOfCalls(t, "SearchByVehicleID", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 2)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 0)
+	})
+
+	t.Run("should sell vehicle at the offer and reject the other open offers", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		offer := pendingOffer(vehicleID)
+		open := pendingOffer(vehicleID)
+		expired := pendingOffer(vehicleID)
+		expired.ExpiresAt = now.Add(-time.Minute)
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", mock.Anything, vehicleID).
+			Return(nil, nil)
+
+		saleRepositoryMocked.On("Create", mock.Anything, entity.Sale{
+			EntityID:            vehicleID,
+			DealerID:            3,
+			BuyerDocumentNumber: offer.BuyerDocumentNumber,
+			ListPrice:           20000,
+			Discount:            2000,
+			Price:               18000,
+			Status:              valueobjects.SaleStatusTypePending,
+		}).
+			Return(&entity.Sale{ID: 7}, nil)
+
+		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", mock.Anything, entity.Payment{Amount: 18000.0, Status: "APPROVED"}).
+			Return(paymentID, nil)
+
+		saleRepositoryMocked.On("AttachPayment", mock.Anything, 7, paymentID).
+			Return(&entity.Sale{ID: 7, PaymentID: paymentID}, nil)
+
+		offerRepositoryMocked.On("SearchByVehicleID", mock.Anything, vehicleID).
+			Return([]entity.Offer{*offer, *open, *expired}, nil)
+
+		offerRepositoryMocked.On("Update", mock.Anything, mock.MatchedBy(func(updated entity.Offer) bool {
+			return updated.EntityID == open.EntityID && updated.Status == valueobjects.OfferStatusRejected
+		})).
+			Return(open, nil).Once()
+
+		offerRepositoryMocked.On("Update", mock.Anything, mock.MatchedBy(func(updated entity.Offer) bool {
+			return updated.EntityID == offer.EntityID
+		})).
+			Return(func(_ context.Context, offer entity.Offer) *entity.Offer { return &offer }, nil).Twice()
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, saleRepositoryMocked, vehiclePlatformPaymentsAdapterMocked, newTxManager(t), ttl, clock)
+
+		actual, err := service.Accept(staffCtx, vehicleID, offer.EntityID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, valueobjects.OfferStatusAccepted, actual.Status)
+		assert.Equal(t, paymentID, actual.PaymentID)
+		offerRepositoryMocked.AssertNumberOfCalls(t, "Update", 3)
+	})
+
+	t.Run("should sell vehicle at the offer replacing its rejected sale", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		offer := pendingOffer(vehicleID)
+		rejected := &entity.Sale{ID: 5, EntityID: vehicleID, Status: valueobjects.SaleStatusTypeRejected}
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", mock.Anything, vehicleID).
+			Return(rejected, nil)
+
+		saleRepositoryMocked.On("DeleteRejected", mock.Anything, rejected.ID).
+			Return(rejected, nil)
+
+		saleRepositoryMocked.On("Create", mock.Anything, mock.MatchedBy(func(sale entity.Sale) bool {
+			return sale.PaymentID == "" && sale.Status == valueobjects.SaleStatusTypePending
+		})).
+			Return(&entity.Sale{ID: 7}, nil)
+
+		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", mock.Anything, entity.Payment{Amount: 18000.0, Status: "APPROVED"}).
+			Return(paymentID, nil)
+
+		saleRepositoryMocked.On("AttachPayment", mock.Anything, 7, paymentID).
+			Return(&entity.Sale{ID: 7, PaymentID: paymentID}, nil)
+
+		offerRepositoryMocked.On("SearchByVehicleID", mock.Anything, vehicleID).
+			Return([]entity.Offer{*offer}, nil)
+
+		offerRepositoryMocked.On("Update", mock.Anything, mock.Anything).
+			Return(func(_ context.Context, offer entity.Offer) *entity.Offer { return &offer }, nil).Twice()
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, saleRepositoryMocked, vehiclePlatformPaymentsAdapterMocked, newTxManager(t), ttl, clock)
+
+		actual, err := service.Accept(staffCtx, vehicleID, offer.EntityID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, valueobjects.OfferStatusAccepted, actual.Status)
+		saleRepositoryMocked.AssertNumberOfCalls(t, "DeleteRejected", 1)
+	})
+
+	t.Run("should not accept counter above the lowered price of the vehicle", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		counterAmount := 19000.0
+
+		offer := pendingOffer(vehicleID)
+		offer.Status = valueobjects.OfferStatusCountered
+		offer.CounterAmount = &counterAmount
+
+		vehicle := publishedVehicle(vehicleID)
+		vehicle.Price = 18500
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(vehicle, nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		txManagerMocked := newTxManager(t)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, txManagerMocked, ttl, clock)
+
+		actual, err := service.Accept(buyerCtx, vehicleID, offer.EntityID)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidState)
+		offerRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 1)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
+	})
+
+	t.Run("should sell vehicle at the counter when buyer accepts it", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		counterAmount := 19000.0
+
+		offer := pendingOffer(vehicleID)
+		offer.Status = valueobjects.OfferStatusCountered
+		offer.CounterAmount = &counterAmount
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", mock.Anything, vehicleID).
+			Return(nil, nil)
+
+		saleRepositoryMocked.On("Create", mock.Anything, mock.MatchedBy(func(sale entity.Sale) bool {
+			return sale.Price == counterAmount
+		})).
+			Return(&entity.Sale{ID: 7}, nil)
+
+		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", mock.Anything, entity.Payment{Amount: counterAmount, Status: "APPROVED"}).
+			Return(paymentID, nil)
+
+		saleRepositoryMocked.On("AttachPayment", mock.Anything, 7, paymentID).
+			Return(&entity.Sale{ID: 7, PaymentID: paymentID}, nil)
+
+		offerRepositoryMocked.On("SearchByVehicleID", mock.Anything, vehicleID).
+			Return([]entity.Offer{*offer}, nil)
+
+		offerRepositoryMocked.On("Update", mock.Anything, mock.AnythingOfType("entity.Offer")).
+			Return(func(_ context.Context, offer entity.Offer) *entity.Offer { return &offer }, nil)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, saleRepositoryMocked, vehiclePlatformPaymentsAdapterMocked, newTxManager(t), ttl, clock)
+
+		actual, err := service.Accept(buyerCtx, vehicleID, offer.EntityID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, valueobjects.OfferStatusAccepted, actual.Status)
+		assert.Equal(t, counterAmount, actual.Price())
+	})
+}
+
+func TestReject(t *testing.T) {
+	vehicleID := uuid.NewString()
+
+	t.Run("should not let staff reject the counter of an offer", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		offer := pendingOffer(vehicleID)
+		offer.Status = valueobjects.OfferStatusCountered
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, newTxManager(t), ttl, clock)
+
+		actual, err := service.Reject(staffCtx, vehicleID, offer.EntityID)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrPermissionDenied)
+	})
+
+	t.Run("should reject pending offer", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		offer := pendingOffer(vehicleID)
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		offerRepositoryMocked.On("Update", mock.Anything, mock.AnythingOfType("entity.Offer")).
+			Return(func(_ context.Context, offer entity.Offer) *entity.Offer { return &offer }, nil)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, newTxManager(t), ttl, clock)
+
+		actual, err := service.Reject(staffCtx, vehicleID, offer.EntityID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, valueobjects.OfferStatusRejected, actual.Status)
+	})
+}
+
+func TestCounter(t *testing.T) {
+	vehicleID := uuid.NewString()
+
+	t.Run("should not counter at or below the offer", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		offer := pendingOffer(vehicleID)
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		txManagerMocked := newTxManager(t)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, txManagerMocked, ttl, clock)
+
+		actual, err := service.Counter(staffCtx, vehicleID, offer.EntityID, 18000)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		offerRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 1)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
+	})
+
+	t.Run("should not counter above the price of the vehicle", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		offer := pendingOffer(vehicleID)
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		txManagerMocked := newTxManager(t)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, txManagerMocked, ttl, clock)
+
+		actual, err := service.Counter(staffCtx, vehicleID, offer.EntityID, 20000.01)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		offerRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 1)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
+	})
+
+	t.Run("should not counter an offer twice", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		offer := pendingOffer(vehicleID)
+		offer.Status = valueobjects.OfferStatusCountered
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		txManagerMocked := newTxManager(t)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, txManagerMocked, ttl, clock)
+
+		actual, err := service.Counter(staffCtx, vehicleID, offer.EntityID, 19000)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidState)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 1)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
+	})
+
+	t.Run("should counter pending offer and renew its expiration", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		offerRepositoryMocked := mocks.NewOfferRepository(t)
+
+		offer := pendingOffer(vehicleID)
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, vehicleID).
+			Return(publishedVehicle(vehicleID), nil)
+
+		offerRepositoryMocked.On("GetByID", mock.Anything, offer.EntityID).
+			Return(offer, nil)
+
+		offerRepositoryMocked.On("Update", mock.Anything, mock.AnythingOfType("entity.Offer")).
+			Return(func(_ context.Context, offer entity.Offer) *entity.Offer { return &offer }, nil)
+
+		service := NewOfferService(vehicleRepositoryMocked, offerRepositoryMocked, nil, nil, newTxManager(t), ttl, clock)
+
+		actual, err := service.Counter(staffCtx, vehicleID, offer.EntityID, 19000)
+
+		assert.Nil(t, err)
+		assert.Equal(t, valueobjects.OfferStatusCountered, actual.Status)
+		assert.Equal(t, 19000.0, *actual.CounterAmount)
+		assert.Equal(t, now.Add(ttl), actual.ExpiresAt)
+	})
+}
@@ -2,21 +2,33 @@ package sale
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"time"
 
 	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
 )
 
 type saleService struct {
-	saleRepository interfaces.SaleRepository
-	timeGenerator  func() time.Time
+	saleRepository   interfaces.SaleRepository
+	couponRepository interfaces.CouponRepository
+	vehicleService   interfaces.VehicleService
+	txManager        interfaces.TxManager
+	timeGenerator    func() time.Time
 }
 
-func NewSaleService(saleRepository interfaces.SaleRepository, timeGenerator func() time.Time) interfaces.SaleService {
+func NewSaleService(saleRepository interfaces.SaleRepository, couponRepository interfaces.CouponRepository, vehicleService interfaces.VehicleService, txManager interfaces.TxManager, timeGenerator func() time.Time) interfaces.SaleService {
 	return &saleService{
-		saleRepository: saleRepository,
-		timeGenerator:  timeGenerator,
+		saleRepository:   saleRepository,
+		couponRepository: couponRepository,
+		vehicleService:   vehicleService,
+		txManager:        txManager,
+		timeGenerator:    timeGenerator,
 	}
 }
 
@@ -28,7 +40,152 @@ func (ref *saleService) Search(ctx context.Context) ([]entity.Sale, error) {
 	return ref.saleRepository.Search(ctx)
 }
 
-func (ref *saleService) UpdateStatusByPaymentID(ctx context.Context, paymentID string, status string) (*entity.Sale, error) {
+// UpdateStatusByPaymentID adds the trade-in of an approved sale to the
+// inventory of the dealer in the same transaction as the status, so a failure
+// leaves the sale to be approved again by the next delivery of the webhook,
+// and a webhook delivered twice does not add it twice. A trade-in whose VIN
+// was registered since the buy does not hold the approval back: the sale is
+// approved without it, for the staff to sort out. A sale rejected by the
+// payment gives the use of its coupon back, also in the same transaction.
+func (ref *saleService) UpdateStatusByPaymentID(ctx context.Context, paymentID string, status string) (*entity.Sale, bool, error) {
+	log := logger.FromContext(ctx).With("payment_id", paymentID, "status", status, "principal", session.Subject(ctx))
+
 	soldDate := ref.timeGenerator()
-	return ref.saleRepository.UpdateStatusByPaymentID(ctx, paymentID, status, soldDate)
+
+	var updated *entity.Sale
+	var finished bool
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		sale, previous, err := ref.saleRepository.UpdateStatusByPaymentID(ctx, paymentID, status, soldDate)
+		if err != nil || sale == nil {
+			return err
+		}
+
+		finished = previous == valueobjects.SaleStatusTypePending && sale.Status != valueobjects.SaleStatusTypePending
+
+		if finished && sale.Status == valueobjects.SaleStatusTypeRejected && sale.CouponCode != "" {
+			if err := ref.releaseCoupon(ctx, *sale); err != nil {
+				return err
+			}
+		}
+
+		if sale.Status == valueobjects.SaleStatusTypeApproved && sale.TradeIn != nil && sale.TradeIn.VehicleID == "" {
+			if sale, err = ref.addTradeIn(ctx, *sale); err != nil {
+				return err
+			}
+		}
+
+		updated = sale
+		return nil
+	})
+	if err != nil {
+		log.ErrorContext(ctx, "failed to update sale status", "error", err)
+		return nil, false, err
+	}
+
+	if updated == nil {
+		log.WarnContext(ctx, "no sale found for payment")
+		return nil, false, nil
+	}
+
+	log.InfoContext(ctx, "sale status updated", "entity_id", updated.EntityID, "finished", finished)
+
+	return updated, finished, nil
+}
+
+// addTradeIn creates the trade-in of the sale as a draft vehicle of its
+// dealer and links it to the sale. A trade-in that can not be created because
+// its VIN is already registered is left out of the inventory.
+func (ref *saleService) addTradeIn(ctx context.Context, sale entity.Sale) (*entity.Sale, error) {
+	vehicle, err := ref.vehicleService.Create(ctx, sale.TradeIn.Vehicle(sale.DealerID))
+	if errors.Is(err, domainerrors.ErrAlreadyExists) {
+		logger.FromContext(ctx).WarnContext(ctx, "trade-in not added to inventory",
+			"entity_id", sale.EntityID,
+			"vin", sale.TradeIn.VIN.String(),
+			"error", err,
+		)
+		return &sale, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	linked, err := ref.saleRepository.LinkTradeInVehicle(ctx, sale.ID, vehicle.EntityID)
+	if err != nil {
+		return nil, err
+	}
+
+	if linked == nil {
+		return nil, fmt.Errorf("%w: trade-in of sale %d was already added", domainerrors.ErrInvalidState, sale.ID)
+	}
+
+	logger.FromContext(ctx).InfoContext(ctx, "trade-in added to inventory",
+		"entity_id", sale.EntityID,
+		"trade_in_entity_id", vehicle.EntityID,
+		"appraised_value", sale.TradeIn.AppraisedValue,
+	)
+
+	return linked, nil
+}
+
+// releaseCoupon gives back the use of the coupon redeemed by the sale. A
+// coupon removed since the buy has no use to give back.
+func (ref *saleService) releaseCoupon(ctx context.Context, sale entity.Sale) error {
+	code, err := valueobjects.ParseCouponCode(sale.CouponCode)
+	if err != nil {
+		return fmt.Errorf("%w: %s", domainerrors.ErrInvalidState, err)
+	}
+
+	coupon, err := ref.couponRepository.GetByCode(ctx, sale.DealerID, code)
+	if err != nil || coupon == nil {
+		return err
+	}
+
+	if _, err := ref.couponRepository.Release(ctx, coupon.ID); err != nil {
+		return err
+	}
+
+	logger.FromContext(ctx).InfoContext(ctx, "coupon use given back",
+		"entity_id", sale.EntityID,
+		"coupon_id", coupon.ID,
+	)
+
+	return nil
+}
+
+// GetInstallments returns the sale along with its amortization table, nil when
+// the sale does not exist.
+func (ref *saleService) GetInstallments(ctx context.Context, id int) (*entity.Sale, []entity.Installment, error) {
+	sale, err := ref.saleRepository.GetByID(ctx, id)
+	if err != nil || sale == nil {
+		return nil, nil, err
+	}
+
+	installments, err := ref.saleRepository.SearchInstallments(ctx, id)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return sale, installments, nil
+}
+
+// PayInstallment records an installment reported paid by the payments
+// platform. A webhook delivered twice keeps the time of the first one.
+func (ref *saleService) PayInstallment(ctx context.Context, paymentID string, number int) (*entity.Installment, error) {
+	log := logger.FromContext(ctx).With("payment_id", paymentID, "installment", number, "principal", session.Subject(ctx))
+
+	installment, err := ref.saleRepository.PayInstallment(ctx, paymentID, number, ref.timeGenerator())
+	if err != nil {
+		log.ErrorContext(ctx, "failed to pay installment", "error", err)
+		return nil, err
+	}
+
+	if installment == nil {
+		log.WarnContext(ctx, "no installment found for payment")
+		return nil, nil
+	}
+
+	log.InfoContext(ctx, "installment paid", "sale_id", installment.SaleID, "paid_at", installment.PaidAt)
+
+	return installment, nil
 }
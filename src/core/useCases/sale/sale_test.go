@@ -12,6 +12,7 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
 	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 )
@@ -36,7 +37,7 @@ func TestCreate(t *testing.T) {
 		saleRepositoryMocked.On("Create", ctx, sale).
 			Return(nil, unexpectedError)
 
-		service := NewSaleService(saleRepositoryMocked, time.Now)
+		service := NewSaleService(saleRepositoryMocked, nil, nil, nil, time.Now)
 
 		actual, err := service.Create(ctx, sale)
 
@@ -57,7 +58,7 @@ func TestCreate(t *testing.T) {
 		saleRepositoryMocked.On("Create", ctx, sale).
 			Return(&sale, nil)
 
-		service := NewSaleService(saleRepositoryMocked, time.Now)
+		service := NewSaleService(saleRepositoryMocked, nil, nil, nil, time.Now)
 
 		actual, err := service.Create(ctx, sale)
 
@@ -79,7 +80,7 @@ func TestSearch(t *testing.T) {
 		saleRepositoryMocked.On("Search", ctx).
 			Return(nil, unexpectedError)
 
-		service := NewSaleService(saleRepositoryMocked, time.Now)
+		service := NewSaleService(saleRepositoryMocked, nil, nil, nil, time.Now)
 
 		actual, err := service.Search(ctx)
 
@@ -102,7 +103,7 @@ func TestSearch(t *testing.T) {
 		saleRepositoryMocked.On("Search", ctx).
 			Return(sales, nil)
 
-		service := NewSaleService(saleRepositoryMocked, time.Now)
+		service := NewSaleService(saleRepositoryMocked, nil, nil, nil, time.Now)
 
 		actual, err := service.Search(ctx)
 
@@ -111,6 +112,21 @@ func TestSearch(t *testing.T) {
 	})
 }
 
+func newTxManager(t *testing.T) *mocks.TxManager {
+	txManagerMocked := mocks.NewTxManager(t)
+
+	txManagerMocked.On("Begin", mock.Anything).
+		Return(func(ctx context.Context) context.Context { return ctx }, nil)
+
+	txManagerMocked.On("Commit", mock.Anything).
+		Return(nil).Maybe()
+
+	txManagerMocked.On("Rollback", mock.Anything).
+		Return(nil).Maybe()
+
+	return txManagerMocked
+}
+
 func TestUpdateStatusByPaymentID(t *testing.T) {
 	ctx := context.TODO()
 	vehicleID := uuid.NewString()
@@ -118,10 +134,12 @@ func TestUpdateStatusByPaymentID(t *testing.T) {
 	buyerDocumentNumber := uuid.NewString()
 	status := valueobjects.SaleStatusTypeApproved
 	soldAt := time.Now()
+	unexpectedError := errors.New("unexpected error")
 
 	sale := entity.Sale{
 		ID:                  1,
 		EntityID:            vehicleID,
+		DealerID:            3,
 		PaymentID:           paymentID,
 		BuyerDocumentNumber: buyerDocumentNumber,
 		Price:               50000,
@@ -129,17 +147,316 @@ func TestUpdateStatusByPaymentID(t *testing.T) {
 		SoldAt:              &soldAt,
 	}
 
-	saleRepositoryMocked := mocks.NewSaleRepository(t)
+	t.Run("should return nil when no sale has the payment", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, paymentID, status.String(), mock.AnythingOfType("time.Time")).
+			Return(nil, valueobjects.SaleStatusType(""), nil)
+
+		service := NewSaleService(saleRepositoryMocked, nil, nil, newTxManager(t), time.Now)
+
+		actual, _, err := service.UpdateStatusByPaymentID(ctx, paymentID, status.String())
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should update sale status successfully", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, paymentID, status.String(), mock.AnythingOfType("time.Time")).
+			Return(&sale, valueobjects.SaleStatusTypePending, nil)
+
+		service := NewSaleService(saleRepositoryMocked, nil, nil, newTxManager(t), time.Now)
+
+		expected := sale
+
+		actual, finished, err := service.UpdateStatusByPaymentID(ctx, paymentID, status.String())
+
+		assert.Equal(t, &expected, actual)
+		assert.True(t, finished)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should not finish sale again when the webhook is delivered twice", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, paymentID, status.String(), mock.AnythingOfType("time.Time")).
+			Return(&sale, valueobjects.SaleStatusTypeApproved, nil)
+
+		service := NewSaleService(saleRepositoryMocked, nil, nil, newTxManager(t), time.Now)
+
+		actual, finished, err := service.UpdateStatusByPaymentID(ctx, paymentID, status.String())
+
+		assert.Nil(t, err)
+		assert.Equal(t, &sale, actual)
+		assert.False(t, finished)
+	})
+
+	withCoupon := sale
+	withCoupon.CouponCode = "BLACKFRIDAY"
+	withCoupon.Status = valueobjects.SaleStatusTypeRejected
+	withCoupon.SoldAt = nil
+
+	t.Run("should give coupon use back when sale is rejected", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		couponRepositoryMocked := mocks.NewCouponRepository(t)
+		txManagerMocked := newTxManager(t)
+
+		saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, paymentID, withCoupon.Status.String(), mock.AnythingOfType("time.Time")).
+			Return(&withCoupon, valueobjects.SaleStatusTypePending, nil)
+
+		couponRepositoryMocked.On("GetByCode", ctx, 3, valueobjects.CouponCode("BLACKFRIDAY")).
+			Return(&entity.Coupon{ID: 7, DealerID: 3, Code: "BLACKFRIDAY", Uses: 1}, nil)
+
+		couponRepositoryMocked.On("Release", ctx, 7).
+			Return(&entity.Coupon{ID: 7, DealerID: 3, Code: "BLACKFRIDAY"}, nil)
+
+		service := NewSaleService(saleRepositoryMocked, couponRepositoryMocked, nil, txManagerMocked, time.Now)
+
+		actual, finished, err := service.UpdateStatusByPaymentID(ctx, paymentID, withCoupon.Status.String())
+
+		assert.Nil(t, err)
+		assert.Equal(t, &withCoupon, actual)
+		assert.True(t, finished)
+		couponRepositoryMocked.AssertNumberOfCalls(t, "Release", 1)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 1)
+	})
+
+	t.Run("should not give coupon use back again when the rejection is delivered twice", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		couponRepositoryMocked := mocks.NewCouponRepository(t)
+
+		saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, paymentID, withCoupon.Status.String(), mock.AnythingOfType("time.Time")).
+			Return(&withCoupon, valueobjects.SaleStatusTypeRejected, nil)
+
+		service := NewSaleService(saleRepositoryMocked, couponRepositoryMocked, nil, newTxManager(t), time.Now)
+
+		actual, finished, err := service.UpdateStatusByPaymentID(ctx, paymentID, withCoupon.Status.String())
+
+		assert.Nil(t, err)
+		assert.Equal(t, &withCoupon, actual)
+		assert.False(t, finished)
+		couponRepositoryMocked.AssertNumberOfCalls(t, "Release", 0)
+	})
+
+	t.Run("should not reject sale when failed to give coupon use back", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		couponRepositoryMocked := mocks.NewCouponRepository(t)
+		txManagerMocked := newTxManager(t)
+
+		saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, paymentID, withCoupon.Status.String(), mock.AnythingOfType("time.Time")).
+			Return(&withCoupon, valueobjects.SaleStatusTypePending, nil)
+
+		couponRepositoryMocked.On("GetByCode", ctx, 3, valueobjects.CouponCode("BLACKFRIDAY")).
+			Return(&entity.Coupon{ID: 7, DealerID: 3, Code: "BLACKFRIDAY", Uses: 1}, nil)
+
+		couponRepositoryMocked.On("Release", ctx, 7).
+			Return(nil, unexpectedError)
+
+		service := NewSaleService(saleRepositoryMocked, couponRepositoryMocked, nil, txManagerMocked, time.Now)
+
+		actual, _, err := service.UpdateStatusByPaymentID(ctx, paymentID, withCoupon.Status.String())
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 1)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
+	})
+
+	tradeIn := entity.TradeIn{Brand: "Ford", Model: "Ka", Year: 2015, Color: "Red", AppraisedValue: 20000, AppraisedBy: "api_key:3"}
+
+	withTradeIn := sale
+	withTradeIn.TradeIn = &tradeIn
+
+	t.Run("should add trade-in of approved sale to the inventory", func(t *testing.T) {
+		tradeInVehicleID := uuid.NewString()
+
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehicleServiceMocked := mocks.NewVehicleService(t)
+
+		saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, paymentID, status.String(), mock.AnythingOfType("time.Time")).
+			Return(&withTradeIn, valueobjects.SaleStatusTypePending, nil)
+
+		vehicleServiceMocked.On("Create", ctx, tradeIn.Vehicle(3)).
+			Return(&entity.Vehicle{EntityID: tradeInVehicleID, DealerID: 3}, nil)
+
+		linked := withTradeIn
+		linkedTradeIn := tradeIn
+		linkedTradeIn.VehicleID = tradeInVehicleID
+		linked.TradeIn = &linkedTradeIn
+
+		saleRepositoryMocked.On("LinkTradeInVehicle", ctx, 1, tradeInVehicleID).
+			Return(&linked, nil)
+
+		service := NewSaleService(saleRepositoryMocked, nil, vehicleServiceMocked, newTxManager(t), time.Now)
+
+		actual, _, err := service.UpdateStatusByPaymentID(ctx, paymentID, status.String())
+
+		assert.Nil(t, err)
+		assert.Equal(t, tradeInVehicleID, actual.TradeIn.VehicleID)
+	})
+
+	t.Run("should not approve sale when failed to add trade-in", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehicleServiceMocked := mocks.NewVehicleService(t)
+		txManagerMocked := newTxManager(t)
+
+		saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, paymentID, status.String(), mock.AnythingOfType("time.Time")).
+			Return(&withTradeIn, valueobjects.SaleStatusTypePending, nil)
+
+		vehicleServiceMocked.On("Create", ctx, tradeIn.Vehicle(3)).
+			Return(nil, unexpectedError)
+
+		service := NewSaleService(saleRepositoryMocked, nil, vehicleServiceMocked, txManagerMocked, time.Now)
+
+		actual, _, err := service.UpdateStatusByPaymentID(ctx, paymentID, status.String())
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
+	})
+
+	t.Run("should approve sale without trade-in whose vin was registered since the buy", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehicleServiceMocked := mocks.NewVehicleService(t)
+		txManagerMocked := newTxManager(t)
+
+		saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, paymentID, status.String(), mock.AnythingOfType("time.Time")).
+			Return(&withTradeIn, valueobjects.SaleStatusTypePending, nil)
+
+		vehicleServiceMocked.On("Create", ctx, tradeIn.Vehicle(3)).
+			Return(nil, domainerrors.ErrAlreadyExists)
+
+		service := NewSaleService(saleRepositoryMocked, nil, vehicleServiceMocked, txManagerMocked, time.Now)
+
+		actual, _, err := service.UpdateStatusByPaymentID(ctx, paymentID, status.String())
+
+		assert.Nil(t, err)
+		assert.Equal(t, &withTradeIn, actual)
+		assert.Empty(t, actual.TradeIn.VehicleID)
+		saleRepositoryMocked.AssertNumberOfCalls(t, "LinkTradeInVehicle", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 1)
+	})
+
+	t.Run("should not add trade-in of rejected sale or already added", func(t *testing.T) {
+		rejected := withTradeIn
+		rejected.Status = valueobjects.SaleStatusTypeRejected
+
+		added := withTradeIn
+		addedTradeIn := tradeIn
+		addedTradeIn.VehicleID = uuid.NewString()
+		added.TradeIn = &addedTradeIn
+
+		for _, updated := range []entity.Sale{rejected, added} {
+			saleRepositoryMocked := mocks.NewSaleRepository(t)
+			vehicleServiceMocked := mocks.NewVehicleService(t)
+
+			saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, paymentID, updated.Status.String(), mock.AnythingOfType("time.Time")).
+				Return(&updated, valueobjects.SaleStatusTypePending, nil)
+
+			service := NewSaleService(saleRepositoryMocked, nil, vehicleServiceMocked, newTxManager(t), time.Now)
+
+			actual, _, err := service.UpdateStatusByPaymentID(ctx, paymentID, updated.Status.String())
+
+			assert.Nil(t, err)
+			assert.Equal(t, &updated, actual)
+			vehicleServiceMocked.AssertNumberOfCalls(t, "Create", 0)
+		}
+	})
+}
+
+func TestGetInstallments(t *testing.T) {
+	ctx := context.TODO()
+	unexpectedError := errors.New("unexpected error")
+
+	t.Run("should return nil when sale does not exist", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		saleRepositoryMocked.On("GetByID", ctx, 1).
+			Return(nil, nil)
+
+		service := NewSaleService(saleRepositoryMocked, nil, nil, nil, time.Now)
+
+		sale, installments, err := service.GetInstallments(ctx, 1)
+
+		assert.Nil(t, sale)
+		assert.Nil(t, installments)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should not get installments when failed to search them", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		saleRepositoryMocked.On("GetByID", ctx, 1).
+			Return(&entity.Sale{ID: 1}, nil)
+
+		saleRepositoryMocked.On("SearchInstallments", ctx, 1).
+			Return(nil, unexpectedError)
+
+		service := NewSaleService(saleRepositoryMocked, nil, nil, nil, time.Now)
+
+		sale, installments, err := service.GetInstallments(ctx, 1)
+
+		assert.Nil(t, sale)
+		assert.Nil(t, installments)
+		assert.Equal(t, unexpectedError, err)
+	})
+
+	t.Run("should get installments of sale", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		expected := []entity.Installment{{SaleID: 1, Number: 1, Amount: 5330.93}}
+
+		saleRepositoryMocked.On("GetByID", ctx, 1).
+			Return(&entity.Sale{ID: 1}, nil)
 
-	saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, paymentID, status.String(), mock.AnythingOfType("time.Time")).
-		Return(&sale, nil)
+		saleRepositoryMocked.On("SearchInstallments", ctx, 1).
+			Return(expected, nil)
 
-	service := NewSaleService(saleRepositoryMocked, time.Now)
+		service := NewSaleService(saleRepositoryMocked, nil, nil, nil, time.Now)
 
-	expected := sale
+		sale, installments, err := service.GetInstallments(ctx, 1)
 
-	actual, err := service.UpdateStatusByPaymentID(ctx, paymentID, status.String())
+		assert.Equal(t, 1, sale.ID)
+		assert.Equal(t, expected, installments)
+		assert.Nil(t, err)
+	})
+}
 
-	assert.Equal(t, &expected, actual)
-	assert.Nil(t, err)
+func TestPayInstallment(t *testing.T) {
+	ctx := context.TODO()
+	paymentID := uuid.NewString()
+	now := time.Now()
+	timeGenerator := func() time.Time { return now }
+
+	t.Run("should return nil when installment does not exist", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		saleRepositoryMocked.On("PayInstallment", ctx, paymentID, 13, now).
+			Return(nil, nil)
+
+		service := NewSaleService(saleRepositoryMocked, nil, nil, nil, timeGenerator)
+
+		actual, err := service.PayInstallment(ctx, paymentID, 13)
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should pay installment", func(t *testing.T) {
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		paid := entity.Installment{SaleID: 1, Number: 2, PaidAt: &now}
+
+		saleRepositoryMocked.On("PayInstallment", ctx, paymentID, 2, now).
+			Return(&paid, nil)
+
+		service := NewSaleService(saleRepositoryMocked, nil, nil, nil, timeGenerator)
+
+		actual, err := service.PayInstallment(ctx, paymentID, 2)
+
+		assert.Equal(t, &paid, actual)
+		assert.Nil(t, err)
+	})
 }
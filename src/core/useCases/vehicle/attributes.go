@@ -0,0 +1,103 @@
+package vehicle
+
+import (
+	"fmt"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+const (
+	maxDoors              = 6
+	maxEngineDisplacement = 10000
+)
+
+// normalizeVehicle validates the optional attributes of vehicle, bringing the
+// enums, plate and VIN to their canonical form so they can be filtered and
+// compared. Attributes left empty are not validated.
+func normalizeVehicle(vehicle entity.Vehicle) (entity.Vehicle, error) {
+	var err error
+
+	if vehicle.Mileage < 0 {
+		return entity.Vehicle{}, fmt.Errorf("%w: mileage can not be negative", domainerrors.ErrInvalidArgument)
+	}
+
+	if vehicle.Doors < 0 || vehicle.Doors > maxDoors {
+		return entity.Vehicle{}, fmt.Errorf("%w: doors must be up to %d", domainerrors.ErrInvalidArgument, maxDoors)
+	}
+
+	if vehicle.EngineDisplacement < 0 || vehicle.EngineDisplacement > maxEngineDisplacement {
+		return entity.Vehicle{}, fmt.Errorf("%w: engine displacement must be up to %d cc", domainerrors.ErrInvalidArgument, maxEngineDisplacement)
+	}
+
+	if vehicle.FuelType, err = parseOptional(vehicle.FuelType, valueobjects.ParseFuelType); err != nil {
+		return entity.Vehicle{}, err
+	}
+
+	if vehicle.Transmission, err = parseOptional(vehicle.Transmission, valueobjects.ParseTransmission); err != nil {
+		return entity.Vehicle{}, err
+	}
+
+	if vehicle.BodyType, err = parseOptional(vehicle.BodyType, valueobjects.ParseBodyType); err != nil {
+		return entity.Vehicle{}, err
+	}
+
+	if vehicle.Condition, err = parseOptional(vehicle.Condition, valueobjects.ParseVehicleCondition); err != nil {
+		return entity.Vehicle{}, err
+	}
+
+	if vehicle.LicensePlate, err = parseOptional(vehicle.LicensePlate, valueobjects.ParseLicensePlate); err != nil {
+		return entity.Vehicle{}, err
+	}
+
+	if vehicle.VIN, err = parseOptional(vehicle.VIN, valueobjects.ParseVIN); err != nil {
+		return entity.Vehicle{}, err
+	}
+
+	return vehicle, nil
+}
+
+// normalizeFilter validates the enums of filter like normalizeVehicle.
+func normalizeFilter(filter entity.VehicleFilter) (entity.VehicleFilter, error) {
+	var err error
+
+	if filter.FuelType, err = parseOptional(filter.FuelType, valueobjects.ParseFuelType); err != nil {
+		return entity.VehicleFilter{}, err
+	}
+
+	if filter.Transmission, err = parseOptional(filter.Transmission, valueobjects.ParseTransmission); err != nil {
+		return entity.VehicleFilter{}, err
+	}
+
+	if filter.BodyType, err = parseOptional(filter.BodyType, valueobjects.ParseBodyType); err != nil {
+		return entity.VehicleFilter{}, err
+	}
+
+	if filter.Condition, err = parseOptional(filter.Condition, valueobjects.ParseVehicleCondition); err != nil {
+		return entity.VehicleFilter{}, err
+	}
+
+	if filter.Status, err = parseOptional(filter.Status, valueobjects.ParseListingStatus); err != nil {
+		return entity.VehicleFilter{}, err
+	}
+
+	if filter.Doors < 0 || filter.MaxMileage != nil && *filter.MaxMileage < 0 {
+		return entity.VehicleFilter{}, fmt.Errorf("%w: doors and max mileage can not be negative", domainerrors.ErrInvalidArgument)
+	}
+
+	return filter, nil
+}
+
+func parseOptional[T ~string](value T, parse func(value string) (T, error)) (T, error) {
+	if value == "" {
+		return "", nil
+	}
+
+	parsed, err := parse(string(value))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", domainerrors.ErrInvalidArgument, err)
+	}
+
+	return parsed, nil
+}
@@ -0,0 +1,100 @@
+package vehicle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestNormalizeVehicle(t *testing.T) {
+	t.Run("should bring attributes to their canonical form", func(t *testing.T) {
+		actual, err := normalizeVehicle(entity.Vehicle{
+			Mileage:            45000,
+			FuelType:           "diesel",
+			Transmission:       "Manual",
+			BodyType:           " pickup ",
+			Condition:          "used",
+			Doors:              4,
+			EngineDisplacement: 2800,
+			LicensePlate:       "abc 1d23",
+			VIN:                "9bwzzz377vt004251",
+		})
+
+		expected := entity.Vehicle{
+			Mileage:            45000,
+			FuelType:           valueobjects.FuelTypeDiesel,
+			Transmission:       valueobjects.TransmissionManual,
+			BodyType:           valueobjects.BodyTypePickup,
+			Condition:          valueobjects.VehicleConditionUsed,
+			Doors:              4,
+			EngineDisplacement: 2800,
+			LicensePlate:       "ABC1D23",
+			VIN:                "9BWZZZ377VT004251",
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("should keep attributes left empty", func(t *testing.T) {
+		vehicle := entity.Vehicle{Brand: "Some Brand", Price: 80000}
+
+		actual, err := normalizeVehicle(vehicle)
+
+		assert.Nil(t, err)
+		assert.Equal(t, vehicle, actual)
+	})
+
+	t.Run("should reject invalid attributes", func(t *testing.T) {
+		for _, vehicle := range []entity.Vehicle{
+			{Mileage: -1},
+			{Doors: 7},
+			{Doors: -1},
+			{EngineDisplacement: 10001},
+			{FuelType: "STEAM"},
+			{Transmission: "SEMI"},
+			{BodyType: "LIMOUSINE"},
+			{Condition: "BROKEN"},
+			{LicensePlate: "AB1234"},
+			{VIN: "9BWZZZ377VT00425I"},
+		} {
+			_, err := normalizeVehicle(vehicle)
+
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument, vehicle)
+		}
+	})
+}
+
+func TestNormalizeFilter(t *testing.T) {
+	t.Run("should bring enums to their canonical form", func(t *testing.T) {
+		actual, err := normalizeFilter(entity.VehicleFilter{BodyType: "suv", Condition: "new", Status: "published"})
+
+		expected := entity.VehicleFilter{
+			BodyType:  valueobjects.BodyTypeSUV,
+			Condition: valueobjects.VehicleConditionNew,
+			Status:    valueobjects.ListingStatusPublished,
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("should reject invalid filters", func(t *testing.T) {
+		maxMileage := -1
+
+		for _, filter := range []entity.VehicleFilter{
+			{FuelType: "STEAM"},
+			{Status: "ARCHIVED"},
+			{Doors: -1},
+			{MaxMileage: &maxMileage},
+		} {
+			_, err := normalizeFilter(filter)
+
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument, filter)
+		}
+	})
+}
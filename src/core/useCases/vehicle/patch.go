@@ -0,0 +1,144 @@
+package vehicle
+
+import (
+	"fmt"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// patchableField reads and writes a field of a vehicle with the type of value
+// documented by entity.VehicleChange. set reports whether value has that type,
+// and a nil value clears optional fields.
+type patchableField struct {
+	required bool
+	get      func(vehicle entity.Vehicle) any
+	set      func(vehicle *entity.Vehicle, value any) bool
+}
+
+var patchableFields = map[string]patchableField{
+	entity.VehicleFieldBrand: {
+		required: true,
+		get:      func(vehicle entity.Vehicle) any { return vehicle.Brand },
+		set:      func(vehicle *entity.Vehicle, value any) bool { return setField(&vehicle.Brand, value) },
+	},
+	entity.VehicleFieldModel: {
+		required: true,
+		get:      func(vehicle entity.Vehicle) any { return vehicle.Model },
+		set:      func(vehicle *entity.Vehicle, value any) bool { return setField(&vehicle.Model, value) },
+	},
+	entity.VehicleFieldYear: {
+		required: true,
+		get:      func(vehicle entity.Vehicle) any { return vehicle.Year },
+		set:      func(vehicle *entity.Vehicle, value any) bool { return setField(&vehicle.Year, value) },
+	},
+	entity.VehicleFieldColor: {
+		required: true,
+		get:      func(vehicle entity.Vehicle) any { return vehicle.Color },
+		set:      func(vehicle *entity.Vehicle, value any) bool { return setField(&vehicle.Color, value) },
+	},
+	entity.VehicleFieldPrice: {
+		required: true,
+		get:      func(vehicle entity.Vehicle) any { return vehicle.Price },
+		set:      func(vehicle *entity.Vehicle, value any) bool { return setField(&vehicle.Price, value) },
+	},
+	entity.VehicleFieldMileage: {
+		get: func(vehicle entity.Vehicle) any { return vehicle.Mileage },
+		set: func(vehicle *entity.Vehicle, value any) bool { return setField(&vehicle.Mileage, value) },
+	},
+	entity.VehicleFieldFuelType: {
+		get: func(vehicle entity.Vehicle) any { return vehicle.FuelType.String() },
+		set: func(vehicle *entity.Vehicle, value any) bool { return setStringField(&vehicle.FuelType, value) },
+	},
+	entity.VehicleFieldTransmission: {
+		get: func(vehicle entity.Vehicle) any { return vehicle.Transmission.String() },
+		set: func(vehicle *entity.Vehicle, value any) bool { return setStringField(&vehicle.Transmission, value) },
+	},
+	entity.VehicleFieldBodyType: {
+		get: func(vehicle entity.Vehicle) any { return vehicle.BodyType.String() },
+		set: func(vehicle *entity.Vehicle, value any) bool { return setStringField(&vehicle.BodyType, value) },
+	},
+	entity.VehicleFieldCondition: {
+		get: func(vehicle entity.Vehicle) any { return vehicle.Condition.String() },
+		set: func(vehicle *entity.Vehicle, value any) bool { return setStringField(&vehicle.Condition, value) },
+	},
+	entity.VehicleFieldDoors: {
+		get: func(vehicle entity.Vehicle) any { return vehicle.Doors },
+		set: func(vehicle *entity.Vehicle, value any) bool { return setField(&vehicle.Doors, value) },
+	},
+	entity.VehicleFieldEngineDisplacement: {
+		get: func(vehicle entity.Vehicle) any { return vehicle.EngineDisplacement },
+		set: func(vehicle *entity.Vehicle, value any) bool { return setField(&vehicle.EngineDisplacement, value) },
+	},
+	entity.VehicleFieldLicensePlate: {
+		get: func(vehicle entity.Vehicle) any { return vehicle.LicensePlate.String() },
+		set: func(vehicle *entity.Vehicle, value any) bool { return setStringField(&vehicle.LicensePlate, value) },
+	},
+	entity.VehicleFieldVIN: {
+		get: func(vehicle entity.Vehicle) any { return vehicle.VIN.String() },
+		set: func(vehicle *entity.Vehicle, value any) bool { return setStringField(&vehicle.VIN, value) },
+	},
+}
+
+// applyPatch returns vehicle with the changes of patch applied in order.
+// Required fields can not be removed nor set to their zero value, like when
+// creating the vehicle, while removing an optional field clears it. The values
+// set are validated by normalizeVehicle afterwards.
+func applyPatch(vehicle entity.Vehicle, patch entity.VehiclePatch) (entity.Vehicle, error) {
+	for _, change := range patch {
+		field, ok := patchableFields[change.Field]
+		if !ok {
+			return entity.Vehicle{}, fmt.Errorf("%w: vehicle field %q can not be patched", domainerrors.ErrInvalidArgument, change.Field)
+		}
+
+		switch change.Operation {
+		case valueobjects.PatchOperationReplace:
+			if !field.set(&vehicle, change.Value) || field.required && field.get(vehicle) == field.get(entity.Vehicle{}) {
+				return entity.Vehicle{}, fmt.Errorf("%w: invalid %s %v", domainerrors.ErrInvalidArgument, change.Field, change.Value)
+			}
+		case valueobjects.PatchOperationRemove:
+			if field.required {
+				return entity.Vehicle{}, fmt.Errorf("%w: %s is required and can not be removed", domainerrors.ErrInvalidArgument, change.Field)
+			}
+			field.set(&vehicle, nil)
+		case valueobjects.PatchOperationTest:
+			if current := field.get(vehicle); current != change.Value {
+				return entity.Vehicle{}, fmt.Errorf("%w: %s is %v", domainerrors.ErrPatchTestFailed, change.Field, current)
+			}
+		default:
+			return entity.Vehicle{}, fmt.Errorf("%w: unknown patch operation %q", domainerrors.ErrInvalidArgument, change.Operation)
+		}
+	}
+
+	return vehicle, nil
+}
+
+func setField[T comparable](field *T, value any) bool {
+	if value == nil {
+		var zero T
+		*field = zero
+		return true
+	}
+
+	typed, ok := value.(T)
+	if ok {
+		*field = typed
+	}
+
+	return ok
+}
+
+func setStringField[T ~string](field *T, value any) bool {
+	if value == nil {
+		*field = ""
+		return true
+	}
+
+	typed, ok := value.(string)
+	if ok {
+		*field = T(typed)
+	}
+
+	return ok
+}
@@ -0,0 +1,132 @@
+package vehicle
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestApplyPatch(t *testing.T) {
+	vehicle := entity.Vehicle{
+		EntityID: "1",
+		Brand:    "Some Brand",
+		Model:    "Some Model",
+		Year:     2025,
+		Color:    "Gray",
+		Price:    80000,
+		Version:  3,
+	}
+
+	replace := func(field string, value any) entity.VehicleChange {
+		return entity.VehicleChange{Operation: valueobjects.PatchOperationReplace, Field: field, Value: value}
+	}
+
+	test := func(field string, value any) entity.VehicleChange {
+		return entity.VehicleChange{Operation: valueobjects.PatchOperationTest, Field: field, Value: value}
+	}
+
+	t.Run("should replace every field", func(t *testing.T) {
+		actual, err := applyPatch(vehicle, entity.VehiclePatch{
+			replace(entity.VehicleFieldBrand, "Other Brand"),
+			replace(entity.VehicleFieldModel, "Other Model"),
+			replace(entity.VehicleFieldYear, 2024),
+			replace(entity.VehicleFieldColor, "Blue"),
+			replace(entity.VehicleFieldPrice, float64(75000)),
+		})
+
+		expected := vehicle
+		expected.Brand = "Other Brand"
+		expected.Model = "Other Model"
+		expected.Year = 2024
+		expected.Color = "Blue"
+		expected.Price = 75000
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("should keep vehicle as is with empty patch", func(t *testing.T) {
+		actual, err := applyPatch(vehicle, nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, vehicle, actual)
+	})
+
+	t.Run("should test fields against the changes before the test", func(t *testing.T) {
+		actual, err := applyPatch(vehicle, entity.VehiclePatch{
+			test(entity.VehicleFieldColor, "Gray"),
+			replace(entity.VehicleFieldColor, "Blue"),
+			test(entity.VehicleFieldColor, "Blue"),
+			test(entity.VehicleFieldYear, 2025),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, "Blue", actual.Color)
+	})
+
+	t.Run("should fail when test does not match", func(t *testing.T) {
+		_, err := applyPatch(vehicle, entity.VehiclePatch{
+			replace(entity.VehicleFieldColor, "Blue"),
+			test(entity.VehicleFieldPrice, float64(1)),
+		})
+
+		assert.ErrorIs(t, err, domainerrors.ErrPatchTestFailed)
+	})
+
+	t.Run("should not remove required field", func(t *testing.T) {
+		_, err := applyPatch(vehicle, entity.VehiclePatch{
+			{Operation: valueobjects.PatchOperationRemove, Field: entity.VehicleFieldBrand},
+		})
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+	})
+
+	t.Run("should not set field to zero or to a value of another type", func(t *testing.T) {
+		for _, change := range []entity.VehicleChange{
+			replace(entity.VehicleFieldBrand, ""),
+			replace(entity.VehicleFieldYear, 0),
+			replace(entity.VehicleFieldPrice, float64(0)),
+			replace(entity.VehicleFieldYear, "2025"),
+			replace(entity.VehicleFieldColor, nil),
+		} {
+			_, err := applyPatch(vehicle, entity.VehiclePatch{change})
+
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument, change)
+		}
+	})
+
+	t.Run("should set and remove optional fields", func(t *testing.T) {
+		withAttributes, err := applyPatch(vehicle, entity.VehiclePatch{
+			replace(entity.VehicleFieldMileage, 12000),
+			replace(entity.VehicleFieldFuelType, "FLEX"),
+			replace(entity.VehicleFieldVIN, "9BWZZZ377VT004251"),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, 12000, withAttributes.Mileage)
+		assert.Equal(t, valueobjects.FuelTypeFlex, withAttributes.FuelType)
+		assert.Equal(t, valueobjects.VIN("9BWZZZ377VT004251"), withAttributes.VIN)
+
+		actual, err := applyPatch(withAttributes, entity.VehiclePatch{
+			test(entity.VehicleFieldFuelType, "FLEX"),
+			{Operation: valueobjects.PatchOperationRemove, Field: entity.VehicleFieldMileage},
+			{Operation: valueobjects.PatchOperationRemove, Field: entity.VehicleFieldFuelType},
+			replace(entity.VehicleFieldVIN, nil),
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, vehicle, actual)
+	})
+
+	t.Run("should not patch fields that are not patchable", func(t *testing.T) {
+		_, err := applyPatch(vehicle, entity.VehiclePatch{
+			replace("entity_id", "2"),
+		})
+
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+	})
+}
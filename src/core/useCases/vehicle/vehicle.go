@@ -3,91 +3,664 @@ package vehicle
 import (
 	"context"
 	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
 
 	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
 	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/financing"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
 )
 
 type vehicleService struct {
 	vehicleRepository              interfaces.VehicleRepository
 	saleRepository                 interfaces.SaleRepository
+	couponRepository               interfaces.CouponRepository
+	financingPlanRepository        interfaces.FinancingPlanRepository
+	mediaRepository                interfaces.VehicleMediaRepository
+	mediaStorage                   interfaces.MediaStorage
 	vehiclePlatformPaymentsAdapter interfaces.VehiclePlatformPaymentsAdapter
+	txManager                      interfaces.TxManager
+	timeGenerator                  func() time.Time
 }
 
+// managerRoles see every vehicle in their scope. Other callers only see the
+// published ones.
+var managerRoles = []valueobjects.Role{valueobjects.RolePlatformAdmin, valueobjects.RoleAdmin, valueobjects.RoleDealerStaff}
+
 func NewVehicleService(
 	vehicleRepository interfaces.VehicleRepository,
 	saleRepository interfaces.SaleRepository,
+	couponRepository interfaces.CouponRepository,
+	financingPlanRepository interfaces.FinancingPlanRepository,
+	mediaRepository interfaces.VehicleMediaRepository,
+	mediaStorage interfaces.MediaStorage,
 	vehiclePlatformPaymentsAdapter interfaces.VehiclePlatformPaymentsAdapter,
+	txManager interfaces.TxManager,
+	timeGenerator func() time.Time,
 ) interfaces.VehicleService {
 	return &vehicleService{
 		vehicleRepository:              vehicleRepository,
 		saleRepository:                 saleRepository,
+		couponRepository:               couponRepository,
+		financingPlanRepository:        financingPlanRepository,
+		mediaRepository:                mediaRepository,
+		mediaStorage:                   mediaStorage,
 		vehiclePlatformPaymentsAdapter: vehiclePlatformPaymentsAdapter,
+		txManager:                      txManager,
+		timeGenerator:                  timeGenerator,
 	}
 }
 
+// Create generates a UUIDv7 for a vehicle without an entity id, so ids sort
+// by creation time, and gives the vehicle its slug. Vehicles are created as
+// drafts, to be published once ready. A VIN already registered is refused
+// before writing, so the transaction of the caller can go on.
 func (ref *vehicleService) Create(ctx context.Context, vehicle entity.Vehicle) (*entity.Vehicle, error) {
-	return ref.vehicleRepository.Create(ctx, vehicle)
+	vehicle, err := normalizeVehicle(vehicle)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ref.checkVIN(ctx, vehicle.VIN); err != nil {
+		return nil, err
+	}
+
+	vehicle.Status = valueobjects.ListingStatusDraft
+	vehicle.PublishedAt = nil
+
+	if vehicle.EntityID == "" {
+		id, err := uuid.NewV7()
+		if err != nil {
+			return nil, err
+		}
+
+		vehicle.EntityID = id.String()
+	}
+
+	vehicle.Slug = vehicleSlug(vehicle)
+
+	created, err := ref.vehicleRepository.Create(ctx, vehicle)
+	if err != nil {
+		return nil, err
+	}
+
+	logger.FromContext(ctx).InfoContext(ctx, "vehicle created",
+		"entity_id", created.EntityID,
+		"dealer_id", created.DealerID,
+		"price", created.Price,
+		"principal", session.Subject(ctx),
+	)
+
+	return created, nil
 }
 
 func (ref *vehicleService) GetByID(ctx context.Context, id string) (*entity.Vehicle, error) {
-	return ref.vehicleRepository.GetByID(ctx, id)
+	vehicle, err := ref.vehicleRepository.GetByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	return ref.visible(ctx, vehicle), nil
 }
 
-func (ref *vehicleService) Search(ctx context.Context, isSold *bool) ([]entity.Vehicle, error) {
-	return ref.vehicleRepository.Search(ctx, isSold)
+func (ref *vehicleService) GetBySlug(ctx context.Context, slug string) (*entity.Vehicle, error) {
+	vehicle, err := ref.vehicleRepository.GetBySlug(ctx, slug)
+	if err != nil {
+		return nil, err
+	}
+
+	return ref.visible(ctx, vehicle), nil
 }
 
-func (ref *vehicleService) Update(ctx context.Context, id string, vehicle entity.Vehicle) (*entity.Vehicle, error) {
-	current, err := ref.vehicleRepository.GetByID(ctx, id)
+// Search only finds published vehicles for callers that do not manage them,
+// whatever listing status they ask for.
+func (ref *vehicleService) Search(ctx context.Context, filter entity.VehicleFilter) ([]entity.Vehicle, error) {
+	filter, err := normalizeFilter(filter)
 	if err != nil {
 		return nil, err
 	}
 
-	if current == nil {
-		return nil, nil
+	if !session.HasAnyRole(ctx, managerRoles...) {
+		filter.PublishedOnly = true
 	}
 
-	return ref.vehicleRepository.Update(ctx, id, vehicle)
+	return ref.vehicleRepository.Search(ctx, filter)
 }
 
-func (ref *vehicleService) Buy(ctx context.Context, entityID, buyerDocumentNumber string) (*entity.Vehicle, error) {
-	vehicle, err := ref.vehicleRepository.GetByID(ctx, entityID)
+// visible hides the vehicles that are not published from callers that do not
+// manage them.
+func (ref *vehicleService) visible(ctx context.Context, vehicle *entity.Vehicle) *entity.Vehicle {
+	if vehicle == nil || vehicle.IsPublishedAt(ref.timeGenerator()) || session.HasAnyRole(ctx, managerRoles...) {
+		return vehicle
+	}
+
+	return nil
+}
+
+// Update applies patch to the vehicle when it is still at version. The
+// vehicle is locked from the read to the write, and an update that changes
+// nothing is not written.
+func (ref *vehicleService) Update(ctx context.Context, id string, version int, patch entity.VehiclePatch) (*entity.Vehicle, error) {
+	var updated *entity.Vehicle
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		current, err := ref.vehicleRepository.GetByID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if current == nil {
+			return nil
+		}
+
+		if current.Version != version {
+			return fmt.Errorf("%w: vehicle %q is at version %d", domainerrors.ErrVersionMismatch, id, current.Version)
+		}
+
+		patched, err := applyPatch(*current, patch)
+		if err != nil {
+			return err
+		}
+
+		if patched, err = normalizeVehicle(patched); err != nil {
+			return err
+		}
+
+		patched.Slug = vehicleSlug(patched)
+
+		if patched == *current {
+			logger.FromContext(ctx).DebugContext(ctx, "vehicle update has no changes", "entity_id", id)
+			updated = current
+			return nil
+		}
+
+		updated, err = ref.vehicleRepository.Update(ctx, id, patched)
+		return err
+	})
 	if err != nil {
 		return nil, err
 	}
 
-	if vehicle == nil {
-		return nil, nil
+	if updated != nil {
+		logger.FromContext(ctx).InfoContext(ctx, "vehicle updated",
+			"entity_id", id,
+			"price", updated.Price,
+			"version", updated.Version,
+			"principal", session.Subject(ctx),
+		)
 	}
 
-	existingSale, err := ref.saleRepository.GetByEntityID(ctx, entityID)
+	return updated, nil
+}
+
+// Buy reserves the vehicle in a single transaction, creating its sale without
+// a payment, and only generates the payment after the transaction commits, so
+// the vehicle is not locked while the payments platform is called. It refuses
+// a vehicle whose sale is pending or approved: a vehicle only has one sale, so
+// a later one could not be stored and its payment would be left without a
+// sale. A rejected sale is deleted instead, so the vehicle can be bought
+// again. Where the storage locks the vehicle in the transaction, a second
+// buyer waits for the first one's reservation and is refused as well. When
+// the payment can not be generated, the reservation is cancelled.
+// Buy charges the price of the vehicle, less the discount of the coupon of
+// the purchase when one is given. The coupon is redeemed along with the sale,
+// so a coupon with no uses left fails the buy before any payment is generated.
+// A trade-in pays for part of the price, and the payment only covers the
+// rest. A financed payment sends its amortization table along, stored with
+// the sale.
+func (ref *vehicleService) Buy(ctx context.Context, entityID string, purchase entity.Purchase) (*entity.Vehicle, error) {
+	var bought *entity.Vehicle
+	var reserved *entity.Sale
+	var payment entity.Payment
+	var couponID int
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		vehicle, err := ref.vehicleRepository.GetByID(ctx, entityID)
+		if err != nil {
+			return err
+		}
+
+		// Only published vehicles can be bought, the others are not found.
+		if vehicle == nil || !vehicle.IsPublishedAt(ref.timeGenerator()) {
+			return nil
+		}
+
+		existingSale, err := ref.saleRepository.GetByEntityID(ctx, entityID)
+		if err != nil {
+			return err
+		}
+
+		switch {
+		case existingSale == nil:
+		case existingSale.Status == valueobjects.SaleStatusTypeApproved:
+			return errors.New("vehicle already sold")
+		case existingSale.Status == valueobjects.SaleStatusTypeRejected:
+			if _, err = ref.saleRepository.DeleteRejected(ctx, existingSale.ID); err != nil {
+				return err
+			}
+		default:
+			return fmt.Errorf("%w: vehicle %q already has a %s sale", domainerrors.ErrInvalidState, entityID, existingSale.Status)
+		}
+
+		log := logger.FromContext(ctx).With("entity_id", entityID, "principal", session.Subject(ctx))
+
+		sale := entity.Sale{
+			EntityID:            entityID,
+			DealerID:            vehicle.DealerID,
+			BuyerDocumentNumber: purchase.BuyerDocumentNumber,
+			ListPrice:           vehicle.Price,
+			Price:               vehicle.Price,
+			Status:              valueobjects.SaleStatusTypePending,
+		}
+
+		if purchase.CouponCode != "" {
+			coupon, err := ref.redeemCoupon(ctx, *vehicle, purchase.CouponCode)
+			if err != nil {
+				return err
+			}
+
+			sale.Discount = coupon.DiscountOn(vehicle.Price)
+			sale.Price = vehicle.Price - sale.Discount
+			sale.CouponCode = coupon.Code.String()
+			couponID = coupon.ID
+		}
+
+		if purchase.TradeIn != nil {
+			if sale.TradeIn, err = ref.appraiseTradeIn(ctx, *purchase.TradeIn, sale.Price); err != nil {
+				return err
+			}
+		}
+
+		payment = entity.Payment{
+			Amount: sale.AmountDue(),
+			Status: valueobjects.SaleStatusTypeApproved.String(),
+		}
+
+		if purchase.Financing != nil {
+			if sale.Financing, payment.Installments, err = ref.finance(ctx, *vehicle, sale.AmountDue(), *purchase.Financing); err != nil {
+				return err
+			}
+
+			payment.DownPayment = sale.Financing.DownPayment
+		}
+
+		log.InfoContext(ctx, "buy started", "price", sale.Price, "discount", sale.Discount, "coupon_code", sale.CouponCode, "amount_due", sale.AmountDue(), "installments", len(payment.Installments))
+
+		created, err := ref.saleRepository.Create(ctx, sale)
+		if err != nil {
+			log.ErrorContext(ctx, "failed to create sale", "error", err)
+			return err
+		}
+
+		if len(payment.Installments) > 0 {
+			if _, err = ref.saleRepository.CreateInstallments(ctx, created.ID, payment.Installments); err != nil {
+				log.ErrorContext(ctx, "failed to create installments", "error", err)
+				return err
+			}
+		}
+
+		bought = vehicle
+		reserved = created
+		return nil
+	})
+	if err != nil || bought == nil {
+		return nil, err
+	}
+
+	log := logger.FromContext(ctx).With("entity_id", entityID, "sale_id", reserved.ID, "principal", session.Subject(ctx))
+
+	paymentID, err := ref.vehiclePlatformPaymentsAdapter.GeneratePayment(ctx, payment)
 	if err != nil {
+		log.ErrorContext(ctx, "failed to generate payment", "error", err)
+		ref.cancelReservation(ctx, *reserved, couponID)
+		return nil, err
+	}
+
+	log = log.With("payment_id", paymentID)
+	log.InfoContext(ctx, "payment generated")
+
+	if _, err = ref.saleRepository.AttachPayment(ctx, reserved.ID, paymentID); err != nil {
+		log.ErrorContext(ctx, "failed to attach payment to sale", "error", err)
+		ref.cancelReservation(ctx, *reserved, couponID)
 		return nil, err
 	}
 
-	if existingSale != nil && existingSale.Status == "APPROVED" {
-		return nil, errors.New("vehicle already sold")
+	log.InfoContext(ctx, "sale created", "status", reserved.Status.String())
+
+	return bought, nil
+}
+
+// cancelReservation deletes a sale reserved by Buy whose payment could not be
+// generated, giving back the use of its coupon, so the vehicle can be bought
+// again. It runs even when the buyer went away, and only logs a failure.
+func (ref *vehicleService) cancelReservation(ctx context.Context, reserved entity.Sale, couponID int) {
+	ctx = context.WithoutCancel(ctx)
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		if _, err := ref.saleRepository.DeleteReservation(ctx, reserved.ID); err != nil {
+			return err
+		}
+
+		if couponID != 0 {
+			if _, err := ref.couponRepository.Release(ctx, couponID); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		logger.FromContext(ctx).ErrorContext(ctx, "failed to cancel sale reservation",
+			"entity_id", reserved.EntityID,
+			"sale_id", reserved.ID,
+			"error", err,
+		)
+	}
+}
+
+// redeemCoupon finds the coupon of the dealer of the vehicle by code and counts
+// one use of it, refusing coupons out of their validity window, not eligible
+// for the vehicle or that would leave nothing to pay.
+func (ref *vehicleService) redeemCoupon(ctx context.Context, vehicle entity.Vehicle, couponCode string) (*entity.Coupon, error) {
+	code, err := valueobjects.ParseCouponCode(couponCode)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", domainerrors.ErrInvalidArgument, err)
 	}
 
-	paymentID, err := ref.vehiclePlatformPaymentsAdapter.GeneratePayment(ctx, vehicle.Price, valueobjects.SaleStatusTypeApproved.String())
+	coupon, err := ref.couponRepository.GetByCode(ctx, vehicle.DealerID, code)
 	if err != nil {
 		return nil, err
 	}
 
-	sale := entity.Sale{
-		EntityID:            entityID,
-		PaymentID:           paymentID,
-		BuyerDocumentNumber: buyerDocumentNumber,
-		Price:               vehicle.Price,
-		Status:              valueobjects.SaleStatusTypePending,
+	if coupon == nil {
+		return nil, fmt.Errorf("%w: coupon %q does not exist", domainerrors.ErrInvalidArgument, code)
 	}
 
-	_, err = ref.saleRepository.Create(ctx, sale)
+	if !coupon.IsValidAt(ref.timeGenerator()) {
+		return nil, fmt.Errorf("%w: coupon %q is not valid now", domainerrors.ErrInvalidArgument, code)
+	}
+
+	if !coupon.AppliesTo(vehicle) {
+		return nil, fmt.Errorf("%w: coupon %q does not apply to vehicle %q", domainerrors.ErrInvalidArgument, code, vehicle.EntityID)
+	}
+
+	if coupon.DiscountOn(vehicle.Price) >= vehicle.Price {
+		return nil, fmt.Errorf("%w: coupon %q discounts the whole price", domainerrors.ErrInvalidArgument, code)
+	}
+
+	redeemed, err := ref.couponRepository.Redeem(ctx, coupon.ID)
 	if err != nil {
 		return nil, err
 	}
 
-	return vehicle, nil
+	if redeemed == nil {
+		return nil, fmt.Errorf("%w: coupon %q has no uses left", domainerrors.ErrInvalidArgument, code)
+	}
+
+	return redeemed, nil
+}
+
+// finance works out the amortization table of amountDue under the plan chosen
+// by the buyer, which must be one of the dealer of the vehicle, the first
+// installment being due a month from now.
+func (ref *vehicleService) finance(ctx context.Context, vehicle entity.Vehicle, amountDue float64, choice entity.FinancingChoice) (*entity.Financing, []entity.Installment, error) {
+	plan, err := ref.financingPlanRepository.GetByID(ctx, choice.PlanID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if plan == nil || plan.DealerID != vehicle.DealerID {
+		return nil, nil, fmt.Errorf("%w: financing plan %d does not exist", domainerrors.ErrInvalidArgument, choice.PlanID)
+	}
+
+	if choice.Months <= 0 || choice.Months > plan.MaxMonths {
+		return nil, nil, fmt.Errorf("%w: financing plan %d takes from 1 to %d months", domainerrors.ErrInvalidArgument, plan.ID, plan.MaxMonths)
+	}
+
+	downPayment := math.Round(choice.DownPayment*100) / 100
+	minDownPayment := plan.MinDownPaymentOn(amountDue)
+
+	if downPayment < minDownPayment || downPayment >= amountDue {
+		return nil, nil, fmt.Errorf("%w: down payment must be at least %.2f and below the amount due of %.2f", domainerrors.ErrInvalidArgument, minDownPayment, amountDue)
+	}
+
+	principal := math.Round((amountDue-downPayment)*100) / 100
+
+	installments, err := financing.Schedule(plan.AmortizationSystem, principal, plan.MonthlyInterestRate, choice.Months, ref.timeGenerator())
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return &entity.Financing{
+		PlanID:              plan.ID,
+		AmortizationSystem:  plan.AmortizationSystem,
+		MonthlyInterestRate: plan.MonthlyInterestRate,
+		DownPayment:         downPayment,
+		Principal:           principal,
+		Months:              choice.Months,
+	}, installments, nil
+}
+
+// appraiseTradeIn only takes trade-ins from the dealer staff, who agree on
+// their value, and validates the vehicle they become once the sale is
+// approved, whose VIN must not be registered yet. The trade-in must leave part
+// of price to pay.
+func (ref *vehicleService) appraiseTradeIn(ctx context.Context, tradeIn entity.TradeIn, price float64) (*entity.TradeIn, error) {
+	if !session.HasAnyRole(ctx, managerRoles...) {
+		return nil, fmt.Errorf("%w: trade-ins are appraised by the dealer staff", domainerrors.ErrPermissionDenied)
+	}
+
+	tradeIn.Brand = strings.TrimSpace(tradeIn.Brand)
+	tradeIn.Model = strings.TrimSpace(tradeIn.Model)
+	tradeIn.Color = strings.TrimSpace(tradeIn.Color)
+
+	if tradeIn.Brand == "" || tradeIn.Model == "" || tradeIn.Color == "" || tradeIn.Year <= 0 {
+		return nil, fmt.Errorf("%w: trade-in brand, model, year and color are required", domainerrors.ErrInvalidArgument)
+	}
+
+	if tradeIn.AppraisedValue <= 0 || tradeIn.AppraisedValue >= price {
+		return nil, fmt.Errorf("%w: trade-in appraised value must be positive and below the price of %.2f", domainerrors.ErrInvalidArgument, price)
+	}
+
+	vehicle, err := normalizeVehicle(tradeIn.Vehicle(0))
+	if err != nil {
+		return nil, err
+	}
+
+	if err = ref.checkVIN(ctx, vehicle.VIN); err != nil {
+		return nil, err
+	}
+
+	tradeIn.Mileage = vehicle.Mileage
+	tradeIn.LicensePlate = vehicle.LicensePlate
+	tradeIn.VIN = vehicle.VIN
+	tradeIn.AppraisedBy = session.Subject(ctx)
+	tradeIn.VehicleID = ""
+
+	return &tradeIn, nil
+}
+
+// checkVIN refuses a VIN registered to a vehicle of any dealer. Vehicles
+// without a VIN never collide.
+func (ref *vehicleService) checkVIN(ctx context.Context, vin valueobjects.VIN) error {
+	if vin == "" {
+		return nil
+	}
+
+	exists, err := ref.vehicleRepository.ExistsByVIN(ctx, vin)
+	if err != nil {
+		return err
+	}
+
+	if exists {
+		return fmt.Errorf("%w: vin %q is already registered", domainerrors.ErrAlreadyExists, vin)
+	}
+
+	return nil
+}
+
+// Publish runs the publish checklist, a price and at least one photo, and
+// offers the vehicle from publishAt on. A time in the past publishes it right
+// away. Publishing a published vehicle again moves its publication time.
+func (ref *vehicleService) Publish(ctx context.Context, id string, publishAt *time.Time) (*entity.Vehicle, error) {
+	var published *entity.Vehicle
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		vehicle, err := ref.vehicleRepository.GetByID(ctx, id)
+		if err != nil || vehicle == nil {
+			return err
+		}
+
+		media, err := ref.mediaRepository.SearchByVehicleIDs(ctx, []string{id})
+		if err != nil {
+			return err
+		}
+
+		var missing []string
+
+		if vehicle.Price <= 0 {
+			missing = append(missing, "price")
+		}
+
+		if len(media) == 0 {
+			missing = append(missing, "photos")
+		}
+
+		if len(missing) > 0 {
+			return fmt.Errorf("%w: vehicle %q is missing %s", domainerrors.ErrIncomplete, id, strings.Join(missing, ", "))
+		}
+
+		now := ref.timeGenerator()
+		if publishAt == nil || publishAt.Before(now) {
+			publishAt = &now
+		}
+
+		changed := *vehicle
+		changed.Status = valueobjects.ListingStatusPublished
+		changed.PublishedAt = publishAt
+
+		published, err = ref.vehicleRepository.Update(ctx, id, changed)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if published != nil {
+		logger.FromContext(ctx).InfoContext(ctx, "vehicle published",
+			"entity_id", id,
+			"published_at", published.PublishedAt,
+			"principal", session.Subject(ctx),
+		)
+	}
+
+	return published, nil
+}
+
+// Unpublish takes a published vehicle out of the catalog, scheduled ones
+// included. Drafts and unlisted vehicles are left as they are.
+func (ref *vehicleService) Unpublish(ctx context.Context, id string) (*entity.Vehicle, error) {
+	var unpublished *entity.Vehicle
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		vehicle, err := ref.vehicleRepository.GetByID(ctx, id)
+		if err != nil || vehicle == nil {
+			return err
+		}
+
+		if vehicle.Status != valueobjects.ListingStatusPublished {
+			unpublished = vehicle
+			return nil
+		}
+
+		changed := *vehicle
+		changed.Status = valueobjects.ListingStatusUnlisted
+		changed.PublishedAt = nil
+
+		unpublished, err = ref.vehicleRepository.Update(ctx, id, changed)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if unpublished != nil {
+		logger.FromContext(ctx).InfoContext(ctx, "vehicle unpublished",
+			"entity_id", id,
+			"status", unpublished.Status.String(),
+			"principal", session.Subject(ctx),
+		)
+	}
+
+	return unpublished, nil
+}
+
+// Delete removes a vehicle that was never sold along with its media. The files
+// of the media are only deleted once the transaction is committed, a failure
+// to delete one leaving an orphan file behind rather than a broken row.
+func (ref *vehicleService) Delete(ctx context.Context, id string) (*entity.Vehicle, error) {
+	var (
+		deleted *entity.Vehicle
+		media   []entity.VehicleMedia
+	)
+
+	err := interfaces.WithinTx(ctx, ref.txManager, func(ctx context.Context) error {
+		vehicle, err := ref.vehicleRepository.GetByID(ctx, id)
+		if err != nil || vehicle == nil {
+			return err
+		}
+
+		sale, err := ref.saleRepository.GetByEntityID(ctx, id)
+		if err != nil {
+			return err
+		}
+
+		if sale != nil {
+			return fmt.Errorf("%w: vehicle %q has a sale", domainerrors.ErrInUse, id)
+		}
+
+		if media, err = ref.mediaRepository.DeleteByVehicleID(ctx, id); err != nil {
+			return err
+		}
+
+		deleted, err = ref.vehicleRepository.Delete(ctx, id)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if deleted == nil {
+		return nil, nil
+	}
+
+	log := logger.FromContext(ctx)
+
+	for _, item := range media {
+		for _, key := range []string{item.StorageKey, item.ThumbnailKey} {
+			if err = ref.mediaStorage.Delete(ctx, key); err != nil {
+				log.ErrorContext(ctx, "failed to delete media file", "key", key, "error", err)
+			}
+		}
+	}
+
+	log.InfoContext(ctx, "vehicle deleted",
+		"entity_id", id,
+		"media", len(media),
+		"principal", session.Subject(ctx),
+	)
+
+	return deleted, nil
+}
+
+func vehicleSlug(vehicle entity.Vehicle) valueobjects.Slug {
+	return valueobjects.NewVehicleSlug(vehicle.Year, vehicle.Brand, vehicle.Model, vehicle.Color, vehicle.EntityID)
 }
@@ -12,13 +12,117 @@ import (
 	"go.mongodb.org/mongo-driver/bson/primitive"
 
 	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
 )
 
 func TestCreate(t *testing.T) {
 	ctx := context.TODO()
 
+	t.Run("should not create vehicle when failed to create", func(t *testing.T) {
+		vehicle := entity.Vehicle{
+			EntityID: uuid.NewString(),
+			Brand:    "Some Brand",
+			Price:    80000,
+		}
+
+		unexpectedError := errors.New("unexpected error")
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("Create", ctx, mock.AnythingOfType("entity.Vehicle")).
+			Return(nil, unexpectedError)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+		actual, err := service.Create(ctx, vehicle)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+	})
+
+	t.Run("should not create vehicle with invalid attributes", func(t *testing.T) {
+		vehicle := entity.Vehicle{
+			Brand:        "Some Brand",
+			Price:        80000,
+			LicensePlate: "AB-1234",
+		}
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+		actual, err := service.Create(ctx, vehicle)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Create", 0)
+	})
+
+	t.Run("should create vehicle with normalized attributes", func(t *testing.T) {
+		vehicle := entity.Vehicle{
+			EntityID:     uuid.NewString(),
+			Brand:        "Some Brand",
+			Price:        80000,
+			FuelType:     "flex",
+			LicensePlate: "abc-1234",
+			VIN:          "9bwzzz377vt004251",
+		}
+
+		normalized := vehicle
+		normalized.FuelType = valueobjects.FuelTypeFlex
+		normalized.LicensePlate = "ABC1234"
+		normalized.VIN = "9BWZZZ377VT004251"
+		normalized.Slug = vehicleSlug(vehicle)
+		normalized.Status = valueobjects.ListingStatusDraft
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("ExistsByVIN", ctx, normalized.VIN).
+			Return(false, nil)
+
+		vehicleRepositoryMocked.On("Create", ctx, normalized).
+			Return(&normalized, nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+		actual, err := service.Create(ctx, vehicle)
+
+		assert.Nil(t, err)
+		assert.Equal(t, &normalized, actual)
+	})
+
 	t.Run("should create vehicle successfully", func(t *testing.T) {
+		vehicle := entity.Vehicle{
+			EntityID: uuid.NewString(),
+			Brand:    "Some Brand",
+			Model:    "Some Model",
+			Year:     2025,
+			Color:    "Gray",
+			Price:    80000,
+		}
+
+		expected := vehicle
+		expected.Slug = vehicleSlug(vehicle)
+		expected.Status = valueobjects.ListingStatusDraft
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("Create", ctx, expected).
+			Return(&expected, nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+		actual, err := service.Create(ctx, vehicle)
+
+		assert.Nil(t, err)
+		assert.Equal(t, &expected, actual)
+		assert.Regexp(t, `^2025-some-brand-some-model-gray-[0-9a-f]{6}$`, actual.Slug.String())
+	})
+
+	t.Run("should generate a uuid v7 when no entity id is given", func(t *testing.T) {
 		vehicle := entity.Vehicle{
 			Brand: "Some Brand",
 			Model: "Some Model",
@@ -29,20 +133,67 @@ func TestCreate(t *testing.T) {
 
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
 
-		vehicleRepositoryMocked.On("Create", ctx, vehicle).
-			Return(&vehicle, nil)
+		vehicleRepositoryMocked.On("Create", ctx, mock.MatchedBy(func(created entity.Vehicle) bool {
+			id, err := uuid.Parse(created.EntityID)
+			return err == nil && id.Version() == 7 && created.Slug == vehicleSlug(created)
+		})).
+			Return(func(_ context.Context, created entity.Vehicle) (*entity.Vehicle, error) {
+				return &created, nil
+			})
 
-		service := NewVehicleService(vehicleRepositoryMocked, nil, nil)
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
 
 		actual, err := service.Create(ctx, vehicle)
 
-		assert.NotNil(t, actual)
 		assert.Nil(t, err)
+		assert.NotEmpty(t, actual.EntityID)
+	})
+
+	t.Run("should not create vehicle with an entity id already taken", func(t *testing.T) {
+		vehicle := entity.Vehicle{
+			EntityID: uuid.NewString(),
+			Brand:    "Some Brand",
+			Price:    80000,
+		}
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("Create", ctx, mock.AnythingOfType("entity.Vehicle")).
+			Return(nil, domainerrors.ErrAlreadyExists)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+		actual, err := service.Create(ctx, vehicle)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+	})
+
+	t.Run("should not create vehicle with a vin already registered", func(t *testing.T) {
+		vehicle := entity.Vehicle{
+			Brand: "Some Brand",
+			Price: 80000,
+			VIN:   "9BWZZZ377VT004251",
+		}
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("ExistsByVIN", ctx, vehicle.VIN).
+			Return(true, nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+		actual, err := service.Create(ctx, vehicle)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Create", 0)
 	})
 }
 
 func TestGetByID(t *testing.T) {
 	ctx := context.TODO()
+	publishedAt := time.Now().Add(-time.Hour)
 	entityID := uuid.NewString()
 	unexpectedError := errors.New("unexpected error")
 
@@ -52,7 +203,7 @@ func TestGetByID(t *testing.T) {
 		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
 			Return(nil, unexpectedError)
 
-		service := NewVehicleService(vehicleRepositoryMocked, nil, nil)
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
 
 		actual, err := service.GetByID(ctx, entityID)
 
@@ -66,27 +217,109 @@ func TestGetByID(t *testing.T) {
 		now := time.Now()
 
 		vehicle := &entity.Vehicle{
-			ID:        1,
-			EntityID:  entityID,
-			Brand:     "Some Brand",
-			Model:     "Some Model",
-			Year:      2025,
-			Color:     "Gray",
-			Price:     80000,
-			CreatedAt: now,
-			UpdatedAt: now,
+			ID:          1,
+			EntityID:    entityID,
+			Brand:       "Some Brand",
+			Model:       "Some Model",
+			Year:        2025,
+			Color:       "Gray",
+			Price:       80000,
+			CreatedAt:   now,
+			UpdatedAt:   now,
+			Status:      valueobjects.ListingStatusPublished,
+			PublishedAt: &publishedAt,
 		}
 
 		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
 			Return(vehicle, nil)
 
-		service := NewVehicleService(vehicleRepositoryMocked, nil, nil)
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
 
 		actual, err := service.GetByID(ctx, entityID)
 
 		assert.NotNil(t, actual)
 		assert.Nil(t, err)
 	})
+
+	t.Run("should hide vehicle that is not published from callers that do not manage vehicles", func(t *testing.T) {
+		scheduledAt := time.Now().Add(time.Hour)
+
+		for _, vehicle := range []*entity.Vehicle{
+			{EntityID: entityID, Status: valueobjects.ListingStatusDraft},
+			{EntityID: entityID, Status: valueobjects.ListingStatusUnlisted},
+			{EntityID: entityID, Status: valueobjects.ListingStatusPublished, PublishedAt: &scheduledAt},
+		} {
+			vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+			vehicleRepositoryMocked.On("GetByID", mock.Anything, entityID).
+				Return(vehicle, nil)
+
+			service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+			hidden, err := service.GetByID(ctx, entityID)
+
+			assert.Nil(t, err)
+			assert.Nil(t, hidden, vehicle.Status)
+
+			buyerCtx := session.WithPrincipal(ctx, entity.Principal{Roles: []valueobjects.Role{valueobjects.RoleBuyer}})
+
+			hidden, err = service.GetByID(buyerCtx, entityID)
+
+			assert.Nil(t, err)
+			assert.Nil(t, hidden, vehicle.Status)
+
+			staffCtx := session.WithPrincipal(ctx, entity.Principal{DealerID: 3, Roles: []valueobjects.Role{valueobjects.RoleDealerStaff}})
+
+			shown, err := service.GetByID(staffCtx, entityID)
+
+			assert.Nil(t, err)
+			assert.Equal(t, vehicle, shown)
+		}
+	})
+}
+
+func TestGetBySlug(t *testing.T) {
+	ctx := context.TODO()
+	slug := "2025-some-brand-some-model-gray-3f9a1c"
+
+	t.Run("should not get vehicle by slug when failed to get", func(t *testing.T) {
+		unexpectedError := errors.New("unexpected error")
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("GetBySlug", ctx, slug).
+			Return(nil, unexpectedError)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+		actual, err := service.GetBySlug(ctx, slug)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+	})
+
+	t.Run("should get vehicle by slug successfully", func(t *testing.T) {
+		publishedAt := time.Now().Add(-time.Hour)
+
+		vehicle := &entity.Vehicle{
+			EntityID:    uuid.NewString(),
+			Slug:        valueobjects.Slug(slug),
+			Status:      valueobjects.ListingStatusPublished,
+			PublishedAt: &publishedAt,
+		}
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("GetBySlug", ctx, slug).
+			Return(vehicle, nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+		actual, err := service.GetBySlug(ctx, slug)
+
+		assert.Equal(t, vehicle, actual)
+		assert.Nil(t, err)
+	})
 }
 
 func TestSearch(t *testing.T) {
@@ -98,28 +331,70 @@ func TestSearch(t *testing.T) {
 
 		isSold := true
 
-		vehicleRepositoryMocked.On("Search", ctx, &isSold).
+		vehicleRepositoryMocked.On("Search", ctx, entity.VehicleFilter{IsSold: &isSold, PublishedOnly: true}).
 			Return(nil, unexpectedError)
 
-		service := NewVehicleService(vehicleRepositoryMocked, nil, nil)
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
 
-		actual, err := service.Search(ctx, &isSold)
+		actual, err := service.Search(ctx, entity.VehicleFilter{IsSold: &isSold})
 
 		assert.Nil(t, actual)
 		assert.Equal(t, unexpectedError, err)
 	})
 
+	t.Run("should not search vehicles with invalid filter", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+		actual, err := service.Search(ctx, entity.VehicleFilter{FuelType: "STEAM"})
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Search", 0)
+	})
+
+	t.Run("should search vehicles by normalized filter", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("Search", ctx, entity.VehicleFilter{Transmission: valueobjects.TransmissionAutomatic, PublishedOnly: true}).
+			Return([]entity.Vehicle{}, nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+		actual, err := service.Search(ctx, entity.VehicleFilter{Transmission: " automatic "})
+
+		assert.NotNil(t, actual)
+		assert.Nil(t, err)
+	})
+
 	t.Run("should search vehicles successfully", func(t *testing.T) {
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
 
 		isSold := true
 
-		vehicleRepositoryMocked.On("Search", ctx, &isSold).
+		vehicleRepositoryMocked.On("Search", ctx, entity.VehicleFilter{IsSold: &isSold, PublishedOnly: true}).
+			Return([]entity.Vehicle{}, nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
+
+		actual, err := service.Search(ctx, entity.VehicleFilter{IsSold: &isSold})
+
+		assert.NotNil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should search every listing status for staff", func(t *testing.T) {
+		staffCtx := session.WithPrincipal(ctx, entity.Principal{DealerID: 3, Roles: []valueobjects.Role{valueobjects.RoleDealerStaff}})
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("Search", staffCtx, entity.VehicleFilter{Status: valueobjects.ListingStatusDraft}).
 			Return([]entity.Vehicle{}, nil)
 
-		service := NewVehicleService(vehicleRepositoryMocked, nil, nil)
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, nil, time.Now)
 
-		actual, err := service.Search(ctx, &isSold)
+		actual, err := service.Search(staffCtx, entity.VehicleFilter{Status: "draft"})
 
 		assert.NotNil(t, actual)
 		assert.Nil(t, err)
@@ -131,77 +406,198 @@ func TestUpdate(t *testing.T) {
 	vehicleID := primitive.NewObjectID().Hex()
 	unexpectedError := errors.New("unexpected error")
 
+	patch := entity.VehiclePatch{
+		{Operation: valueobjects.PatchOperationReplace, Field: entity.VehicleFieldColor, Value: "Blue"},
+	}
+
 	t.Run("should not update vehicle when failed to get by id", func(t *testing.T) {
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
 
 		vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
 			Return(nil, unexpectedError)
 
-		service := NewVehicleService(vehicleRepositoryMocked, nil, nil)
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
 
-		actual, err := service.Update(ctx, vehicleID, entity.Vehicle{})
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Update(ctx, vehicleID, 1, patch)
 
 		assert.Nil(t, actual)
 		assert.Equal(t, unexpectedError, err)
 		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
 	})
 
 	t.Run("should not update vehicle when vehicle does not exist", func(t *testing.T) {
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
 
 		vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
 			Return(nil, nil)
 
-		service := NewVehicleService(vehicleRepositoryMocked, nil, nil)
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Update(ctx, vehicleID, 1, patch)
 
-		actual, err := service.Update(ctx, vehicleID, entity.Vehicle{})
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 0)
+	})
+
+	t.Run("should not update vehicle changed by another request", func(t *testing.T) {
+		vehicle := entity.Vehicle{Color: "Black", Version: 2}
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
+			Return(&vehicle, nil)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Update(ctx, vehicleID, 1, patch)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrVersionMismatch)
+		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
+	})
+
+	t.Run("should not update vehicle when patch is invalid", func(t *testing.T) {
+		vehicle := entity.Vehicle{Color: "Black", Version: 1}
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
+			Return(&vehicle, nil)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Update(ctx, vehicleID, 1, entity.VehiclePatch{
+			{Operation: valueobjects.PatchOperationRemove, Field: entity.VehicleFieldColor},
+		})
 
 		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+	})
+
+	t.Run("should not write vehicle when patch changes nothing", func(t *testing.T) {
+		vehicle := entity.Vehicle{EntityID: vehicleID, Color: "Blue", Version: 1}
+		vehicle.Slug = vehicleSlug(vehicle)
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
+			Return(&vehicle, nil)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Update(ctx, vehicleID, 1, patch)
+
+		assert.Equal(t, &vehicle, actual)
 		assert.Nil(t, err)
 		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
 	})
 
 	t.Run("should not update vehicle when failed to update", func(t *testing.T) {
-		vehicle := entity.Vehicle{}
+		vehicle := entity.Vehicle{EntityID: vehicleID, Color: "Black", Version: 1}
+
+		patched := entity.Vehicle{EntityID: vehicleID, Color: "Blue", Version: 1}
+		patched.Slug = vehicleSlug(patched)
 
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
 
 		vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
 			Return(&vehicle, nil)
 
-		vehicleRepositoryMocked.On("Update", ctx, vehicleID, entity.Vehicle{}).
+		vehicleRepositoryMocked.On("Update", ctx, vehicleID, patched).
 			Return(nil, unexpectedError)
 
-		service := NewVehicleService(vehicleRepositoryMocked, nil, nil)
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
 
-		actual, err := service.Update(ctx, vehicleID, entity.Vehicle{})
+		actual, err := service.Update(ctx, vehicleID, 1, patch)
 
 		assert.Nil(t, actual)
 		assert.Equal(t, unexpectedError, err)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
 	})
 
 	t.Run("should update vehicle successfully", func(t *testing.T) {
-		vehicle := entity.Vehicle{}
+		vehicle := entity.Vehicle{EntityID: vehicleID, Color: "Black", Version: 1}
+		vehicle.Slug = vehicleSlug(vehicle)
+
+		patched := entity.Vehicle{EntityID: vehicleID, Color: "Blue", Version: 1}
+		patched.Slug = vehicleSlug(patched)
 
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
 
 		vehicleRepositoryMocked.On("GetByID", ctx, vehicleID).
 			Return(&vehicle, nil)
 
-		vehicleRepositoryMocked.On("Update", ctx, vehicleID, entity.Vehicle{}).
-			Return(&entity.Vehicle{}, nil)
+		// The slug follows the color it names.
+		vehicleRepositoryMocked.On("Update", ctx, vehicleID, patched).
+			Return(&entity.Vehicle{Color: "Blue", Version: 2}, nil)
 
-		service := NewVehicleService(vehicleRepositoryMocked, nil, nil)
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
 
-		actual, err := service.Update(ctx, vehicleID, entity.Vehicle{})
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
 
-		assert.NotNil(t, actual)
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Update(ctx, vehicleID, 1, patch)
+
+		assert.Equal(t, &entity.Vehicle{Color: "Blue", Version: 2}, actual)
 		assert.Nil(t, err)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 0)
 	})
 }
 
 func TestBuy(t *testing.T) {
 	ctx := context.TODO()
+	publishedAt := time.Now().Add(-time.Hour)
 	entityID := uuid.NewString()
 	paymentID := uuid.NewString()
 	buyerDocumentNumber := uuid.NewString()
@@ -209,53 +605,72 @@ func TestBuy(t *testing.T) {
 
 	t.Run("should not buy vehicle when failed to get vehicle by id", func(t *testing.T) {
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
 		saleRepositoryMocked := mocks.NewSaleRepository(t)
 		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
 
 		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
 			Return(nil, unexpectedError)
 
-		service := NewVehicleService(vehicleRepositoryMocked, nil, nil)
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
 
-		actual, err := service.Buy(ctx, entityID, buyerDocumentNumber)
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
 
 		assert.Nil(t, actual)
 		assert.Equal(t, unexpectedError, err)
 		saleRepositoryMocked.AssertNumberOfCalls(t, "Create", 0)
 		vehiclePlatformPaymentsAdapterMocked.AssertNumberOfCalls(t, "GeneratePayment", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
 	})
 
 	t.Run("should not buy vehicle when vehicle does not exist", func(t *testing.T) {
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
 		saleRepositoryMocked := mocks.NewSaleRepository(t)
 		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
 
 		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
 			Return(nil, nil)
 
-		service := NewVehicleService(vehicleRepositoryMocked, nil, nil)
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
 
-		actual, err := service.Buy(ctx, entityID, buyerDocumentNumber)
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
 
 		assert.Nil(t, actual)
 		assert.Nil(t, err)
 		saleRepositoryMocked.AssertNumberOfCalls(t, "Create", 0)
 		vehiclePlatformPaymentsAdapterMocked.AssertNumberOfCalls(t, "GeneratePayment", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 0)
 	})
 
 	t.Run("should not buy vehicle when failed to check sales for this vehicle", func(t *testing.T) {
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
 		saleRepositoryMocked := mocks.NewSaleRepository(t)
 		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
 
 		vehicle := &entity.Vehicle{
-			ID:       1,
-			EntityID: entityID,
-			Brand:    "Some Brand",
-			Model:    "Some Model",
-			Year:     2000,
-			Color:    "Black",
-			Price:    20000,
+			ID:          1,
+			EntityID:    entityID,
+			Brand:       "Some Brand",
+			Model:       "Some Model",
+			Year:        2000,
+			Color:       "Black",
+			Price:       20000,
+			Status:      valueobjects.ListingStatusPublished,
+			PublishedAt: &publishedAt,
 		}
 
 		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
@@ -264,29 +679,39 @@ func TestBuy(t *testing.T) {
 		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
 			Return(nil, unexpectedError)
 
-		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil)
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
 
-		actual, err := service.Buy(ctx, entityID, buyerDocumentNumber)
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
 
 		assert.Nil(t, actual)
 		assert.Equal(t, unexpectedError, err)
 		saleRepositoryMocked.AssertNumberOfCalls(t, "Create", 0)
 		vehiclePlatformPaymentsAdapterMocked.AssertNumberOfCalls(t, "GeneratePayment", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
 	})
 
 	t.Run("should not buy vehicle when vehicle already sold", func(t *testing.T) {
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
 		saleRepositoryMocked := mocks.NewSaleRepository(t)
 		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
 
 		vehicle := &entity.Vehicle{
-			ID:       1,
-			EntityID: entityID,
-			Brand:    "Some Brand",
-			Model:    "Some Model",
-			Year:     2000,
-			Color:    "Black",
-			Price:    20000,
+			ID:          1,
+			EntityID:    entityID,
+			Brand:       "Some Brand",
+			Model:       "Some Model",
+			Year:        2000,
+			Color:       "Black",
+			Price:       20000,
+			Status:      valueobjects.ListingStatusPublished,
+			PublishedAt: &publishedAt,
 		}
 
 		sale := &entity.Sale{
@@ -295,7 +720,7 @@ func TestBuy(t *testing.T) {
 			PaymentID:           paymentID,
 			BuyerDocumentNumber: buyerDocumentNumber,
 			Price:               10000,
-			Status:              "APPROVED",
+			Status:              valueobjects.SaleStatusTypeApproved,
 		}
 
 		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
@@ -304,49 +729,80 @@ func TestBuy(t *testing.T) {
 		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
 			Return(sale, nil)
 
-		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil)
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
 
-		actual, err := service.Buy(ctx, entityID, buyerDocumentNumber)
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
 
 		assert.Nil(t, actual)
 		assert.ErrorContains(t, err, "vehicle already sold")
 		saleRepositoryMocked.AssertNumberOfCalls(t, "Create", 0)
 		vehiclePlatformPaymentsAdapterMocked.AssertNumberOfCalls(t, "GeneratePayment", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
 	})
 
-	t.Run("should not buy vehicle when failed to generate payment", func(t *testing.T) {
+	t.Run("should not generate payment when vehicle already has a pending sale", func(t *testing.T) {
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
 		saleRepositoryMocked := mocks.NewSaleRepository(t)
 		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
 
 		vehicle := &entity.Vehicle{
-			Price: 10000,
+			ID:          1,
+			EntityID:    entityID,
+			Price:       20000,
+			Status:      valueobjects.ListingStatusPublished,
+			PublishedAt: &publishedAt,
+		}
+
+		sale := &entity.Sale{
+			ID:                  1,
+			EntityID:            entityID,
+			PaymentID:           paymentID,
+			BuyerDocumentNumber: buyerDocumentNumber,
+			Price:               20000,
+			Status:              valueobjects.SaleStatusTypePending,
 		}
 
 		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
 			Return(vehicle, nil)
 
 		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
-			Return(nil, nil)
+			Return(sale, nil)
 
-		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", ctx, vehicle.Price, "APPROVED").
-			Return("", unexpectedError)
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
 
-		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, vehiclePlatformPaymentsAdapterMocked)
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
 
-		actual, err := service.Buy(ctx, entityID, buyerDocumentNumber)
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
 
 		assert.Nil(t, actual)
-		assert.Equal(t, unexpectedError, err)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidState)
 		saleRepositoryMocked.AssertNumberOfCalls(t, "Create", 0)
+		vehiclePlatformPaymentsAdapterMocked.AssertNumberOfCalls(t, "GeneratePayment", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
 	})
 
-	t.Run("should not buy vehicle when failed to create sale", func(t *testing.T) {
+	t.Run("should cancel reservation when failed to generate payment", func(t *testing.T) {
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
 		saleRepositoryMocked := mocks.NewSaleRepository(t)
 		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
 
-		vehicle := &entity.Vehicle{}
+		vehicle := &entity.Vehicle{
+			Price:       10000,
+			Status:      valueobjects.ListingStatusPublished,
+			PublishedAt: &publishedAt,
+		}
 
 		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
 			Return(vehicle, nil)
@@ -354,26 +810,42 @@ func TestBuy(t *testing.T) {
 		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
 			Return(nil, nil)
 
-		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", ctx, vehicle.Price, "APPROVED").
-			Return(paymentID, nil)
+		saleRepositoryMocked.On("Create", ctx, mock.MatchedBy(func(sale entity.Sale) bool {
+			return sale.PaymentID == ""
+		})).
+			Return(&entity.Sale{ID: 1, EntityID: entityID}, nil)
 
-		saleRepositoryMocked.On("Create", ctx, mock.AnythingOfType("entity.Sale")).
-			Return(nil, unexpectedError)
+		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", ctx, entity.Payment{Amount: vehicle.Price, Status: "APPROVED"}).
+			Return("", unexpectedError)
+
+		saleRepositoryMocked.On("DeleteReservation", mock.Anything, 1).
+			Return(&entity.Sale{ID: 1, EntityID: entityID}, nil)
+
+		txManagerMocked.On("Begin", mock.Anything).
+			Return(func(ctx context.Context) context.Context { return ctx }, nil)
 
-		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, vehiclePlatformPaymentsAdapterMocked)
+		txManagerMocked.On("Commit", mock.Anything).
+			Return(nil)
 
-		actual, err := service.Buy(ctx, entityID, buyerDocumentNumber)
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
 
 		assert.Nil(t, actual)
 		assert.Equal(t, unexpectedError, err)
+		saleRepositoryMocked.AssertNumberOfCalls(t, "DeleteReservation", 1)
+		saleRepositoryMocked.AssertNumberOfCalls(t, "AttachPayment", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 2)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 0)
 	})
 
-	t.Run("should buy vehicle successfully", func(t *testing.T) {
+	t.Run("should not buy vehicle when failed to create sale", func(t *testing.T) {
 		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
 		saleRepositoryMocked := mocks.NewSaleRepository(t)
 		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
 
-		vehicle := &entity.Vehicle{}
+		vehicle := &entity.Vehicle{Status: valueobjects.ListingStatusPublished, PublishedAt: &publishedAt}
 
 		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
 			Return(vehicle, nil)
@@ -381,17 +853,894 @@ func TestBuy(t *testing.T) {
 		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
 			Return(nil, nil)
 
-		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", ctx, vehicle.Price, "APPROVED").
-			Return(paymentID, nil)
-
 		saleRepositoryMocked.On("Create", ctx, mock.AnythingOfType("entity.Sale")).
-			Return(nil, nil)
+			Return(nil, unexpectedError)
 
-		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, vehiclePlatformPaymentsAdapterMocked)
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
 
-		actual, err := service.Buy(ctx, entityID, buyerDocumentNumber)
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+		vehiclePlatformPaymentsAdapterMocked.AssertNumberOfCalls(t, "GeneratePayment", 0)
+		txManagerMocked.AssertNumberOfCalls(t, "Commit", 0)
+	})
+
+	t.Run("should buy vehicle successfully", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		vehicle := &entity.Vehicle{DealerID: 3, Status: valueobjects.ListingStatusPublished, PublishedAt: &publishedAt}
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(vehicle, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+			Return(nil, nil)
+
+		saleRepositoryMocked.On("Create", ctx, mock.MatchedBy(func(sale entity.Sale) bool {
+			return sale.DealerID == vehicle.DealerID && sale.PaymentID == ""
+		})).
+			Return(&entity.Sale{ID: 1, EntityID: entityID}, nil)
+
+		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", ctx, entity.Payment{Amount: vehicle.Price, Status: "APPROVED"}).
+			Return(paymentID, nil)
+
+		saleRepositoryMocked.On("AttachPayment", ctx, 1, paymentID).
+			Return(&entity.Sale{ID: 1, EntityID: entityID, PaymentID: paymentID}, nil)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
+
+		assert.NotNil(t, actual)
+		assert.Nil(t, err)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 0)
+	})
+
+	t.Run("should buy vehicle again after its sale was rejected", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		vehicle := &entity.Vehicle{DealerID: 3, Price: 20000, Status: valueobjects.ListingStatusPublished, PublishedAt: &publishedAt}
+
+		rejected := &entity.Sale{
+			ID:       1,
+			EntityID: entityID,
+			Price:    20000,
+			Status:   valueobjects.SaleStatusTypeRejected,
+		}
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(vehicle, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+			Return(rejected, nil)
+
+		saleRepositoryMocked.On("DeleteRejected", ctx, rejected.ID).
+			Return(rejected, nil)
+
+		saleRepositoryMocked.On("Create", ctx, mock.MatchedBy(func(sale entity.Sale) bool {
+			return sale.PaymentID == "" && sale.Status == valueobjects.SaleStatusTypePending
+		})).
+			Return(&entity.Sale{ID: 2, EntityID: entityID}, nil)
+
+		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", ctx, entity.Payment{Amount: vehicle.Price, Status: "APPROVED"}).
+			Return(paymentID, nil)
+
+		saleRepositoryMocked.On("AttachPayment", ctx, 2, paymentID).
+			Return(&entity.Sale{ID: 2, EntityID: entityID, PaymentID: paymentID}, nil)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
+
+		assert.NotNil(t, actual)
+		assert.Nil(t, err)
+		txManagerMocked.AssertNumberOfCalls(t, "Rollback", 0)
+	})
+
+	t.Run("should not buy vehicle when failed to begin transaction", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(nil, unexpectedError)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+		vehicleRepositoryMocked.AssertNumberOfCalls(t, "GetByID", 0)
+	})
+
+	t.Run("should not buy vehicle when failed to commit transaction", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		vehicle := &entity.Vehicle{Status: valueobjects.ListingStatusPublished, PublishedAt: &publishedAt}
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(vehicle, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+			Return(nil, nil)
+
+		saleRepositoryMocked.On("Create", ctx, mock.AnythingOfType("entity.Sale")).
+			Return(&entity.Sale{ID: 1, EntityID: entityID}, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(unexpectedError)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+		vehiclePlatformPaymentsAdapterMocked.AssertNumberOfCalls(t, "GeneratePayment", 0)
+	})
+
+	t.Run("should not find vehicle that is not published", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(&entity.Vehicle{EntityID: entityID, Status: valueobjects.ListingStatusDraft}, nil)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber})
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+		saleRepositoryMocked.AssertNumberOfCalls(t, "GetByEntityID", 0)
+	})
+
+	validUntil := time.Now().Add(-time.Minute)
+
+	couponVehicle := entity.Vehicle{EntityID: entityID, DealerID: 3, Brand: "Fiat", Year: 2020, Price: 80000, Status: valueobjects.ListingStatusPublished, PublishedAt: &publishedAt}
+	coupon := entity.Coupon{ID: 7, DealerID: 3, Code: "BLACKFRIDAY", DiscountType: valueobjects.DiscountTypePercentage, DiscountValue: 10}
+
+	refusedCoupons := map[string]*entity.Coupon{
+		"does not exist":            nil,
+		"is expired":                {ID: 7, Code: "BLACKFRIDAY", DiscountType: valueobjects.DiscountTypeFixed, DiscountValue: 1000, ValidUntil: &validUntil},
+		"is for another brand":      {ID: 7, Code: "BLACKFRIDAY", DiscountType: valueobjects.DiscountTypeFixed, DiscountValue: 1000, Brand: "Ford"},
+		"discounts the whole price": {ID: 7, Code: "BLACKFRIDAY", DiscountType: valueobjects.DiscountTypePercentage, DiscountValue: 100},
+	}
+
+	for name, refused := range refusedCoupons {
+		t.Run("should not buy vehicle with coupon that "+name, func(t *testing.T) {
+			vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+			txManagerMocked := mocks.NewTxManager(t)
+			saleRepositoryMocked := mocks.NewSaleRepository(t)
+			couponRepositoryMocked := mocks.NewCouponRepository(t)
+
+			vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+				Return(&couponVehicle, nil)
+
+			saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+				Return(nil, nil)
+
+			couponRepositoryMocked.On("GetByCode", ctx, 3, valueobjects.CouponCode("BLACKFRIDAY")).
+				Return(refused, nil)
+
+			txManagerMocked.On("Begin", ctx).
+				Return(ctx, nil)
+
+			txManagerMocked.On("Rollback", ctx).
+				Return(nil)
+
+			service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, couponRepositoryMocked, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+			actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber, CouponCode: "blackfriday"})
+
+			assert.Nil(t, actual)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+			couponRepositoryMocked.AssertNumberOfCalls(t, "Redeem", 0)
+		})
+	}
+
+	t.Run("should not buy vehicle with coupon that has no uses left", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		couponRepositoryMocked := mocks.NewCouponRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(&couponVehicle, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+			Return(nil, nil)
+
+		couponRepositoryMocked.On("GetByCode", ctx, 3, coupon.Code).
+			Return(&coupon, nil)
+
+		couponRepositoryMocked.On("Redeem", ctx, coupon.ID).
+			Return(nil, nil)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, couponRepositoryMocked, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber, CouponCode: "BLACKFRIDAY"})
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+		vehiclePlatformPaymentsAdapterMocked.AssertNumberOfCalls(t, "GeneratePayment", 0)
+	})
+
+	t.Run("should buy vehicle with coupon paying the discounted price", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		couponRepositoryMocked := mocks.NewCouponRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(&couponVehicle, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+			Return(nil, nil)
+
+		couponRepositoryMocked.On("GetByCode", ctx, 3, coupon.Code).
+			Return(&coupon, nil)
+
+		redeemed := coupon
+		redeemed.Uses = 1
+
+		couponRepositoryMocked.On("Redeem", ctx, coupon.ID).
+			Return(&redeemed, nil)
+
+		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", ctx, entity.Payment{Amount: 72000.0, Status: "APPROVED"}).
+			Return(paymentID, nil)
+
+		saleRepositoryMocked.On("Create", ctx, entity.Sale{
+			EntityID:            entityID,
+			DealerID:            3,
+			BuyerDocumentNumber: buyerDocumentNumber,
+			ListPrice:           80000,
+			Discount:            8000,
+			Price:               72000,
+			CouponCode:          "BLACKFRIDAY",
+			Status:              valueobjects.SaleStatusTypePending,
+		}).
+			Return(&entity.Sale{ID: 1}, nil)
+
+		saleRepositoryMocked.On("AttachPayment", ctx, 1, paymentID).
+			Return(&entity.Sale{ID: 1, PaymentID: paymentID}, nil)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, couponRepositoryMocked, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber, CouponCode: "BLACKFRIDAY"})
+
+		assert.Nil(t, err)
+		assert.Equal(t, entityID, actual.EntityID)
+	})
+
+	t.Run("should give coupon use back when failed to generate payment", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		couponRepositoryMocked := mocks.NewCouponRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(&couponVehicle, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+			Return(nil, nil)
+
+		couponRepositoryMocked.On("GetByCode", ctx, 3, coupon.Code).
+			Return(&coupon, nil)
+
+		redeemed := coupon
+		redeemed.Uses = 1
+
+		couponRepositoryMocked.On("Redeem", ctx, coupon.ID).
+			Return(&redeemed, nil)
+
+		saleRepositoryMocked.On("Create", ctx, mock.AnythingOfType("entity.Sale")).
+			Return(&entity.Sale{ID: 1, EntityID: entityID}, nil)
+
+		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", ctx, entity.Payment{Amount: 72000.0, Status: "APPROVED"}).
+			Return("", unexpectedError)
+
+		saleRepositoryMocked.On("DeleteReservation", mock.Anything, 1).
+			Return(&entity.Sale{ID: 1, EntityID: entityID}, nil)
+
+		couponRepositoryMocked.On("Release", mock.Anything, coupon.ID).
+			Return(&coupon, nil)
+
+		txManagerMocked.On("Begin", mock.Anything).
+			Return(func(ctx context.Context) context.Context { return ctx }, nil)
+
+		txManagerMocked.On("Commit", mock.Anything).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, couponRepositoryMocked, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber, CouponCode: "BLACKFRIDAY"})
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+		couponRepositoryMocked.AssertNumberOfCalls(t, "Release", 1)
+	})
+
+	staffCtx := session.WithPrincipal(ctx, entity.Principal{Subject: "api_key:3", DealerID: 3, Roles: []valueobjects.Role{valueobjects.RoleDealerStaff}})
+	buyerCtx := session.WithPrincipal(ctx, entity.Principal{Subject: "api_key:2", Roles: []valueobjects.Role{valueobjects.RoleBuyer}})
+
+	tradeIn := entity.TradeIn{Brand: "Ford", Model: "Ka", Year: 2015, Color: "Red", LicensePlate: "abc-1d23", AppraisedValue: 20000}
+
+	refusedTradeIns := map[string]struct {
+		ctx      context.Context
+		tradeIn  entity.TradeIn
+		expected error
+	}{
+		"offered by the buyer":         {buyerCtx, tradeIn, domainerrors.ErrPermissionDenied},
+		"without model":                {staffCtx, entity.TradeIn{Brand: "Ford", Year: 2015, Color: "Red", AppraisedValue: 20000}, domainerrors.ErrInvalidArgument},
+		"appraised at the whole price": {staffCtx, entity.TradeIn{Brand: "Ford", Model: "Ka", Year: 2015, Color: "Red", AppraisedValue: 80000}, domainerrors.ErrInvalidArgument},
+		"with invalid license plate":   {staffCtx, entity.TradeIn{Brand: "Ford", Model: "Ka", Year: 2015, Color: "Red", LicensePlate: "A", AppraisedValue: 20000}, domainerrors.ErrInvalidArgument},
+	}
+
+	for name, refused := range refusedTradeIns {
+		t.Run("should not buy vehicle with trade-in "+name, func(t *testing.T) {
+			vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+			txManagerMocked := mocks.NewTxManager(t)
+			saleRepositoryMocked := mocks.NewSaleRepository(t)
+			vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+			vehicleRepositoryMocked.On("GetByID", refused.ctx, entityID).
+				Return(&couponVehicle, nil)
+
+			saleRepositoryMocked.On("GetByEntityID", refused.ctx, entityID).
+				Return(nil, nil)
+
+			txManagerMocked.On("Begin", refused.ctx).
+				Return(refused.ctx, nil)
+
+			txManagerMocked.On("Rollback", refused.ctx).
+				Return(nil)
+
+			service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+			tradeIn := refused.tradeIn
+			actual, err := service.Buy(refused.ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber, TradeIn: &tradeIn})
+
+			assert.Nil(t, actual)
+			assert.ErrorIs(t, err, refused.expected)
+			vehiclePlatformPaymentsAdapterMocked.AssertNumberOfCalls(t, "GeneratePayment", 0)
+		})
+	}
+
+	t.Run("should not buy vehicle with trade-in whose vin is already registered", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		vehicleRepositoryMocked.On("GetByID", staffCtx, entityID).
+			Return(&couponVehicle, nil)
+
+		vehicleRepositoryMocked.On("ExistsByVIN", staffCtx, valueobjects.VIN("9BWZZZ377VT004251")).
+			Return(true, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", staffCtx, entityID).
+			Return(nil, nil)
+
+		txManagerMocked.On("Begin", staffCtx).
+			Return(staffCtx, nil)
+
+		txManagerMocked.On("Rollback", staffCtx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		registered := tradeIn
+		registered.VIN = "9bwzzz377vt004251"
+
+		actual, err := service.Buy(staffCtx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber, TradeIn: &registered})
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+		vehiclePlatformPaymentsAdapterMocked.AssertNumberOfCalls(t, "GeneratePayment", 0)
+		saleRepositoryMocked.AssertNumberOfCalls(t, "Create", 0)
+	})
+
+	t.Run("should buy vehicle with trade-in paying the remaining balance", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		vehicleRepositoryMocked.On("GetByID", staffCtx, entityID).
+			Return(&couponVehicle, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", staffCtx, entityID).
+			Return(nil, nil)
+
+		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", staffCtx, entity.Payment{Amount: 60000.0, Status: "APPROVED"}).
+			Return(paymentID, nil)
+
+		appraised := tradeIn
+		appraised.LicensePlate = "ABC1D23"
+		appraised.AppraisedBy = "api_key:3"
+
+		saleRepositoryMocked.On("Create", staffCtx, entity.Sale{
+			EntityID:            entityID,
+			DealerID:            3,
+			BuyerDocumentNumber: buyerDocumentNumber,
+			ListPrice:           80000,
+			Price:               80000,
+			TradeIn:             &appraised,
+			Status:              valueobjects.SaleStatusTypePending,
+		}).
+			Return(&entity.Sale{ID: 1}, nil)
+
+		saleRepositoryMocked.On("AttachPayment", staffCtx, 1, paymentID).
+			Return(&entity.Sale{ID: 1, PaymentID: paymentID}, nil)
+
+		txManagerMocked.On("Begin", staffCtx).
+			Return(staffCtx, nil)
+
+		txManagerMocked.On("Commit", staffCtx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(staffCtx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber, TradeIn: &tradeIn})
+
+		assert.Nil(t, err)
+		assert.Equal(t, entityID, actual.EntityID)
+	})
+
+	plan := entity.FinancingPlan{ID: 5, DealerID: 3, AmortizationSystem: valueobjects.AmortizationSystemPrice, MonthlyInterestRate: 1, MaxMonths: 12, MinDownPaymentPercentage: 20}
+
+	refusedFinancings := map[string]struct {
+		plan   *entity.FinancingPlan
+		choice entity.FinancingChoice
+	}{
+		"with plan that does not exist":         {nil, entity.FinancingChoice{PlanID: 5, DownPayment: 20000, Months: 12}},
+		"with plan of another dealer":           {&entity.FinancingPlan{ID: 5, DealerID: 4, MaxMonths: 12}, entity.FinancingChoice{PlanID: 5, DownPayment: 20000, Months: 12}},
+		"in more months than the plan takes":    {&plan, entity.FinancingChoice{PlanID: 5, DownPayment: 20000, Months: 24}},
+		"with down payment below the minimum":   {&plan, entity.FinancingChoice{PlanID: 5, DownPayment: 10000, Months: 12}},
+		"with down payment of the whole amount": {&plan, entity.FinancingChoice{PlanID: 5, DownPayment: 80000, Months: 12}},
+	}
+
+	for name, refused := range refusedFinancings {
+		t.Run("should not buy vehicle financed "+name, func(t *testing.T) {
+			vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+			txManagerMocked := mocks.NewTxManager(t)
+			saleRepositoryMocked := mocks.NewSaleRepository(t)
+			financingPlanRepositoryMocked := mocks.NewFinancingPlanRepository(t)
+			vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+			vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+				Return(&couponVehicle, nil)
+
+			saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+				Return(nil, nil)
+
+			financingPlanRepositoryMocked.On("GetByID", ctx, 5).
+				Return(refused.plan, nil)
+
+			txManagerMocked.On("Begin", ctx).
+				Return(ctx, nil)
+
+			txManagerMocked.On("Rollback", ctx).
+				Return(nil)
+
+			service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, financingPlanRepositoryMocked, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+			choice := refused.choice
+			actual, err := service.Buy(ctx, entityID, entity.Purchase{BuyerDocumentNumber: buyerDocumentNumber, Financing: &choice})
+
+			assert.Nil(t, actual)
+			assert.ErrorIs(t, err, domainerrors.ErrInvalidArgument)
+			vehiclePlatformPaymentsAdapterMocked.AssertNumberOfCalls(t, "GeneratePayment", 0)
+		})
+	}
+
+	t.Run("should buy financed vehicle sending its installments", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		financingPlanRepositoryMocked := mocks.NewFinancingPlanRepository(t)
+		vehiclePlatformPaymentsAdapterMocked := mocks.NewVehiclePlatformPaymentsAdapter(t)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(&couponVehicle, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+			Return(nil, nil)
+
+		financingPlanRepositoryMocked.On("GetByID", ctx, 5).
+			Return(&plan, nil)
+
+		var payment entity.Payment
+
+		vehiclePlatformPaymentsAdapterMocked.On("GeneratePayment", ctx, mock.Anything).
+			Run(func(args mock.Arguments) { payment = args.Get(1).(entity.Payment) }).
+			Return(paymentID, nil)
+
+		saleRepositoryMocked.On("Create", ctx, entity.Sale{
+			EntityID:            entityID,
+			DealerID:            3,
+			BuyerDocumentNumber: buyerDocumentNumber,
+			ListPrice:           80000,
+			Price:               80000,
+			Financing: &entity.Financing{
+				PlanID:              5,
+				AmortizationSystem:  valueobjects.AmortizationSystemPrice,
+				MonthlyInterestRate: 1,
+				DownPayment:         20000,
+				Principal:           60000,
+				Months:              12,
+			},
+			Status: valueobjects.SaleStatusTypePending,
+		}).
+			Return(&entity.Sale{ID: 1}, nil)
+
+		saleRepositoryMocked.On("CreateInstallments", ctx, 1, mock.Anything).
+			Return(nil, nil)
+
+		saleRepositoryMocked.On("AttachPayment", ctx, 1, paymentID).
+			Return(&entity.Sale{ID: 1, PaymentID: paymentID}, nil)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, financingPlanRepositoryMocked, nil, nil, vehiclePlatformPaymentsAdapterMocked, txManagerMocked, time.Now)
+
+		actual, err := service.Buy(ctx, entityID, entity.Purchase{
+			BuyerDocumentNumber: buyerDocumentNumber,
+			Financing:           &entity.FinancingChoice{PlanID: 5, DownPayment: 20000, Months: 12},
+		})
+
+		assert.Nil(t, err)
+		assert.Equal(t, entityID, actual.EntityID)
+		assert.Equal(t, 80000.0, payment.Amount)
+		assert.Equal(t, 20000.0, payment.DownPayment)
+		assert.Len(t, payment.Installments, 12)
+		assert.Equal(t, 5330.93, payment.Installments[0].Amount)
+		saleRepositoryMocked.AssertCalled(t, "CreateInstallments", ctx, 1, payment.Installments)
+	})
+}
+
+func TestPublish(t *testing.T) {
+	ctx := context.TODO()
+	entityID := uuid.NewString()
+	now := time.Now().UTC()
+	timeGenerator := func() time.Time { return now }
+
+	t.Run("should return nil when vehicle does not exist", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(nil, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, timeGenerator)
+
+		actual, err := service.Publish(ctx, entityID, nil)
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should not publish vehicle missing the checklist items", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		mediaRepositoryMocked := mocks.NewVehicleMediaRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(&entity.Vehicle{EntityID: entityID, Status: valueobjects.ListingStatusDraft}, nil)
+
+		mediaRepositoryMocked.On("SearchByVehicleIDs", ctx, []string{entityID}).
+			Return([]entity.VehicleMedia{}, nil)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, mediaRepositoryMocked, nil, nil, txManagerMocked, timeGenerator)
+
+		actual, err := service.Publish(ctx, entityID, nil)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrIncomplete)
+		assert.ErrorContains(t, err, "missing price, photos")
+		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+	})
+
+	t.Run("should publish vehicle right away or at the scheduled time", func(t *testing.T) {
+		past := now.Add(-time.Hour)
+		future := now.Add(24 * time.Hour)
+
+		for _, tc := range []struct {
+			publishAt *time.Time
+			expected  time.Time
+		}{
+			{publishAt: nil, expected: now},
+			{publishAt: &past, expected: now},
+			{publishAt: &future, expected: future},
+		} {
+			vehicle := entity.Vehicle{EntityID: entityID, Price: 80000, Status: valueobjects.ListingStatusDraft, Version: 1}
+
+			vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+			mediaRepositoryMocked := mocks.NewVehicleMediaRepository(t)
+			txManagerMocked := mocks.NewTxManager(t)
+
+			txManagerMocked.On("Begin", ctx).
+				Return(ctx, nil)
+
+			vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+				Return(&vehicle, nil)
+
+			mediaRepositoryMocked.On("SearchByVehicleIDs", ctx, []string{entityID}).
+				Return([]entity.VehicleMedia{{VehicleID: entityID}}, nil)
+
+			vehicleRepositoryMocked.On("Update", ctx, entityID, mock.MatchedBy(func(published entity.Vehicle) bool {
+				return published.Status == valueobjects.ListingStatusPublished && published.PublishedAt.Equal(tc.expected) && published.Version == 1
+			})).
+				Return(func(_ context.Context, _ string, published entity.Vehicle) (*entity.Vehicle, error) {
+					return &published, nil
+				})
+
+			txManagerMocked.On("Commit", ctx).
+				Return(nil)
+
+			service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, mediaRepositoryMocked, nil, nil, txManagerMocked, timeGenerator)
+
+			actual, err := service.Publish(ctx, entityID, tc.publishAt)
+
+			assert.Nil(t, err)
+			assert.Equal(t, valueobjects.ListingStatusPublished, actual.Status)
+			assert.Equal(t, tc.expected, *actual.PublishedAt)
+		}
+	})
+}
+
+func TestUnpublish(t *testing.T) {
+	ctx := context.TODO()
+	entityID := uuid.NewString()
+
+	t.Run("should unlist published vehicle", func(t *testing.T) {
+		publishedAt := time.Now()
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(&entity.Vehicle{EntityID: entityID, Status: valueobjects.ListingStatusPublished, PublishedAt: &publishedAt, Version: 2}, nil)
+
+		vehicleRepositoryMocked.On("Update", ctx, entityID, entity.Vehicle{EntityID: entityID, Status: valueobjects.ListingStatusUnlisted, Version: 2}).
+			Return(&entity.Vehicle{EntityID: entityID, Status: valueobjects.ListingStatusUnlisted, Version: 3}, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Unpublish(ctx, entityID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, &entity.Vehicle{EntityID: entityID, Status: valueobjects.ListingStatusUnlisted, Version: 3}, actual)
+	})
+
+	t.Run("should leave draft as it is", func(t *testing.T) {
+		vehicle := &entity.Vehicle{EntityID: entityID, Status: valueobjects.ListingStatusDraft, Version: 1}
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(vehicle, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Unpublish(ctx, entityID)
+
+		assert.Nil(t, err)
+		assert.Equal(t, vehicle, actual)
+		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Update", 0)
+	})
+}
+
+func TestDelete(t *testing.T) {
+	ctx := context.TODO()
+	entityID := uuid.NewString()
+	unexpectedError := errors.New("unexpected error")
+
+	t.Run("should return nil when vehicle does not exist", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(nil, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, nil, nil, nil, nil, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Delete(ctx, entityID)
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Delete", 0)
+	})
+
+	t.Run("should not delete vehicle with a sale", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		mediaRepositoryMocked := mocks.NewVehicleMediaRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(&entity.Vehicle{EntityID: entityID}, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+			Return(&entity.Sale{EntityID: entityID}, nil)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, mediaRepositoryMocked, nil, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Delete(ctx, entityID)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrInUse)
+		mediaRepositoryMocked.AssertNumberOfCalls(t, "DeleteByVehicleID", 0)
+		vehicleRepositoryMocked.AssertNumberOfCalls(t, "Delete", 0)
+	})
+
+	t.Run("should keep media files when failed to delete vehicle", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		mediaRepositoryMocked := mocks.NewVehicleMediaRepository(t)
+		mediaStorageMocked := mocks.NewMediaStorage(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(&entity.Vehicle{EntityID: entityID}, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+			Return(nil, nil)
+
+		mediaRepositoryMocked.On("DeleteByVehicleID", ctx, entityID).
+			Return([]entity.VehicleMedia{{StorageKey: "photo.jpg", ThumbnailKey: "photo_thumbnail.jpg"}}, nil)
+
+		vehicleRepositoryMocked.On("Delete", ctx, entityID).
+			Return(nil, unexpectedError)
+
+		txManagerMocked.On("Rollback", ctx).
+			Return(nil)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, mediaRepositoryMocked, mediaStorageMocked, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Delete(ctx, entityID)
+
+		assert.Nil(t, actual)
+		assert.Equal(t, unexpectedError, err)
+		mediaStorageMocked.AssertNumberOfCalls(t, "Delete", 0)
+	})
+
+	t.Run("should delete vehicle and the files of its media", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+		mediaRepositoryMocked := mocks.NewVehicleMediaRepository(t)
+		mediaStorageMocked := mocks.NewMediaStorage(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		vehicle := &entity.Vehicle{EntityID: entityID}
+
+		txManagerMocked.On("Begin", ctx).
+			Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", ctx, entityID).
+			Return(vehicle, nil)
+
+		saleRepositoryMocked.On("GetByEntityID", ctx, entityID).
+			Return(nil, nil)
+
+		mediaRepositoryMocked.On("DeleteByVehicleID", ctx, entityID).
+			Return([]entity.VehicleMedia{{StorageKey: "photo.jpg", ThumbnailKey: "photo_thumbnail.jpg"}}, nil)
+
+		vehicleRepositoryMocked.On("Delete", ctx, entityID).
+			Return(vehicle, nil)
+
+		txManagerMocked.On("Commit", ctx).
+			Return(nil)
+
+		mediaStorageMocked.On("Delete", ctx, "photo.jpg").
+			Return(nil)
+
+		mediaStorageMocked.On("Delete", ctx, "photo_thumbnail.jpg").
+			Return(unexpectedError)
+
+		service := NewVehicleService(vehicleRepositoryMocked, saleRepositoryMocked, nil, nil, mediaRepositoryMocked, mediaStorageMocked, nil, txManagerMocked, time.Now)
+
+		actual, err := service.Delete(ctx, entityID)
 
-		assert.NotNil(t, actual)
 		assert.Nil(t, err)
+		assert.Equal(t, vehicle, actual)
 	})
 }
@@ -0,0 +1,116 @@
+package health
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusUp   = "up"
+	StatusDown = "down"
+)
+
+type CheckFunc func(ctx context.Context) error
+
+type CheckResult struct {
+	Status   string `json:"status"`
+	Duration string `json:"duration"`
+	Error    string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status string                 `json:"status"`
+	Checks map[string]CheckResult `json:"checks"`
+}
+
+func (ref Report) IsUp() bool {
+	return ref.Status == StatusUp
+}
+
+type namedCheck struct {
+	name string
+	fn   CheckFunc
+}
+
+// Checker runs the readiness checks of the service dependencies. Every check
+// runs concurrently and is bounded by the same timeout.
+type Checker struct {
+	timeout  time.Duration
+	checks   []namedCheck
+	draining atomic.Bool
+}
+
+func NewChecker(timeout time.Duration) *Checker {
+	return &Checker{
+		timeout: timeout,
+	}
+}
+
+func (ref *Checker) Add(name string, fn CheckFunc) {
+	ref.checks = append(ref.checks, namedCheck{name: name, fn: fn})
+}
+
+// SetDraining makes the service report itself as not ready, so the load
+// balancer stops routing new requests while in-flight ones finish.
+func (ref *Checker) SetDraining() {
+	ref.draining.Store(true)
+}
+
+func (ref *Checker) Check(ctx context.Context) Report {
+	report := Report{
+		Status: StatusUp,
+		Checks: make(map[string]CheckResult, len(ref.checks)),
+	}
+
+	if ref.draining.Load() {
+		report.Status = StatusDown
+		report.Checks["shutdown"] = CheckResult{
+			Status:   StatusDown,
+			Duration: "0s",
+			Error:    "server is shutting down",
+		}
+	}
+
+	var (
+		mutex sync.Mutex
+		wg    sync.WaitGroup
+	)
+
+	for _, check := range ref.checks {
+		wg.Add(1)
+
+		go func(check namedCheck) {
+			defer wg.Done()
+
+			checkCtx, cancel := context.WithTimeout(ctx, ref.timeout)
+			defer cancel()
+
+			startedAt := time.Now()
+			err := check.fn(checkCtx)
+
+			result := CheckResult{
+				Status:   StatusUp,
+				Duration: time.Since(startedAt).Round(time.Microsecond).String(),
+			}
+
+			if err != nil {
+				result.Status = StatusDown
+				result.Error = err.Error()
+			}
+
+			mutex.Lock()
+			defer mutex.Unlock()
+
+			report.Checks[check.name] = result
+			if err != nil {
+				report.Status = StatusDown
+			}
+		}(check)
+	}
+
+	wg.Wait()
+
+	return report
+}
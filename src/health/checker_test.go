@@ -0,0 +1,62 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("should report up when every check succeeds", func(t *testing.T) {
+		checker := NewChecker(time.Second)
+		checker.Add("postgres", func(ctx context.Context) error { return nil })
+		checker.Add("payments", func(ctx context.Context) error { return nil })
+
+		actual := checker.Check(ctx)
+
+		assert.True(t, actual.IsUp())
+		assert.Equal(t, StatusUp, actual.Checks["postgres"].Status)
+		assert.Equal(t, StatusUp, actual.Checks["payments"].Status)
+	})
+
+	t.Run("should report down when a check fails", func(t *testing.T) {
+		checker := NewChecker(time.Second)
+		checker.Add("postgres", func(ctx context.Context) error { return nil })
+		checker.Add("payments", func(ctx context.Context) error { return errors.New("connection refused") })
+
+		actual := checker.Check(ctx)
+
+		assert.False(t, actual.IsUp())
+		assert.Equal(t, StatusUp, actual.Checks["postgres"].Status)
+		assert.Equal(t, "connection refused", actual.Checks["payments"].Error)
+	})
+
+	t.Run("should report down when a check exceeds the timeout", func(t *testing.T) {
+		checker := NewChecker(10 * time.Millisecond)
+		checker.Add("postgres", func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		})
+
+		actual := checker.Check(ctx)
+
+		assert.False(t, actual.IsUp())
+		assert.Equal(t, context.DeadlineExceeded.Error(), actual.Checks["postgres"].Error)
+	})
+
+	t.Run("should report down while draining", func(t *testing.T) {
+		checker := NewChecker(time.Second)
+		checker.Add("postgres", func(ctx context.Context) error { return nil })
+		checker.SetDraining()
+
+		actual := checker.Check(ctx)
+
+		assert.False(t, actual.IsUp())
+		assert.Equal(t, StatusDown, actual.Checks["shutdown"].Status)
+	})
+}
@@ -0,0 +1,66 @@
+package logger
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"log/slog"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	var buffer bytes.Buffer
+
+	logger, level := New(&buffer)
+	level.Set(slog.LevelWarn)
+
+	logger.Info("ignored")
+	logger.Warn("kept", "payment_id", "123")
+
+	var entry map[string]any
+	err := json.Unmarshal(buffer.Bytes(), &entry)
+
+	assert.Nil(t, err)
+	assert.Equal(t, "kept", entry["msg"])
+	assert.Equal(t, "123", entry["payment_id"])
+}
+
+func TestParseLevel(t *testing.T) {
+	t.Run("should parse level successfully", func(t *testing.T) {
+		actual, err := ParseLevel("debug")
+
+		assert.Equal(t, slog.LevelDebug, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should not parse unknown level", func(t *testing.T) {
+		_, err := ParseLevel("verbose")
+
+		assert.NotNil(t, err)
+	})
+}
+
+func TestFromContext(t *testing.T) {
+	t.Run("should fall back to default logger", func(t *testing.T) {
+		actual := FromContext(context.TODO())
+
+		assert.Equal(t, slog.Default(), actual)
+	})
+
+	t.Run("should get logger from context", func(t *testing.T) {
+		logger := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+
+		ctx := WithContext(context.TODO(), logger)
+
+		assert.Equal(t, logger, FromContext(ctx))
+	})
+}
+
+func TestRequestIDFromContext(t *testing.T) {
+	ctx := WithRequestID(context.TODO(), "some-request-id")
+
+	assert.Equal(t, "some-request-id", RequestIDFromContext(ctx))
+	assert.Empty(t, RequestIDFromContext(context.TODO()))
+}
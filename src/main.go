@@ -2,140 +2,274 @@ package main
 
 import (
 	"context"
-	"database/sql"
-	"fmt"
-	"log"
-	"net"
+	"errors"
+	"log/slog"
 	"net/http"
 	"os"
+	"os/signal"
+	"syscall"
 	"time"
 
-	"cloud.google.com/go/cloudsqlconn"
-	"github.com/jackc/pgx/v5"
-	"github.com/jackc/pgx/v5/stdlib"
-	_ "github.com/joho/godotenv/autoload"
-	_ "github.com/lib/pq"
+	"github.com/gin-gonic/gin"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	swaggerFiles "github.com/swaggo/files"
 	ginSwagger "github.com/swaggo/gin-swagger"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 
+	"github.com/caiiomp/vehicle-platform-sales/src/adapter/jwks"
+	mediastorage "github.com/caiiomp/vehicle-platform-sales/src/adapter/mediaStorage"
 	vehicleplatformpayments "github.com/caiiomp/vehicle-platform-sales/src/adapter/vehiclePlatformPayments"
 	vehiclePlatformPaymentsHttpClient "github.com/caiiomp/vehicle-platform-sales/src/adapter/vehiclePlatformPayments/http"
+	"github.com/caiiomp/vehicle-platform-sales/src/config"
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/financing"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/useCases/auth"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/useCases/coupon"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/useCases/dealer"
+	financingplan "github.com/caiiomp/vehicle-platform-sales/src/core/useCases/financingPlan"
+	financingsimulation "github.com/caiiomp/vehicle-platform-sales/src/core/useCases/financingSimulation"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/useCases/media"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/useCases/offer"
 	"github.com/caiiomp/vehicle-platform-sales/src/core/useCases/sale"
 	"github.com/caiiomp/vehicle-platform-sales/src/core/useCases/vehicle"
 	_ "github.com/caiiomp/vehicle-platform-sales/src/docs"
+	"github.com/caiiomp/vehicle-platform-sales/src/health"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+	"github.com/caiiomp/vehicle-platform-sales/src/metrics"
 	"github.com/caiiomp/vehicle-platform-sales/src/presentation"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/apiKeyApi"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/couponApi"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/dealerApi"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/financingPlanApi"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/healthApi"
 	"github.com/caiiomp/vehicle-platform-sales/src/presentation/saleApi"
 	"github.com/caiiomp/vehicle-platform-sales/src/presentation/vehicleApi"
-	salerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/saleRepository"
-	vehiclerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/vehicleRepository"
+	"github.com/caiiomp/vehicle-platform-sales/src/ratelimit"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/migrator"
+	"github.com/caiiomp/vehicle-platform-sales/src/tracing"
+	"github.com/caiiomp/vehicle-platform-sales/src/workers"
 )
 
+const bootstrapAdminKeyName = "bootstrap-admin"
+
+// @securityDefinitions.apikey ApiKeyAuth
+// @in header
+// @name X-API-Key
+
+// @securityDefinitions.apikey BearerAuth
+// @in header
+// @name Authorization
+// @description JWT signed by a key of AUTH_JWKS_FILE, sent as "Bearer <token>"
 func main() {
-	var (
-		ctx = context.Background()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 
-		environment = os.Getenv("ENVIRONMENT")
-		apiPort     = os.Getenv("API_PORT")
+	log, logLevel := logger.New(os.Stdout)
+	slog.SetDefault(log)
 
-		instanceConnectionName = os.Getenv("INSTANCE_CONNECTION_NAME")
-		host                   = os.Getenv("DB_HOST")
-		port                   = os.Getenv("DB_PORT")
-		user                   = os.Getenv("DB_USER")
-		password               = os.Getenv("DB_PASSWORD")
-		dbname                 = os.Getenv("DB_NAME")
+	cfg, err := config.Load()
+	if err != nil {
+		fatal("error to load config", err)
+	}
 
-		vehiclePlatformPaymentsHost = os.Getenv("VEHICLE_PLATFORM_PAYMENTS_HOST")
-		vehiclePlatformSalesHost    = os.Getenv("VEHICLE_PLATFORM_SALES_HOST")
-	)
+	level, err := logger.ParseLevel(cfg.LogLevel)
+	if err != nil {
+		fatal("invalid log level", err)
+	}
+	logLevel.Set(level)
 
-	db, err := getDb(ctx, environment, instanceConnectionName, host, port, user, password, dbname)
+	if len(os.Args) > 1 && os.Args[1] == "config" {
+		if err = runConfigCommand(cfg, os.Args[2:]); err != nil {
+			exitWithError(err)
+		}
+		return
+	}
+
+	if err = cfg.Validate(); err != nil {
+		fatal("invalid config", err)
+	}
+
+	shutdownTracing, err := tracing.Setup(ctx, cfg.Tracing, os.Stdout)
 	if err != nil {
-		log.Fatalf("error to connect database: %s", err)
+		fatal("error to setup tracing", err)
 	}
-	defer db.Close()
 
-	if err := db.Ping(); err != nil {
-		log.Fatalf("error to ping database: %s", err)
+	storage, err := openStorage(ctx, cfg)
+	if err != nil {
+		fatal("error to open storage", err)
+	}
+	defer storage.close()
+
+	if len(os.Args) > 1 {
+		if err = runCommand(ctx, storage, os.Args[1:]); err != nil {
+			exitWithError(err)
+		}
+		return
+	}
+
+	if storage.migrator != nil {
+		if cfg.Database.MigrateOnStartup {
+			if err = storage.migrator.Up(ctx); err != nil && !errors.Is(err, migrator.ErrNothingToDo) {
+				fatal("error to apply migrations", err)
+			}
+		}
+
+		if err = storage.migrator.Check(ctx); err != nil {
+			fatal("refusing to start", err)
+		}
+	} else {
+		log.Warn("using in-memory storage, data is lost on restart")
+	}
+
+	if cfg.Cache.CatalogTTL > 0 {
+		storage.useCatalogCache(cfg.Cache)
+	}
+
+	// Auth
+	var tokenVerifier interfaces.TokenVerifier
+	if cfg.Auth.JWKSFile != "" {
+		if tokenVerifier, err = jwks.NewTokenVerifier(cfg.Auth.JWKSFile, cfg.Auth.Issuer, cfg.Auth.Audience, timeGenerator); err != nil {
+			fatal("error to load jwks file", err)
+		}
+	}
+
+	authService := auth.NewAuthService(storage.apiKeyRepository, tokenVerifier, timeGenerator)
+
+	if cfg.Auth.BootstrapAdminKey != "" {
+		if _, err = authService.EnsureAPIKey(tenant.WithAllDealers(ctx), bootstrapAdminKeyName, cfg.Auth.BootstrapAdminKey, []valueobjects.Role{valueobjects.RolePlatformAdmin}); err != nil {
+			fatal("error to store bootstrap admin key", err)
+		}
 	}
 
 	// HTTP Clients
 	httpClient := &http.Client{
-		Timeout: time.Second * 3,
+		Timeout:   cfg.Payments.Timeout,
+		Transport: otelhttp.NewTransport(metrics.InstrumentPaymentsTransport(http.DefaultTransport)),
 	}
-	vehiclePlatformPaymentsHttpClient := vehiclePlatformPaymentsHttpClient.NewVehiclePlatformSalesHttpClient(httpClient, vehiclePlatformPaymentsHost, vehiclePlatformSalesHost)
+	vehiclePlatformPaymentsHttpClient := vehiclePlatformPaymentsHttpClient.NewVehiclePlatformSalesHttpClient(httpClient, cfg.Payments.Host, cfg.API.PublicHost)
 
 	// Adapters
 	vehiclePlatformPaymentsAdapter := vehicleplatformpayments.NewVehiclePlatformPaymentsAdapter(vehiclePlatformPaymentsHttpClient)
 
-	// Repositories
-	vehicleRepository := vehiclerepository.NewVehicleRepository(db)
-	saleRepository := salerepository.NewSaleRepository(db)
+	mediaStorage, err := mediastorage.NewLocalStorage(cfg.Media.Dir)
+	if err != nil {
+		fatal("error to open media storage", err)
+	}
+
+	// The rate table was already checked by cfg.Validate.
+	rates, err := financing.ParseRateTable(cfg.Financing.RateTable)
+	if err != nil {
+		fatal("error to parse financing rate table", err)
+	}
+
+	financingTerms := financing.Terms{
+		Rates:           rates,
+		RegistrationFee: cfg.Financing.RegistrationFee,
+		IOFPercentage:   cfg.Financing.IOFPercentage,
+	}
 
 	// Services
-	vehicleService := vehicle.NewVehicleService(vehicleRepository, saleRepository, vehiclePlatformPaymentsAdapter)
-	saleService := sale.NewSaleService(saleRepository, timeGenerator)
+	vehicleService := vehicle.NewVehicleService(storage.vehicleRepository, storage.saleRepository, storage.couponRepository, storage.financingPlanRepository, storage.mediaRepository, mediaStorage, vehiclePlatformPaymentsAdapter, storage.txManager, timeGenerator)
+	mediaService := media.NewVehicleMediaService(storage.vehicleRepository, storage.mediaRepository, mediaStorage, storage.txManager, media.Limits{
+		MaxSize:       cfg.Media.MaxSize,
+		MinWidth:      cfg.Media.MinWidth,
+		MinHeight:     cfg.Media.MinHeight,
+		MaxWidth:      cfg.Media.MaxWidth,
+		MaxHeight:     cfg.Media.MaxHeight,
+		MaxPerVehicle: cfg.Media.MaxPerVehicle,
+		ThumbnailSize: cfg.Media.ThumbnailSize,
+	})
+	offerService := offer.NewOfferService(storage.vehicleRepository, storage.offerRepository, storage.saleRepository, vehiclePlatformPaymentsAdapter, storage.txManager, cfg.Offers.TTL, timeGenerator)
+	saleService := sale.NewSaleService(storage.saleRepository, storage.couponRepository, vehicleService, storage.txManager, timeGenerator)
+	dealerService := dealer.NewDealerService(storage.dealerRepository)
+	couponService := coupon.NewCouponService(storage.couponRepository)
+	financingPlanService := financingplan.NewFinancingPlanService(storage.financingPlanRepository)
+	financingSimulationService := financingsimulation.NewFinancingSimulationService(vehicleService, financingTerms, timeGenerator)
 
-	app := presentation.SetupServer()
+	// Health
+	checker := health.NewChecker(cfg.API.HealthCheckTimeout)
+	checker.Add("payments", vehiclePlatformPaymentsHttpClient.Ping)
+	for name, check := range storage.healthChecks {
+		checker.Add(name, check)
+	}
 
-	app.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	// Metrics
+	prometheus.MustRegister(storage.collectors...)
+	prometheus.MustRegister(
+		metrics.NewPendingSalesCollector(func(ctx context.Context) (int, error) {
+			return storage.saleRepository.CountByStatus(tenant.WithAllDealers(ctx), valueobjects.SaleStatusTypePending.String())
+		}, cfg.API.HealthCheckTimeout),
+	)
 
-	vehicleApi.RegisterVehicleRoutes(app, vehicleService)
-	saleApi.RegisterSaleRoutes(app, saleService)
+	// Rate limits
+	limits := newRateLimits(cfg.RateLimit, storage)
 
-	if apiPort == "" {
-		apiPort = "8080"
-	}
+	// Workers
+	backgroundWorkers := workers.NewGroup(ctx)
 
-	if err = app.Run(":" + apiPort); err != nil {
-		log.Fatalf("coult not initialize http server: %v", err)
+	if idle := limits.idle(); idle > 0 {
+		backgroundWorkers.Go(func(ctx context.Context) {
+			ratelimit.Prune(ctx, limits.store, rateLimitPruneInterval, idle, timeGenerator)
+		})
 	}
-}
 
-func getDb(ctx context.Context, environment, instanceConnectionName, host, port, user, password, dbname string) (*sql.DB, error) {
-	var (
-		db *sql.DB
+	app := presentation.SetupServer(log, cfg.Tracing.ServiceName, authService)
 
-		config *pgx.ConnConfig
-		dialer *cloudsqlconn.Dialer
-		opts   []cloudsqlconn.Option
+	app.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
 
-		dataSourceName string
-		err            error
-	)
+	app.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
-	switch environment {
-	case "PROD":
-		dataSourceName = fmt.Sprintf("user=%s password=%s database=%s", user, password, dbname)
+	healthApi.RegisterHealthRoutes(app, checker)
+	apiKeyApi.RegisterAPIKeyRoutes(app, authService)
+	dealerApi.RegisterDealerRoutes(app, dealerService)
+	couponApi.RegisterCouponRoutes(app, couponService)
+	financingPlanApi.RegisterFinancingPlanRoutes(app, financingPlanService)
+	vehicleApi.RegisterVehicleRoutes(app, vehicleService, mediaService, offerService, financingSimulationService, limits.buy, limits.buyConcurrency)
+	saleApi.RegisterSaleRoutes(app, saleService, limits.webhook)
 
-		config, err = pgx.ParseConfig(dataSourceName)
-		if err != nil {
-			return nil, err
-		}
+	server := &http.Server{
+		Addr:              ":" + cfg.API.Port,
+		Handler:           app,
+		ReadHeaderTimeout: cfg.API.ReadHeaderTimeout,
+	}
 
-		opts = append(opts, cloudsqlconn.WithLazyRefresh())
+	serverErrors := make(chan error, 1)
+	go func() {
+		log.Info("http server listening", "addr", server.Addr)
+		serverErrors <- server.ListenAndServe()
+	}()
 
-		dialer, err = cloudsqlconn.NewDialer(ctx, opts...)
-		if err != nil {
-			return nil, err
-		}
+	select {
+	case err = <-serverErrors:
+		fatal("coult not initialize http server", err)
+	case <-ctx.Done():
+	}
 
-		config.DialFunc = func(ctx context.Context, network, instance string) (net.Conn, error) {
-			return dialer.Dial(ctx, instanceConnectionName)
-		}
+	log.Info("shutting down, draining in-flight requests", "timeout", cfg.API.ShutdownTimeout.String())
+	checker.SetDraining()
 
-		dbUri := stdlib.RegisterConnConfig(config)
-		db, err = sql.Open("pgx", dbUri)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.API.ShutdownTimeout)
+	defer cancel()
 
-	default:
-		dataSourceName = fmt.Sprintf("host=%s port=%s user=%s password=%s dbname=%s sslmode=disable", host, port, user, password, dbname)
-		db, err = sql.Open("postgres", dataSourceName)
+	if err = server.Shutdown(shutdownCtx); err != nil {
+		log.Error("error to shut down http server", "error", err)
 	}
 
-	if err != nil {
-		return nil, err
+	if err = backgroundWorkers.Shutdown(shutdownCtx); err != nil {
+		log.Error("error to stop background workers", "error", err)
 	}
 
-	return db, nil
+	if err = shutdownTracing(shutdownCtx); err != nil {
+		log.Error("error to flush traces", "error", err)
+	}
+}
+
+func fatal(message string, err error) {
+	slog.Error(message, "error", err)
+	os.Exit(1)
 }
 
 func timeGenerator() time.Time {
@@ -0,0 +1,18 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// NewCacheEntriesGauge reports the entries held by an in-process cache, read
+// on every scrape.
+func NewCacheEntriesGauge(cache string, count func() int) prometheus.Collector {
+	return prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Namespace:   namespace,
+		Name:        "cache_entries",
+		Help:        "Entries held by an in-process cache, expired ones included until evicted.",
+		ConstLabels: prometheus.Labels{"cache": cache},
+	}, func() float64 {
+		return float64(count())
+	})
+}
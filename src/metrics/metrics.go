@@ -0,0 +1,79 @@
+package metrics
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "vehicle_platform_sales"
+
+var (
+	HTTPRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "http_requests_total",
+		Help:      "HTTP requests handled, by route, method and status code.",
+	}, []string{"route", "method", "status"})
+
+	HTTPRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "http_request_duration_seconds",
+		Help:      "Latency of the HTTP requests handled, by route, method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+
+	PaymentsRequestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "payments_request_duration_seconds",
+		Help:      "Latency of the calls to vehicle platform payments, by method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method", "status"})
+
+	PaymentsErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "payments_errors_total",
+		Help:      "Failed calls to vehicle platform payments, by reason (transport or status).",
+	}, []string{"reason"})
+
+	WebhooksTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "webhooks_total",
+		Help:      "Payment webhooks received, by outcome.",
+	}, []string{"outcome"})
+
+	BuysStartedTotal = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "buys_started_total",
+		Help:      "Buys that started a sale, waiting for its payment.",
+	})
+
+	SalesFinishedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "sales_finished_total",
+		Help:      "Sales that left the PENDING status, by final status.",
+	}, []string{"status"})
+
+	RateLimitedTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "rate_limited_total",
+		Help:      "Requests rejected with 429, by route group and the key that ran out.",
+	}, []string{"group", "key"})
+
+	CacheRequestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_requests_total",
+		Help:      "Cache lookups, by cache and result (hit or miss).",
+	}, []string{"cache", "result"})
+
+	CacheEvictionsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "cache_evictions_total",
+		Help:      "Entries evicted to make room in an in-process cache, by cache.",
+	}, []string{"cache"})
+)
+
+const (
+	WebhookOutcomeInvalid  = "invalid"
+	WebhookOutcomeError    = "error"
+	WebhookOutcomeNotFound = "not_found"
+	WebhookOutcomeUpdated  = "updated"
+)
@@ -0,0 +1,97 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInstrumentPaymentsTransport(t *testing.T) {
+	t.Run("should count payments answering with error status", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		before := testutil.ToFloat64(PaymentsErrorsTotal.WithLabelValues("status"))
+
+		client := &http.Client{Transport: InstrumentPaymentsTransport(nil)}
+
+		response, err := client.Post(server.URL+"/payments", "application/json", nil)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadGateway, response.StatusCode)
+		assert.Equal(t, before+1, testutil.ToFloat64(PaymentsErrorsTotal.WithLabelValues("status")))
+	})
+
+	t.Run("should count payments transport errors", func(t *testing.T) {
+		server := httptest.NewServer(http.NotFoundHandler())
+		server.Close()
+
+		before := testutil.ToFloat64(PaymentsErrorsTotal.WithLabelValues("transport"))
+
+		client := &http.Client{Transport: InstrumentPaymentsTransport(nil)}
+
+		_, err := client.Get(server.URL)
+
+		assert.NotNil(t, err)
+		assert.Equal(t, before+1, testutil.ToFloat64(PaymentsErrorsTotal.WithLabelValues("transport")))
+	})
+
+	t.Run("should leave health probes out of the payments metrics", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.WriteHeader(http.StatusBadGateway)
+		}))
+		defer server.Close()
+
+		errorsBefore := testutil.ToFloat64(PaymentsErrorsTotal.WithLabelValues("status"))
+		observationsBefore := testutil.CollectAndCount(PaymentsRequestDuration)
+
+		client := &http.Client{Transport: InstrumentPaymentsTransport(nil)}
+
+		request, err := http.NewRequestWithContext(HealthProbe(context.TODO()), http.MethodGet, server.URL, nil)
+		assert.Nil(t, err)
+
+		response, err := client.Do(request)
+
+		assert.Nil(t, err)
+		assert.Equal(t, http.StatusBadGateway, response.StatusCode)
+		assert.Equal(t, errorsBefore, testutil.ToFloat64(PaymentsErrorsTotal.WithLabelValues("status")))
+		assert.Equal(t, observationsBefore, testutil.CollectAndCount(PaymentsRequestDuration))
+	})
+}
+
+func TestPendingSalesCollector(t *testing.T) {
+	t.Run("should collect pending sales successfully", func(t *testing.T) {
+		collector := NewPendingSalesCollector(func(ctx context.Context) (int, error) {
+			return 3, nil
+		}, time.Second)
+
+		expected := `
+# HELP vehicle_platform_sales_sales_pending Sales waiting for the payment confirmation.
+# TYPE vehicle_platform_sales_sales_pending gauge
+vehicle_platform_sales_sales_pending 3
+`
+
+		err := testutil.CollectAndCompare(collector, strings.NewReader(expected))
+
+		assert.Nil(t, err)
+	})
+
+	t.Run("should report invalid metric when failed to count", func(t *testing.T) {
+		collector := NewPendingSalesCollector(func(ctx context.Context) (int, error) {
+			return 0, errors.New("connection refused")
+		}, time.Second)
+
+		_, err := testutil.CollectAndLint(collector)
+
+		assert.ErrorContains(t, err, "connection refused")
+	})
+}
@@ -0,0 +1,45 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+type pendingSalesCollector struct {
+	description *prometheus.Desc
+	count       func(ctx context.Context) (int, error)
+	timeout     time.Duration
+}
+
+// NewPendingSalesCollector reports the current number of PENDING sales, read
+// from the store on every scrape.
+func NewPendingSalesCollector(count func(ctx context.Context) (int, error), timeout time.Duration) prometheus.Collector {
+	return &pendingSalesCollector{
+		description: prometheus.NewDesc(
+			prometheus.BuildFQName(namespace, "", "sales_pending"),
+			"Sales waiting for the payment confirmation.",
+			nil, nil,
+		),
+		count:   count,
+		timeout: timeout,
+	}
+}
+
+func (ref *pendingSalesCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- ref.description
+}
+
+func (ref *pendingSalesCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), ref.timeout)
+	defer cancel()
+
+	count, err := ref.count(ctx)
+	if err != nil {
+		ch <- prometheus.NewInvalidMetric(ref.description, err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(ref.description, prometheus.GaugeValue, float64(count))
+}
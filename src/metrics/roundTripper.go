@@ -0,0 +1,55 @@
+package metrics
+
+import (
+	"context"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type paymentsRoundTripper struct {
+	next http.RoundTripper
+}
+
+type healthProbeKey struct{}
+
+// HealthProbe marks the requests made with ctx as health checks, which are
+// left out of the payments metrics.
+func HealthProbe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, healthProbeKey{}, true)
+}
+
+// InstrumentPaymentsTransport records the latency and the errors of the calls
+// made through next to vehicle platform payments, except for health probes.
+func InstrumentPaymentsTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+
+	return &paymentsRoundTripper{
+		next: next,
+	}
+}
+
+func (ref *paymentsRoundTripper) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Context().Value(healthProbeKey{}) != nil {
+		return ref.next.RoundTrip(request)
+	}
+
+	startedAt := time.Now()
+
+	response, err := ref.next.RoundTrip(request)
+	if err != nil {
+		PaymentsErrorsTotal.WithLabelValues("transport").Inc()
+		return nil, err
+	}
+
+	status := strconv.Itoa(response.StatusCode)
+	PaymentsRequestDuration.WithLabelValues(request.Method, status).Observe(time.Since(startedAt).Seconds())
+
+	if response.StatusCode >= http.StatusBadRequest {
+		PaymentsErrorsTotal.WithLabelValues("status").Inc()
+	}
+
+	return response, nil
+}
@@ -0,0 +1,31 @@
+package apiKeyApi
+
+import (
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// createAPIKeyRequest takes DealerID from platform admins only, dealer admins
+// always create keys for their own dealer.
+type createAPIKeyRequest struct {
+	DealerID int      `json:"dealer_id"`
+	Name     string   `json:"name" binding:"required"`
+	Roles    []string `json:"roles" binding:"required,min=1"`
+}
+
+func (ref createAPIKeyRequest) RolesToDomain() ([]valueobjects.Role, error) {
+	roles := make([]valueobjects.Role, len(ref.Roles))
+
+	for i, value := range ref.Roles {
+		role, err := valueobjects.ParseRole(value)
+		if err != nil {
+			return nil, err
+		}
+		roles[i] = role
+	}
+
+	return roles, nil
+}
+
+type apiKeyUri struct {
+	ID int `uri:"id" binding:"required"`
+}
@@ -0,0 +1,37 @@
+package apiKeyApi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func Test_createAPIKeyRequestRolesToDomain(t *testing.T) {
+	t.Run("should parse roles successfully", func(t *testing.T) {
+		request := createAPIKeyRequest{
+			Name:  "payments",
+			Roles: []string{"payments_system", "ADMIN"},
+		}
+
+		expected := []valueobjects.Role{valueobjects.RolePaymentsSystem, valueobjects.RoleAdmin}
+
+		actual, err := request.RolesToDomain()
+
+		assert.Equal(t, expected, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should not parse unknown role", func(t *testing.T) {
+		request := createAPIKeyRequest{
+			Name:  "payments",
+			Roles: []string{"root"},
+		}
+
+		actual, err := request.RolesToDomain()
+
+		assert.Nil(t, actual)
+		assert.NotNil(t, err)
+	})
+}
@@ -0,0 +1,162 @@
+package apiKeyApi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/middlewares"
+)
+
+type apiKeyApi struct {
+	authService interfaces.AuthService
+}
+
+func RegisterAPIKeyRoutes(app *gin.Engine, authService interfaces.AuthService) {
+	service := apiKeyApi{
+		authService: authService,
+	}
+
+	admin := app.Group("/api-keys", middlewares.RequireRoles(valueobjects.RolePlatformAdmin, valueobjects.RoleAdmin))
+
+	admin.POST("", service.create)
+	admin.GET("", service.search)
+	admin.DELETE("/:id", service.revoke)
+}
+
+// Create godoc
+// @Summary Create API Key
+// @Description Create an API key. The key is only returned in this response. Dealer admins create keys for their own dealer and can not grant PLATFORM_ADMIN or PAYMENTS_SYSTEM
+// @Tags APIKey
+// @Accept json
+// @Produce json
+// @Param api_key body apiKeyApi.createAPIKeyRequest true "Body"
+// @Success 201 {object} responses.CreatedAPIKey
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api-keys [post]
+func (ref *apiKeyApi) create(ctx *gin.Context) {
+	var request createAPIKeyRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	roles, err := request.RolesToDomain()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	apiKey, key, err := ref.authService.CreateAPIKey(ctx, request.Name, request.DealerID, roles)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domainerrors.ErrInvalidArgument), errors.Is(err, domainerrors.ErrReferenceNotFound):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, domainerrors.ErrPermissionDenied):
+			statusCode = http.StatusForbidden
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	response := responses.CreatedAPIKey{
+		APIKey: responses.APIKeyFromDomain(*apiKey),
+		Key:    key,
+	}
+
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// Create godoc
+// @Summary List API Keys
+// @Description List API keys, without the keys themselves. Dealer admins only see the keys of their dealer
+// @Tags APIKey
+// @Accept json
+// @Produce json
+// @Success 200 {array} responses.APIKey
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api-keys [get]
+func (ref *apiKeyApi) search(ctx *gin.Context) {
+	apiKeys, err := ref.authService.SearchAPIKeys(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	response := make([]responses.APIKey, len(apiKeys))
+
+	for i, apiKey := range apiKeys {
+		response[i] = responses.APIKeyFromDomain(apiKey)
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Revoke API Key
+// @Description Revoke API key
+// @Tags APIKey
+// @Accept json
+// @Produce json
+// @Param id path int true "API Key ID"
+// @Success 200 {object} responses.APIKey
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /api-keys/{id} [delete]
+func (ref *apiKeyApi) revoke(ctx *gin.Context) {
+	var uri apiKeyUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	apiKey, err := ref.authService.RevokeAPIKey(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if apiKey == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.APIKeyDoesNotExist,
+		})
+		return
+	}
+
+	response := responses.APIKeyFromDomain(*apiKey)
+	ctx.JSON(http.StatusOK, response)
+}
@@ -4,5 +4,28 @@ const (
 	VehicleDoesNotExist = "vehicle does not exist"
 	VehicleAlreadySold  = "vehicle already sold"
 
-	SaleDoesNotExist = "sale does not exist"
+	IfMatchRequired       = "If-Match header with the vehicle ETag is required"
+	VehicleVersionChanged = "vehicle was changed by another request"
+
+	MediaDoesNotExist = "media does not exist"
+	MediaFileRequired = `multipart field "file" is required`
+
+	OfferDoesNotExist = "offer does not exist"
+
+	CouponDoesNotExist = "coupon does not exist"
+
+	FinancingPlanDoesNotExist = "financing plan does not exist"
+
+	SaleDoesNotExist        = "sale does not exist"
+	InstallmentDoesNotExist = "installment does not exist"
+
+	APIKeyDoesNotExist = "api key does not exist"
+
+	DealerDoesNotExist = "dealer does not exist"
+	InvalidDealerID    = "invalid dealer id"
+
+	AuthenticationRequired = "authentication required"
+	InvalidCredentials     = "invalid credentials"
+	PermissionDenied       = "permission denied"
+	TooManyRequests        = "too many requests"
 )
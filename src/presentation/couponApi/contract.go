@@ -0,0 +1,45 @@
+package couponApi
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// createCouponRequest takes DealerID from platform admins only, dealer admins
+// always create coupons for their own dealer. The fields after max_uses are
+// optional and restrict when and on which vehicles the coupon is redeemed.
+type createCouponRequest struct {
+	DealerID      int        `json:"dealer_id"`
+	Code          string     `json:"code" binding:"required" example:"BLACKFRIDAY"`
+	DiscountType  string     `json:"discount_type" binding:"required" enums:"PERCENTAGE,FIXED"`
+	DiscountValue float64    `json:"discount_value" binding:"required"`
+	MaxUses       int        `json:"max_uses"`
+	ValidFrom     *time.Time `json:"valid_from"`
+	ValidUntil    *time.Time `json:"valid_until"`
+	Brand         string     `json:"brand"`
+	Model         string     `json:"model"`
+	MinYear       int        `json:"min_year"`
+	MaxYear       int        `json:"max_year"`
+}
+
+func (ref createCouponRequest) ToDomain() entity.Coupon {
+	return entity.Coupon{
+		DealerID:      ref.DealerID,
+		Code:          valueobjects.CouponCode(ref.Code),
+		DiscountType:  valueobjects.DiscountType(ref.DiscountType),
+		DiscountValue: ref.DiscountValue,
+		MaxUses:       ref.MaxUses,
+		ValidFrom:     ref.ValidFrom,
+		ValidUntil:    ref.ValidUntil,
+		Brand:         ref.Brand,
+		Model:         ref.Model,
+		MinYear:       ref.MinYear,
+		MaxYear:       ref.MaxYear,
+	}
+}
+
+type couponUri struct {
+	ID int `uri:"id" binding:"required"`
+}
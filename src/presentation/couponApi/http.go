@@ -0,0 +1,199 @@
+package couponApi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/middlewares"
+)
+
+type couponApi struct {
+	couponService interfaces.CouponService
+}
+
+func RegisterCouponRoutes(app *gin.Engine, couponService interfaces.CouponService) {
+	service := couponApi{
+		couponService: couponService,
+	}
+
+	admins := middlewares.RequireRoles(valueobjects.RolePlatformAdmin, valueobjects.RoleAdmin)
+	staff := middlewares.RequireRoles(valueobjects.RolePlatformAdmin, valueobjects.RoleAdmin, valueobjects.RoleDealerStaff)
+
+	app.POST("/coupons", admins, service.create)
+	app.GET("/coupons", staff, service.search)
+	app.GET("/coupons/:id", staff, service.get)
+	app.DELETE("/coupons/:id", admins, service.delete)
+}
+
+// Create godoc
+// @Summary Create Coupon
+// @Description Create coupon. Dealer admins create it in their own dealer, platform admins name the dealer with dealer_id. Codes are unique within the dealer, in upper case
+// @Tags Coupon
+// @Accept json
+// @Produce json
+// @Param coupon body couponApi.createCouponRequest true "Body"
+// @Success 201 {object} responses.Coupon
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /coupons [post]
+func (ref *couponApi) create(ctx *gin.Context) {
+	var request createCouponRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	coupon, err := ref.couponService.Create(ctx, request.ToDomain())
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domainerrors.ErrInvalidArgument), errors.Is(err, domainerrors.ErrReferenceNotFound):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, domainerrors.ErrPermissionDenied):
+			statusCode = http.StatusForbidden
+		case errors.Is(err, domainerrors.ErrAlreadyExists):
+			statusCode = http.StatusConflict
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	response := responses.CouponFromDomain(*coupon)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// Create godoc
+// @Summary List Coupons
+// @Description List coupons. Dealer users only see the coupons of their own dealer
+// @Tags Coupon
+// @Accept json
+// @Produce json
+// @Success 200 {array} responses.Coupon
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /coupons [get]
+func (ref *couponApi) search(ctx *gin.Context) {
+	coupons, err := ref.couponService.Search(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	response := make([]responses.Coupon, len(coupons))
+
+	for i, coupon := range coupons {
+		response[i] = responses.CouponFromDomain(coupon)
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Get Coupon
+// @Description Get coupon with the number of times it was redeemed
+// @Tags Coupon
+// @Accept json
+// @Produce json
+// @Param id path int true "Coupon ID"
+// @Success 200 {object} responses.Coupon
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /coupons/{id} [get]
+func (ref *couponApi) get(ctx *gin.Context) {
+	var uri couponUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	coupon, err := ref.couponService.GetByID(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if coupon == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.CouponDoesNotExist,
+		})
+		return
+	}
+
+	response := responses.CouponFromDomain(*coupon)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Delete Coupon
+// @Description Delete coupon, so it can no longer be redeemed. Sales keep the code they were made with
+// @Tags Coupon
+// @Accept json
+// @Produce json
+// @Param id path int true "Coupon ID"
+// @Success 200 {object} responses.Coupon
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /coupons/{id} [delete]
+func (ref *couponApi) delete(ctx *gin.Context) {
+	var uri couponUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	coupon, err := ref.couponService.Delete(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if coupon == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.CouponDoesNotExist,
+		})
+		return
+	}
+
+	response := responses.CouponFromDomain(*coupon)
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,19 @@
+package dealerApi
+
+import (
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type createDealerRequest struct {
+	Name string `json:"name" binding:"required"`
+}
+
+func (ref createDealerRequest) ToDomain() entity.Dealer {
+	return entity.Dealer{
+		Name: ref.Name,
+	}
+}
+
+type dealerUri struct {
+	ID int `uri:"id" binding:"required"`
+}
@@ -0,0 +1,148 @@
+package dealerApi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/middlewares"
+)
+
+type dealerApi struct {
+	dealerService interfaces.DealerService
+}
+
+func RegisterDealerRoutes(app *gin.Engine, dealerService interfaces.DealerService) {
+	service := dealerApi{
+		dealerService: dealerService,
+	}
+
+	app.POST("/dealers", middlewares.RequireRoles(valueobjects.RolePlatformAdmin), service.create)
+	app.GET("/dealers", middlewares.RequireRoles(valueobjects.RolePlatformAdmin, valueobjects.RoleAdmin), service.search)
+	app.GET("/dealers/:id", middlewares.RequireRoles(valueobjects.RolePlatformAdmin, valueobjects.RoleAdmin, valueobjects.RoleDealerStaff), service.get)
+}
+
+// Create godoc
+// @Summary Create Dealer
+// @Description Create dealer
+// @Tags Dealer
+// @Accept json
+// @Produce json
+// @Param dealer body dealerApi.createDealerRequest true "Body"
+// @Success 201 {object} responses.Dealer
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /dealers [post]
+func (ref *dealerApi) create(ctx *gin.Context) {
+	var request createDealerRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	dealer, err := ref.dealerService.Create(ctx, request.ToDomain())
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domainerrors.ErrInvalidArgument):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, domainerrors.ErrPermissionDenied):
+			statusCode = http.StatusForbidden
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	response := responses.DealerFromDomain(*dealer)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// Create godoc
+// @Summary List Dealers
+// @Description List dealers. Dealer admins only see their own dealer
+// @Tags Dealer
+// @Accept json
+// @Produce json
+// @Success 200 {array} responses.Dealer
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /dealers [get]
+func (ref *dealerApi) search(ctx *gin.Context) {
+	dealers, err := ref.dealerService.Search(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	response := make([]responses.Dealer, len(dealers))
+
+	for i, dealer := range dealers {
+		response[i] = responses.DealerFromDomain(dealer)
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Get Dealer
+// @Description Get dealer
+// @Tags Dealer
+// @Accept json
+// @Produce json
+// @Param id path int true "Dealer ID"
+// @Success 200 {object} responses.Dealer
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /dealers/{id} [get]
+func (ref *dealerApi) get(ctx *gin.Context) {
+	var uri dealerUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	dealer, err := ref.dealerService.GetByID(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if dealer == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.DealerDoesNotExist,
+		})
+		return
+	}
+
+	response := responses.DealerFromDomain(*dealer)
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,33 @@
+package financingPlanApi
+
+import (
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// createFinancingPlanRequest takes DealerID from platform admins only, dealer
+// admins always create plans for their own dealer. Rates and percentages are
+// in percent.
+type createFinancingPlanRequest struct {
+	DealerID                 int     `json:"dealer_id"`
+	Name                     string  `json:"name" binding:"required" example:"48x Price"`
+	AmortizationSystem       string  `json:"amortization_system" binding:"required" enums:"PRICE,SAC"`
+	MonthlyInterestRate      float64 `json:"monthly_interest_rate" example:"1.49"`
+	MaxMonths                int     `json:"max_months" binding:"required" example:"48"`
+	MinDownPaymentPercentage float64 `json:"min_down_payment_percentage" example:"20"`
+}
+
+func (ref createFinancingPlanRequest) ToDomain() entity.FinancingPlan {
+	return entity.FinancingPlan{
+		DealerID:                 ref.DealerID,
+		Name:                     ref.Name,
+		AmortizationSystem:       valueobjects.AmortizationSystem(ref.AmortizationSystem),
+		MonthlyInterestRate:      ref.MonthlyInterestRate,
+		MaxMonths:                ref.MaxMonths,
+		MinDownPaymentPercentage: ref.MinDownPaymentPercentage,
+	}
+}
+
+type financingPlanUri struct {
+	ID int `uri:"id" binding:"required"`
+}
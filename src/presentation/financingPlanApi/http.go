@@ -0,0 +1,187 @@
+package financingPlanApi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/middlewares"
+)
+
+type financingPlanApi struct {
+	financingPlanService interfaces.FinancingPlanService
+}
+
+func RegisterFinancingPlanRoutes(app *gin.Engine, financingPlanService interfaces.FinancingPlanService) {
+	service := financingPlanApi{
+		financingPlanService: financingPlanService,
+	}
+
+	admins := middlewares.RequireRoles(valueobjects.RolePlatformAdmin, valueobjects.RoleAdmin)
+
+	app.POST("/financing-plans", admins, service.create)
+	app.GET("/financing-plans", service.search)
+	app.GET("/financing-plans/:id", service.get)
+	app.DELETE("/financing-plans/:id", admins, service.delete)
+}
+
+// Create godoc
+// @Summary Create Financing Plan
+// @Description Create financing plan. Dealer admins create it in their own dealer, platform admins name the dealer with dealer_id. The monthly interest rate and the minimum down payment are in percent
+// @Tags FinancingPlan
+// @Accept json
+// @Produce json
+// @Param financing_plan body financingPlanApi.createFinancingPlanRequest true "Body"
+// @Success 201 {object} responses.FinancingPlan
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /financing-plans [post]
+func (ref *financingPlanApi) create(ctx *gin.Context) {
+	var request createFinancingPlanRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	plan, err := ref.financingPlanService.Create(ctx, request.ToDomain())
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domainerrors.ErrInvalidArgument), errors.Is(err, domainerrors.ErrReferenceNotFound):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, domainerrors.ErrPermissionDenied):
+			statusCode = http.StatusForbidden
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	response := responses.FinancingPlanFromDomain(*plan)
+	ctx.JSON(http.StatusCreated, response)
+}
+
+// Create godoc
+// @Summary List Financing Plans
+// @Description List the financing plans buyers may choose from when buying. Dealer users only see the plans of their own dealer, anyone else may narrow them to a dealer with the X-Dealer-ID header
+// @Tags FinancingPlan
+// @Accept json
+// @Produce json
+// @Success 200 {array} responses.FinancingPlan
+// @Failure 500 {object} responses.ErrorResponse
+// @Router /financing-plans [get]
+func (ref *financingPlanApi) search(ctx *gin.Context) {
+	plans, err := ref.financingPlanService.Search(ctx)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	response := make([]responses.FinancingPlan, len(plans))
+
+	for i, plan := range plans {
+		response[i] = responses.FinancingPlanFromDomain(plan)
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Get Financing Plan
+// @Description Get financing plan
+// @Tags FinancingPlan
+// @Accept json
+// @Produce json
+// @Param id path int true "Financing Plan ID"
+// @Success 200 {object} responses.FinancingPlan
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Router /financing-plans/{id} [get]
+func (ref *financingPlanApi) get(ctx *gin.Context) {
+	var uri financingPlanUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	plan, err := ref.financingPlanService.GetByID(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if plan == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.FinancingPlanDoesNotExist,
+		})
+		return
+	}
+
+	response := responses.FinancingPlanFromDomain(*plan)
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Delete Financing Plan
+// @Description Delete financing plan, so it can no longer be chosen. Financed sales keep the terms they were made with
+// @Tags FinancingPlan
+// @Accept json
+// @Produce json
+// @Param id path int true "Financing Plan ID"
+// @Success 200 {object} responses.FinancingPlan
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /financing-plans/{id} [delete]
+func (ref *financingPlanApi) delete(ctx *gin.Context) {
+	var uri financingPlanUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	plan, err := ref.financingPlanService.Delete(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if plan == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.FinancingPlanDoesNotExist,
+		})
+		return
+	}
+
+	response := responses.FinancingPlanFromDomain(*plan)
+	ctx.JSON(http.StatusOK, response)
+}
@@ -0,0 +1,5 @@
+package healthApi
+
+type livenessResponse struct {
+	Status string `json:"status"`
+}
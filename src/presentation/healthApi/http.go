@@ -0,0 +1,54 @@
+package healthApi
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/health"
+)
+
+type healthApi struct {
+	checker *health.Checker
+}
+
+func RegisterHealthRoutes(app *gin.Engine, checker *health.Checker) {
+	service := healthApi{
+		checker: checker,
+	}
+
+	app.GET("/healthz", service.liveness)
+	app.GET("/readyz", service.readiness)
+}
+
+// Create godoc
+// @Summary Liveness
+// @Description Reports whether the process is running
+// @Tags Health
+// @Produce json
+// @Success 200 {object} healthApi.livenessResponse
+// @Router /healthz [get]
+func (ref *healthApi) liveness(ctx *gin.Context) {
+	ctx.JSON(http.StatusOK, livenessResponse{
+		Status: health.StatusUp,
+	})
+}
+
+// Create godoc
+// @Summary Readiness
+// @Description Reports whether the dependencies of the service are reachable
+// @Tags Health
+// @Produce json
+// @Success 200 {object} health.Report
+// @Failure 503 {object} health.Report
+// @Router /readyz [get]
+func (ref *healthApi) readiness(ctx *gin.Context) {
+	report := ref.checker.Check(ctx)
+
+	statusCode := http.StatusOK
+	if !report.IsUp() {
+		statusCode = http.StatusServiceUnavailable
+	}
+
+	ctx.JSON(statusCode, report)
+}
@@ -0,0 +1,42 @@
+package middlewares
+
+import (
+	"log/slog"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+// AccessLog logs one entry per request once it is handled. It must run after
+// RequestID so the entry carries the request ID.
+func AccessLog() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		startedAt := time.Now()
+
+		ctx.Next()
+
+		level := slog.LevelInfo
+		if ctx.Writer.Status() >= 500 {
+			level = slog.LevelError
+		}
+
+		attributes := []any{
+			"method", ctx.Request.Method,
+			"route", ctx.FullPath(),
+			"path", ctx.Request.URL.Path,
+			"status", ctx.Writer.Status(),
+			"latency_ms", time.Since(startedAt).Milliseconds(),
+			"client_ip", ctx.ClientIP(),
+			"principal", session.Subject(ctx.Request.Context()),
+		}
+
+		if len(ctx.Errors) > 0 {
+			attributes = append(attributes, "errors", ctx.Errors.String())
+		}
+
+		logger.FromContext(ctx).Log(ctx, level, "request handled", attributes...)
+	}
+}
@@ -0,0 +1,95 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+)
+
+const (
+	APIKeyHeader        = "X-API-Key"
+	AuthorizationHeader = "Authorization"
+
+	bearerPrefix = "Bearer "
+)
+
+// Authenticate resolves the caller from the X-API-Key header or a bearer
+// token and stores the principal in the request context. Requests without
+// credentials go on anonymous so public routes keep working, while invalid
+// credentials are rejected on any route.
+func Authenticate(authService interfaces.AuthService) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		var principal *entity.Principal
+		var err error
+
+		if key := ctx.GetHeader(APIKeyHeader); key != "" {
+			principal, err = authService.AuthenticateAPIKey(ctx, key)
+		} else if authorization := ctx.GetHeader(AuthorizationHeader); authorization != "" {
+			token, ok := strings.CutPrefix(authorization, bearerPrefix)
+			if !ok {
+				err = domainerrors.ErrInvalidCredentials
+			} else {
+				principal, err = authService.AuthenticateToken(ctx, strings.TrimSpace(token))
+			}
+		} else {
+			ctx.Next()
+			return
+		}
+
+		if err != nil {
+			if errors.Is(err, domainerrors.ErrInvalidCredentials) {
+				unauthorized(ctx, constants.InvalidCredentials)
+				return
+			}
+
+			ctx.AbortWithStatusJSON(http.StatusInternalServerError, responses.ErrorResponse{
+				Error: err.Error(),
+			})
+			return
+		}
+
+		ctx.Request = ctx.Request.WithContext(session.WithPrincipal(ctx.Request.Context(), *principal))
+
+		ctx.Next()
+	}
+}
+
+// RequireRoles lets the request through when the principal holds any of the
+// roles, answering 401 to anonymous callers and 403 to the others.
+func RequireRoles(roles ...valueobjects.Role) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		principal, ok := session.PrincipalFromContext(ctx.Request.Context())
+		if !ok {
+			unauthorized(ctx, constants.AuthenticationRequired)
+			return
+		}
+
+		if !principal.HasAnyRole(roles...) {
+			logger.FromContext(ctx).WarnContext(ctx, "permission denied", "route", ctx.FullPath(), "roles", principal.Roles)
+			ctx.AbortWithStatusJSON(http.StatusForbidden, responses.ErrorResponse{
+				Error: constants.PermissionDenied,
+			})
+			return
+		}
+
+		ctx.Next()
+	}
+}
+
+func unauthorized(ctx *gin.Context, message string) {
+	ctx.Header("WWW-Authenticate", `Bearer, ApiKey header="`+APIKeyHeader+`"`)
+	ctx.AbortWithStatusJSON(http.StatusUnauthorized, responses.ErrorResponse{
+		Error: message,
+	})
+}
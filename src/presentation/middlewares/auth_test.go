@@ -0,0 +1,175 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+)
+
+func TestAuthenticate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	setup := func(authService *mocks.AuthService, subject *string) *gin.Engine {
+		app := gin.New()
+		app.ContextWithFallback = true
+		app.Use(Authenticate(authService))
+		app.GET("/", func(ctx *gin.Context) {
+			*subject = session.Subject(ctx)
+			ctx.Status(http.StatusOK)
+		})
+		return app
+	}
+
+	t.Run("should let anonymous request through", func(t *testing.T) {
+		var subject string
+		app := setup(mocks.NewAuthService(t), &subject)
+
+		recorder := httptest.NewRecorder()
+		app.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "anonymous", subject)
+	})
+
+	t.Run("should authenticate api key", func(t *testing.T) {
+		authServiceMocked := mocks.NewAuthService(t)
+
+		authServiceMocked.On("AuthenticateAPIKey", mock.Anything, "vps_some-key").
+			Return(&entity.Principal{Subject: "api_key:1"}, nil)
+
+		var subject string
+		app := setup(authServiceMocked, &subject)
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set(APIKeyHeader, "vps_some-key")
+		recorder := httptest.NewRecorder()
+
+		app.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "api_key:1", subject)
+	})
+
+	t.Run("should authenticate bearer token", func(t *testing.T) {
+		authServiceMocked := mocks.NewAuthService(t)
+
+		authServiceMocked.On("AuthenticateToken", mock.Anything, "some.jwt.token").
+			Return(&entity.Principal{Subject: "user-1"}, nil)
+
+		var subject string
+		app := setup(authServiceMocked, &subject)
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set(AuthorizationHeader, "Bearer some.jwt.token")
+		recorder := httptest.NewRecorder()
+
+		app.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, "user-1", subject)
+	})
+
+	t.Run("should reject invalid credentials", func(t *testing.T) {
+		authServiceMocked := mocks.NewAuthService(t)
+
+		authServiceMocked.On("AuthenticateAPIKey", mock.Anything, "vps_unknown").
+			Return(nil, domainerrors.ErrInvalidCredentials)
+
+		var subject string
+		app := setup(authServiceMocked, &subject)
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set(APIKeyHeader, "vps_unknown")
+		recorder := httptest.NewRecorder()
+
+		app.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+		assert.NotEmpty(t, recorder.Header().Get("WWW-Authenticate"))
+		assert.Empty(t, subject)
+	})
+
+	t.Run("should reject authorization header without bearer scheme", func(t *testing.T) {
+		var subject string
+		app := setup(mocks.NewAuthService(t), &subject)
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set(AuthorizationHeader, "Basic dXNlcjpwYXNz")
+		recorder := httptest.NewRecorder()
+
+		app.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("should fail when authentication fails unexpectedly", func(t *testing.T) {
+		authServiceMocked := mocks.NewAuthService(t)
+
+		authServiceMocked.On("AuthenticateAPIKey", mock.Anything, "vps_some-key").
+			Return(nil, errors.New("connection refused"))
+
+		var subject string
+		app := setup(authServiceMocked, &subject)
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set(APIKeyHeader, "vps_some-key")
+		recorder := httptest.NewRecorder()
+
+		app.ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusInternalServerError, recorder.Code)
+	})
+}
+
+func TestRequireRoles(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	setup := func(principal *entity.Principal) *gin.Engine {
+		app := gin.New()
+		app.Use(func(ctx *gin.Context) {
+			if principal != nil {
+				ctx.Request = ctx.Request.WithContext(session.WithPrincipal(ctx.Request.Context(), *principal))
+			}
+		})
+		app.GET("/", RequireRoles(valueobjects.RoleAdmin, valueobjects.RoleDealerStaff), func(ctx *gin.Context) {
+			ctx.Status(http.StatusOK)
+		})
+		return app
+	}
+
+	t.Run("should require authentication", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		setup(nil).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusUnauthorized, recorder.Code)
+	})
+
+	t.Run("should deny principal without any of the roles", func(t *testing.T) {
+		principal := &entity.Principal{Subject: "user-1", Roles: []valueobjects.Role{valueobjects.RoleBuyer}}
+
+		recorder := httptest.NewRecorder()
+		setup(principal).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusForbidden, recorder.Code)
+	})
+
+	t.Run("should allow principal with one of the roles", func(t *testing.T) {
+		principal := &entity.Principal{Subject: "user-1", Roles: []valueobjects.Role{valueobjects.RoleBuyer, valueobjects.RoleDealerStaff}}
+
+		recorder := httptest.NewRecorder()
+		setup(principal).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+	})
+}
@@ -0,0 +1,30 @@
+package middlewares
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/metrics"
+)
+
+// Metrics records the count and latency of the requests by route template,
+// so /vehicles/:entity_id is a single series regardless of the ID.
+func Metrics() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		startedAt := time.Now()
+
+		ctx.Next()
+
+		route := ctx.FullPath()
+		if route == "" {
+			route = "unmatched"
+		}
+
+		status := strconv.Itoa(ctx.Writer.Status())
+
+		metrics.HTTPRequestsTotal.WithLabelValues(route, ctx.Request.Method, status).Inc()
+		metrics.HTTPRequestDuration.WithLabelValues(route, ctx.Request.Method, status).Observe(time.Since(startedAt).Seconds())
+	}
+}
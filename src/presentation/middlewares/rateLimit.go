@@ -0,0 +1,114 @@
+package middlewares
+
+import (
+	"math"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+	"github.com/caiiomp/vehicle-platform-sales/src/metrics"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+	"github.com/caiiomp/vehicle-platform-sales/src/ratelimit"
+)
+
+// concurrencyRetryAfter is suggested when a concurrency cap is hit, since
+// there is no bucket telling when a slot frees up.
+const concurrencyRetryAfter = time.Second
+
+// RateLimitKey is one dimension a route is limited by, read from the request.
+// An empty value skips the dimension, as for anonymous callers.
+type RateLimitKey struct {
+	Name  string
+	Value func(ctx *gin.Context) string
+}
+
+var (
+	ClientIPKey = RateLimitKey{
+		Name: "ip",
+		Value: func(ctx *gin.Context) string {
+			return ctx.ClientIP()
+		},
+	}
+
+	// PrincipalKey is the API key or token subject of the caller.
+	PrincipalKey = RateLimitKey{
+		Name: "principal",
+		Value: func(ctx *gin.Context) string {
+			principal, _ := session.PrincipalFromContext(ctx)
+			return principal.Subject
+		},
+	}
+)
+
+// RateLimit spends a token of each key of the request from the buckets of
+// the group and answers 429 with Retry-After once one of them is empty. A nil
+// limiter disables the group. When the store fails the request goes through,
+// so an outage of a shared store does not take the routes down with it.
+func RateLimit(limiter *ratelimit.Limiter, group string, keys ...RateLimitKey) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if limiter == nil {
+			ctx.Next()
+			return
+		}
+
+		for _, key := range keys {
+			value := key.Value(ctx)
+			if value == "" {
+				continue
+			}
+
+			decision, err := limiter.Allow(ctx, group+":"+key.Name+":"+value)
+			if err != nil {
+				logger.FromContext(ctx).WarnContext(ctx, "error to check rate limit, letting the request through",
+					"group", group,
+					"key", key.Name,
+					"error", err,
+				)
+				continue
+			}
+
+			if !decision.Allowed {
+				metrics.RateLimitedTotal.WithLabelValues(group, key.Name).Inc()
+				tooManyRequests(ctx, decision.RetryAfter)
+				return
+			}
+		}
+
+		ctx.Next()
+	}
+}
+
+// ConcurrencyLimit holds a slot of the key while the request runs and answers
+// 429 when every slot is taken. A nil semaphore disables the cap.
+func ConcurrencyLimit(semaphore *ratelimit.Semaphore, group string, key RateLimitKey) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		if semaphore == nil {
+			ctx.Next()
+			return
+		}
+
+		release, ok := semaphore.TryAcquire(group + ":" + key.Name + ":" + key.Value(ctx))
+		if !ok {
+			metrics.RateLimitedTotal.WithLabelValues(group, key.Name).Inc()
+			tooManyRequests(ctx, concurrencyRetryAfter)
+			return
+		}
+		defer release()
+
+		ctx.Next()
+	}
+}
+
+func tooManyRequests(ctx *gin.Context, retryAfter time.Duration) {
+	seconds := max(int(math.Ceil(retryAfter.Seconds())), 1)
+
+	ctx.Header("Retry-After", strconv.Itoa(seconds))
+	ctx.AbortWithStatusJSON(http.StatusTooManyRequests, responses.ErrorResponse{
+		Error: constants.TooManyRequests,
+	})
+}
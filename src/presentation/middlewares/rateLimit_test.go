@@ -0,0 +1,137 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/ratelimit"
+)
+
+type failingStore struct{}
+
+func (failingStore) Take(context.Context, string, ratelimit.Limit, time.Time) (ratelimit.Decision, error) {
+	return ratelimit.Decision{}, errors.New("unexpected error")
+}
+
+func (failingStore) Prune(context.Context, time.Time) error {
+	return nil
+}
+
+func TestRateLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	now := func() time.Time { return time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC) }
+
+	headerKey := RateLimitKey{
+		Name: "header",
+		Value: func(ctx *gin.Context) string {
+			return ctx.GetHeader("X-Key")
+		},
+	}
+
+	setup := func(limiter *ratelimit.Limiter) *gin.Engine {
+		app := gin.New()
+		app.GET("/", RateLimit(limiter, "test", headerKey), func(ctx *gin.Context) {
+			ctx.Status(http.StatusOK)
+		})
+		return app
+	}
+
+	request := func(app *gin.Engine, key string) *httptest.ResponseRecorder {
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set("X-Key", key)
+		recorder := httptest.NewRecorder()
+		app.ServeHTTP(recorder, request)
+		return recorder
+	}
+
+	t.Run("should reject requests beyond the limit with retry after", func(t *testing.T) {
+		app := setup(ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.PerMinute(1, 1), now))
+
+		first := request(app, "a")
+		second := request(app, "a")
+		other := request(app, "b")
+
+		assert.Equal(t, http.StatusOK, first.Code)
+		assert.Equal(t, http.StatusTooManyRequests, second.Code)
+		assert.Equal(t, "60", second.Header().Get("Retry-After"))
+		assert.Equal(t, http.StatusOK, other.Code)
+	})
+
+	t.Run("should skip empty keys", func(t *testing.T) {
+		app := setup(ratelimit.NewLimiter(ratelimit.NewMemoryStore(), ratelimit.PerMinute(1, 1), now))
+
+		request(app, "")
+		actual := request(app, "")
+
+		assert.Equal(t, http.StatusOK, actual.Code)
+	})
+
+	t.Run("should let requests through when the store fails", func(t *testing.T) {
+		app := setup(ratelimit.NewLimiter(failingStore{}, ratelimit.PerMinute(1, 1), now))
+
+		actual := request(app, "a")
+
+		assert.Equal(t, http.StatusOK, actual.Code)
+	})
+
+	t.Run("should not limit without limiter", func(t *testing.T) {
+		app := setup(nil)
+
+		request(app, "a")
+		actual := request(app, "a")
+
+		assert.Equal(t, http.StatusOK, actual.Code)
+	})
+}
+
+func TestConcurrencyLimit(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("should reject requests while every slot is taken", func(t *testing.T) {
+		entered := make(chan struct{})
+		leave := make(chan struct{})
+
+		app := gin.New()
+		app.GET("/:id", ConcurrencyLimit(ratelimit.NewSemaphore(1), "test", RateLimitKey{
+			Name: "id",
+			Value: func(ctx *gin.Context) string {
+				return ctx.Param("id")
+			},
+		}), func(ctx *gin.Context) {
+			if ctx.Query("wait") != "" {
+				close(entered)
+				<-leave
+			}
+			ctx.Status(http.StatusOK)
+		})
+
+		serve := func(target string) *httptest.ResponseRecorder {
+			recorder := httptest.NewRecorder()
+			app.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+			return recorder
+		}
+
+		first := make(chan *httptest.ResponseRecorder)
+		go func() { first <- serve("/1?wait=true") }()
+		<-entered
+
+		busy := serve("/1")
+		other := serve("/2")
+
+		close(leave)
+
+		assert.Equal(t, http.StatusOK, (<-first).Code)
+		assert.Equal(t, http.StatusTooManyRequests, busy.Code)
+		assert.Equal(t, "1", busy.Header().Get("Retry-After"))
+		assert.Equal(t, http.StatusOK, other.Code)
+		assert.Equal(t, http.StatusOK, serve("/1").Code)
+	})
+}
@@ -0,0 +1,24 @@
+package middlewares
+
+import (
+	"io"
+	"net/http"
+	"runtime/debug"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+// Recovery turns panics into a 500 response and logs them as JSON instead of
+// gin's plain text output.
+func Recovery() gin.HandlerFunc {
+	return gin.CustomRecoveryWithWriter(io.Discard, func(ctx *gin.Context, recovered any) {
+		logger.FromContext(ctx).ErrorContext(ctx, "panic recovered",
+			"panic", recovered,
+			"stack", string(debug.Stack()),
+		)
+
+		ctx.AbortWithStatus(http.StatusInternalServerError)
+	})
+}
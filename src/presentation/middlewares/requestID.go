@@ -0,0 +1,43 @@
+package middlewares
+
+import (
+	"log/slog"
+	"regexp"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+const RequestIDHeader = "X-Request-ID"
+
+var validRequestID = regexp.MustCompile(`^[A-Za-z0-9._:-]{1,128}$`)
+
+// RequestID accepts the X-Request-ID sent by the caller, or generates one, and
+// returns it in the response. The ID and a logger carrying it, along with the
+// trace ID when the request is traced, are stored in the request context so
+// every layer logs with the same IDs.
+func RequestID(base *slog.Logger) gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestID := ctx.GetHeader(RequestIDHeader)
+		if !validRequestID.MatchString(requestID) {
+			requestID = uuid.NewString()
+		}
+
+		ctx.Header(RequestIDHeader, requestID)
+
+		requestLogger := base.With("request_id", requestID)
+
+		if spanContext := trace.SpanContextFromContext(ctx.Request.Context()); spanContext.IsValid() {
+			requestLogger = requestLogger.With("trace_id", spanContext.TraceID().String())
+		}
+
+		requestCtx := logger.WithRequestID(ctx.Request.Context(), requestID)
+		requestCtx = logger.WithContext(requestCtx, requestLogger)
+		ctx.Request = ctx.Request.WithContext(requestCtx)
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,59 @@
+package middlewares
+
+import (
+	"bytes"
+	"log/slog"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+func TestRequestID(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	base := slog.New(slog.NewJSONHandler(&bytes.Buffer{}, nil))
+
+	setup := func(requestID *string) *gin.Engine {
+		app := gin.New()
+		app.Use(RequestID(base))
+		app.GET("/", func(ctx *gin.Context) {
+			*requestID = logger.RequestIDFromContext(ctx.Request.Context())
+			ctx.Status(http.StatusOK)
+		})
+		return app
+	}
+
+	t.Run("should keep request id sent by the caller", func(t *testing.T) {
+		var requestID string
+		app := setup(&requestID)
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set(RequestIDHeader, "abc-123")
+		recorder := httptest.NewRecorder()
+
+		app.ServeHTTP(recorder, request)
+
+		assert.Equal(t, "abc-123", requestID)
+		assert.Equal(t, "abc-123", recorder.Header().Get(RequestIDHeader))
+	})
+
+	t.Run("should generate request id when caller sends an invalid one", func(t *testing.T) {
+		var requestID string
+		app := setup(&requestID)
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set(RequestIDHeader, "invalid id with spaces")
+		recorder := httptest.NewRecorder()
+
+		app.ServeHTTP(recorder, request)
+
+		assert.NotEqual(t, "invalid id with spaces", requestID)
+		assert.Len(t, requestID, 36)
+		assert.Equal(t, requestID, recorder.Header().Get(RequestIDHeader))
+	})
+}
@@ -0,0 +1,49 @@
+package middlewares
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+)
+
+const DealerIDHeader = "X-Dealer-ID"
+
+// Tenant stores the tenant scope of the request, so it must run after
+// Authenticate. A principal bound to a dealer only reaches that dealer.
+// Platform admins and callers bound to no dealer, such as buyers, the payments
+// system and anonymous visitors of the catalog, reach every dealer and may
+// narrow the scope to one with the X-Dealer-ID header.
+func Tenant() gin.HandlerFunc {
+	return func(ctx *gin.Context) {
+		requestCtx := ctx.Request.Context()
+
+		principal, _ := session.PrincipalFromContext(requestCtx)
+
+		if principal.DealerID != 0 && !principal.HasAnyRole(valueobjects.RolePlatformAdmin) {
+			requestCtx = tenant.WithDealer(requestCtx, principal.DealerID)
+		} else if header := ctx.GetHeader(DealerIDHeader); header != "" {
+			dealerID, err := strconv.Atoi(header)
+			if err != nil || dealerID <= 0 {
+				ctx.AbortWithStatusJSON(http.StatusBadRequest, responses.ErrorResponse{
+					Error: constants.InvalidDealerID,
+				})
+				return
+			}
+
+			requestCtx = tenant.WithDealer(requestCtx, dealerID)
+		} else {
+			requestCtx = tenant.WithAllDealers(requestCtx)
+		}
+
+		ctx.Request = ctx.Request.WithContext(requestCtx)
+
+		ctx.Next()
+	}
+}
@@ -0,0 +1,85 @@
+package middlewares
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/session"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+)
+
+func TestTenant(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	setup := func(principal *entity.Principal, scope *tenant.Scope) *gin.Engine {
+		app := gin.New()
+		app.Use(func(ctx *gin.Context) {
+			if principal != nil {
+				ctx.Request = ctx.Request.WithContext(session.WithPrincipal(ctx.Request.Context(), *principal))
+			}
+		})
+		app.Use(Tenant())
+		app.GET("/", func(ctx *gin.Context) {
+			*scope, _ = tenant.FromContext(ctx.Request.Context())
+			ctx.Status(http.StatusOK)
+		})
+		return app
+	}
+
+	t.Run("should scope dealer principal to its dealer", func(t *testing.T) {
+		var scope tenant.Scope
+		principal := &entity.Principal{Subject: "api_key:1", DealerID: 3, Roles: []valueobjects.Role{valueobjects.RoleDealerStaff}}
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set(DealerIDHeader, "4")
+		recorder := httptest.NewRecorder()
+
+		setup(principal, &scope).ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, tenant.Scope{DealerID: 3}, scope)
+	})
+
+	t.Run("should let platform admin reach every dealer", func(t *testing.T) {
+		scope := tenant.Scope{DealerID: -1}
+		principal := &entity.Principal{Subject: "api_key:1", Roles: []valueobjects.Role{valueobjects.RolePlatformAdmin}}
+
+		recorder := httptest.NewRecorder()
+		setup(principal, &scope).ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.True(t, scope.IsAll())
+	})
+
+	t.Run("should narrow the scope to the dealer of the header", func(t *testing.T) {
+		var scope tenant.Scope
+		principal := &entity.Principal{Subject: "api_key:1", Roles: []valueobjects.Role{valueobjects.RolePlatformAdmin}}
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set(DealerIDHeader, "4")
+		recorder := httptest.NewRecorder()
+
+		setup(principal, &scope).ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusOK, recorder.Code)
+		assert.Equal(t, tenant.Scope{DealerID: 4}, scope)
+	})
+
+	t.Run("should reject invalid dealer header", func(t *testing.T) {
+		var scope tenant.Scope
+
+		request := httptest.NewRequest(http.MethodGet, "/", nil)
+		request.Header.Set(DealerIDHeader, "abc")
+		recorder := httptest.NewRecorder()
+
+		setup(nil, &scope).ServeHTTP(recorder, request)
+
+		assert.Equal(t, http.StatusBadRequest, recorder.Code)
+	})
+}
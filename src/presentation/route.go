@@ -1,7 +1,41 @@
 package presentation
 
-import "github.com/gin-gonic/gin"
+import (
+	"log/slog"
+	"net/http"
 
-func SetupServer() *gin.Engine {
-	return gin.Default()
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/contrib/instrumentation/github.com/gin-gonic/gin/otelgin"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/middlewares"
+)
+
+// untracedPaths are polled by the infrastructure and would only add noise.
+var untracedPaths = map[string]bool{
+	"/healthz": true,
+	"/readyz":  true,
+	"/metrics": true,
+}
+
+func SetupServer(logger *slog.Logger, serviceName string, authService interfaces.AuthService) *gin.Engine {
+	app := gin.New()
+
+	// Lets values stored in the request context (logger, request ID, span) be
+	// read from the *gin.Context handed to the services.
+	app.ContextWithFallback = true
+
+	app.Use(
+		otelgin.Middleware(serviceName, otelgin.WithFilter(func(request *http.Request) bool {
+			return !untracedPaths[request.URL.Path]
+		})),
+		middlewares.RequestID(logger),
+		middlewares.AccessLog(),
+		middlewares.Metrics(),
+		middlewares.Recovery(),
+		middlewares.Authenticate(authService),
+		middlewares.Tenant(),
+	)
+
+	return app
 }
@@ -4,7 +4,16 @@ type saleQuery struct {
 	Status string `form:"status"`
 }
 
+type saleUri struct {
+	ID int `uri:"id" binding:"required"`
+}
+
 type saleWebhookRequest struct {
-	PaymentID string `json:"payment_id"`
+	PaymentID string `json:"payment_id" binding:"required"`
 	Status    string `json:"status"`
 }
+
+type installmentWebhookRequest struct {
+	PaymentID string `json:"payment_id" binding:"required"`
+	Number    int    `json:"number" binding:"required"`
+}
@@ -6,21 +6,35 @@ import (
 	"github.com/gin-gonic/gin"
 
 	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/metrics"
 	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/middlewares"
+	"github.com/caiiomp/vehicle-platform-sales/src/ratelimit"
 )
 
+const webhookRateLimitGroup = "webhook"
+
 type saleApi struct {
 	saleService interfaces.SaleService
 }
 
-func RegisterSaleRoutes(app *gin.Engine, saleService interfaces.SaleService) {
+// RegisterSaleRoutes limits the webhook by client IP and caller with
+// webhookLimiter, which may be nil to disable it.
+func RegisterSaleRoutes(app *gin.Engine, saleService interfaces.SaleService, webhookLimiter *ratelimit.Limiter) {
 	service := saleApi{
 		saleService: saleService,
 	}
 
-	app.GET("/sales", service.search)
-	app.POST("/sales/webhook", service.webhook)
+	staff := middlewares.RequireRoles(valueobjects.RolePlatformAdmin, valueobjects.RoleAdmin, valueobjects.RoleDealerStaff)
+	paymentsSystem := middlewares.RequireRoles(valueobjects.RolePaymentsSystem)
+	webhookRateLimit := middlewares.RateLimit(webhookLimiter, webhookRateLimitGroup, middlewares.ClientIPKey, middlewares.PrincipalKey)
+
+	app.GET("/sales", staff, service.search)
+	app.GET("/sales/:id/installments", staff, service.getInstallments)
+	app.POST("/sales/webhook", paymentsSystem, webhookRateLimit, service.webhook)
+	app.POST("/sales/installments/webhook", paymentsSystem, webhookRateLimit, service.installmentWebhook)
 }
 
 // Create godoc
@@ -30,7 +44,11 @@ func RegisterSaleRoutes(app *gin.Engine, saleService interfaces.SaleService) {
 // @Accept json
 // @Produce json
 // @Success 200 {array} responses.Sale
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
 // @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /sales [get]
 func (ref *saleApi) search(ctx *gin.Context) {
 	sales, err := ref.saleService.Search(ctx)
@@ -50,6 +68,50 @@ func (ref *saleApi) search(ctx *gin.Context) {
 	ctx.JSON(http.StatusOK, response)
 }
 
+// Create godoc
+// @Summary List Sale Installments
+// @Description List the amortization table of a financed sale, with the installments already paid. A sale paid in cash has no installments
+// @Tags Sale
+// @Accept json
+// @Produce json
+// @Param id path int true "Sale ID"
+// @Success 200 {object} responses.SaleInstallments
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /sales/{id}/installments [get]
+func (ref *saleApi) getInstallments(ctx *gin.Context) {
+	var uri saleUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	sale, installments, err := ref.saleService.GetInstallments(ctx, uri.ID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if sale == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.SaleDoesNotExist,
+		})
+		return
+	}
+
+	response := responses.SaleInstallmentsFromDomain(*sale, installments)
+	ctx.JSON(http.StatusOK, response)
+}
+
 // Create godoc
 // @Summary Sale Webhook
 // @Description Sale Webhook
@@ -59,20 +121,27 @@ func (ref *saleApi) search(ctx *gin.Context) {
 // @Param expected_webhook body saleApi.saleWebhookRequest true "Body"
 // @Success 204
 // @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
 // @Failure 404 {object} responses.ErrorResponse
+// @Failure 429 {object} responses.ErrorResponse
 // @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /sales/webhook [post]
 func (ref *saleApi) webhook(ctx *gin.Context) {
 	var request saleWebhookRequest
 	if err := ctx.ShouldBindJSON(&request); err != nil {
+		metrics.WebhooksTotal.WithLabelValues(metrics.WebhookOutcomeInvalid).Inc()
 		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
 			Error: err.Error(),
 		})
 		return
 	}
 
-	sale, err := ref.saleService.UpdateStatusByPaymentID(ctx, request.PaymentID, request.Status)
+	sale, finished, err := ref.saleService.UpdateStatusByPaymentID(ctx, request.PaymentID, request.Status)
 	if err != nil {
+		metrics.WebhooksTotal.WithLabelValues(metrics.WebhookOutcomeError).Inc()
 		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
 			Error: err.Error(),
 		})
@@ -80,11 +149,67 @@ func (ref *saleApi) webhook(ctx *gin.Context) {
 	}
 
 	if sale == nil {
+		metrics.WebhooksTotal.WithLabelValues(metrics.WebhookOutcomeNotFound).Inc()
 		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
 			Error: constants.SaleDoesNotExist,
 		})
 		return
 	}
 
+	metrics.WebhooksTotal.WithLabelValues(metrics.WebhookOutcomeUpdated).Inc()
+
+	if finished {
+		metrics.SalesFinishedTotal.WithLabelValues(sale.Status.String()).Inc()
+	}
+
+	ctx.JSON(http.StatusNoContent, nil)
+}
+
+// Create godoc
+// @Summary Sale Installment Webhook
+// @Description Sale Installment Webhook, called by the payments platform when an installment of a financed sale is paid. Repeated calls keep the time of the first one
+// @Tags Sale
+// @Accept json
+// @Produce json
+// @Param expected_webhook body saleApi.installmentWebhookRequest true "Body"
+// @Success 204
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 429 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /sales/installments/webhook [post]
+func (ref *saleApi) installmentWebhook(ctx *gin.Context) {
+	var request installmentWebhookRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		metrics.WebhooksTotal.WithLabelValues(metrics.WebhookOutcomeInvalid).Inc()
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	installment, err := ref.saleService.PayInstallment(ctx, request.PaymentID, request.Number)
+	if err != nil {
+		metrics.WebhooksTotal.WithLabelValues(metrics.WebhookOutcomeError).Inc()
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if installment == nil {
+		metrics.WebhooksTotal.WithLabelValues(metrics.WebhookOutcomeNotFound).Inc()
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.InstallmentDoesNotExist,
+		})
+		return
+	}
+
+	metrics.WebhooksTotal.WithLabelValues(metrics.WebhookOutcomeUpdated).Inc()
+
 	ctx.JSON(http.StatusNoContent, nil)
 }
@@ -1,26 +1,62 @@
 package vehicleApi
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/middlewares"
 )
 
+// createVehicleRequest takes DealerID from platform admins only, dealer staff
+// always create vehicles in their own dealer. VehicleID is generated by the
+// service when left empty. The attributes after price are optional and
+// validated by the service.
 type createVehicleRequest struct {
-	VehicleID string  `json:"vehicle_id" binding:"required"`
-	Brand     string  `json:"brand" binding:"required"`
-	Model     string  `json:"model" binding:"required"`
-	Year      int     `json:"year" binding:"required"`
-	Color     string  `json:"color" binding:"required"`
-	Price     float64 `json:"price" binding:"required"`
+	DealerID           int     `json:"dealer_id"`
+	VehicleID          string  `json:"vehicle_id"`
+	Brand              string  `json:"brand" binding:"required"`
+	Model              string  `json:"model" binding:"required"`
+	Year               int     `json:"year" binding:"required"`
+	Color              string  `json:"color" binding:"required"`
+	Price              float64 `json:"price" binding:"required"`
+	Mileage            int     `json:"mileage"`
+	FuelType           string  `json:"fuel_type" enums:"FLEX,GASOLINE,ETHANOL,DIESEL,ELECTRIC,HYBRID"`
+	Transmission       string  `json:"transmission" enums:"MANUAL,AUTOMATIC,AUTOMATED,CVT"`
+	BodyType           string  `json:"body_type" enums:"HATCH,SEDAN,SUV,PICKUP,COUPE,CONVERTIBLE,WAGON,MINIVAN,VAN"`
+	Condition          string  `json:"condition" enums:"NEW,USED,CERTIFIED"`
+	Doors              int     `json:"doors"`
+	EngineDisplacement int     `json:"engine_displacement"`
+	LicensePlate       string  `json:"license_plate" example:"ABC1D23"`
+	VIN                string  `json:"vin" example:"9BWZZZ377VT004251"`
 }
 
 func (ref createVehicleRequest) ToDomain() *entity.Vehicle {
 	return &entity.Vehicle{
-		EntityID: ref.VehicleID,
-		Brand:    ref.Brand,
-		Model:    ref.Model,
-		Year:     ref.Year,
-		Color:    ref.Color,
-		Price:    ref.Price,
+		EntityID:           ref.VehicleID,
+		DealerID:           ref.DealerID,
+		Brand:              ref.Brand,
+		Model:              ref.Model,
+		Year:               ref.Year,
+		Color:              ref.Color,
+		Price:              ref.Price,
+		Mileage:            ref.Mileage,
+		FuelType:           valueobjects.FuelType(ref.FuelType),
+		Transmission:       valueobjects.Transmission(ref.Transmission),
+		BodyType:           valueobjects.BodyType(ref.BodyType),
+		Condition:          valueobjects.VehicleCondition(ref.Condition),
+		Doors:              ref.Doors,
+		EngineDisplacement: ref.EngineDisplacement,
+		LicensePlate:       valueobjects.LicensePlate(ref.LicensePlate),
+		VIN:                valueobjects.VIN(ref.VIN),
 	}
 }
 
@@ -28,28 +64,217 @@ type entityUri struct {
 	EntityID string `uri:"entity_id" binding:"required"`
 }
 
+type slugUri struct {
+	Slug string `uri:"slug" binding:"required"`
+}
+
+type mediaUri struct {
+	EntityID string `uri:"entity_id" binding:"required"`
+	MediaID  string `uri:"media_id" binding:"required"`
+}
+
+// reorderMediaRequest lists every media of the vehicle in its new order.
+type reorderMediaRequest struct {
+	MediaIDs []string `json:"media_ids" binding:"required"`
+}
+
+// updateVehicleRequest documents the merge patch body of PATCH, which is read
+// by parsePatch rather than bound, so absent and null fields can be told apart.
 type updateVehicleRequest struct {
-	Brand string  `json:"brand"`
-	Model string  `json:"model"`
-	Year  int     `json:"year"`
-	Color string  `json:"color"`
-	Price float64 `json:"price"`
+	Brand              string  `json:"brand,omitempty"`
+	Model              string  `json:"model,omitempty"`
+	Year               int     `json:"year,omitempty"`
+	Color              string  `json:"color,omitempty"`
+	Price              float64 `json:"price,omitempty"`
+	Mileage            int     `json:"mileage,omitempty"`
+	FuelType           string  `json:"fuel_type,omitempty" enums:"FLEX,GASOLINE,ETHANOL,DIESEL,ELECTRIC,HYBRID"`
+	Transmission       string  `json:"transmission,omitempty" enums:"MANUAL,AUTOMATIC,AUTOMATED,CVT"`
+	BodyType           string  `json:"body_type,omitempty" enums:"HATCH,SEDAN,SUV,PICKUP,COUPE,CONVERTIBLE,WAGON,MINIVAN,VAN"`
+	Condition          string  `json:"condition,omitempty" enums:"NEW,USED,CERTIFIED"`
+	Doors              int     `json:"doors,omitempty"`
+	EngineDisplacement int     `json:"engine_displacement,omitempty"`
+	LicensePlate       string  `json:"license_plate,omitempty"`
+	VIN                string  `json:"vin,omitempty"`
 }
 
-func (ref updateVehicleRequest) ToDomain() *entity.Vehicle {
-	return &entity.Vehicle{
-		Brand: ref.Brand,
-		Model: ref.Model,
-		Year:  ref.Year,
-		Color: ref.Color,
-		Price: ref.Price,
+type vehicleQuery struct {
+	IsSold       *bool  `form:"is_sold"`
+	Status       string `form:"status"`
+	FuelType     string `form:"fuel_type"`
+	Transmission string `form:"transmission"`
+	BodyType     string `form:"body_type"`
+	Condition    string `form:"condition"`
+	Doors        int    `form:"doors"`
+	MaxMileage   *int   `form:"max_mileage"`
+}
+
+func (ref vehicleQuery) ToDomain() entity.VehicleFilter {
+	return entity.VehicleFilter{
+		IsSold:       ref.IsSold,
+		Status:       valueobjects.ListingStatus(ref.Status),
+		FuelType:     valueobjects.FuelType(ref.FuelType),
+		Transmission: valueobjects.Transmission(ref.Transmission),
+		BodyType:     valueobjects.BodyType(ref.BodyType),
+		Condition:    valueobjects.VehicleCondition(ref.Condition),
+		Doors:        ref.Doors,
+		MaxMileage:   ref.MaxMileage,
 	}
 }
 
-type vehicleQuery struct {
-	IsSold *bool `form:"is_sold"`
+// financingSimulationQuery leaves DownPayment at zero to finance the whole
+// price.
+type financingSimulationQuery struct {
+	DownPayment float64 `form:"down_payment"`
+	Months      int     `form:"months" binding:"required"`
 }
 
+// Validate refuses the NaN and infinite down payments the binding parses.
+func (ref financingSimulationQuery) Validate() error {
+	if math.IsNaN(ref.DownPayment) || math.IsInf(ref.DownPayment, 0) {
+		return errors.New("down_payment must be a finite number")
+	}
+
+	return nil
+}
+
+type offerUri struct {
+	EntityID string `uri:"entity_id" binding:"required"`
+	OfferID  string `uri:"offer_id" binding:"required"`
+}
+
+type createOfferRequest struct {
+	BuyerDocumentNumber string  `json:"buyer_document_number" binding:"required"`
+	Amount              float64 `json:"amount" binding:"required"`
+}
+
+type counterOfferRequest struct {
+	Amount float64 `json:"amount" binding:"required"`
+}
+
+// publishVehicleRequest schedules the publication of a vehicle at PublishAt.
+// The body may be left out to publish it right away.
+type publishVehicleRequest struct {
+	PublishAt *time.Time `json:"publish_at" example:"2026-11-01T09:00:00Z"`
+}
+
+// buyVehicleRequest takes an optional CouponCode of the dealer of the vehicle,
+// an optional TradeIn, which only the dealer staff may send, and an optional
+// Financing of the amount due.
 type buyVehicleRequest struct {
-	BuyerDocumentNumber string `json:"buyer_document_number" binding:"required"`
+	BuyerDocumentNumber string            `json:"buyer_document_number" binding:"required"`
+	CouponCode          string            `json:"coupon_code" example:"BLACKFRIDAY"`
+	TradeIn             *tradeInRequest   `json:"trade_in"`
+	Financing           *financingRequest `json:"financing"`
+}
+
+// financingRequest picks a financing plan of the dealer of the vehicle, the
+// down payment and the number of monthly installments.
+type financingRequest struct {
+	PlanID      int     `json:"plan_id" binding:"required"`
+	DownPayment float64 `json:"down_payment" example:"20000"`
+	Months      int     `json:"months" binding:"required" example:"48"`
+}
+
+// tradeInRequest describes the vehicle traded in and the value the staff
+// appraised it at, taken off the amount paid.
+type tradeInRequest struct {
+	Brand          string  `json:"brand" binding:"required"`
+	Model          string  `json:"model" binding:"required"`
+	Year           int     `json:"year" binding:"required"`
+	Color          string  `json:"color" binding:"required"`
+	Mileage        int     `json:"mileage"`
+	LicensePlate   string  `json:"license_plate" example:"ABC1D23"`
+	VIN            string  `json:"vin" example:"9BWZZZ377VT004251"`
+	AppraisedValue float64 `json:"appraised_value" binding:"required"`
+}
+
+func (ref buyVehicleRequest) ToDomain() entity.Purchase {
+	purchase := entity.Purchase{
+		BuyerDocumentNumber: ref.BuyerDocumentNumber,
+		CouponCode:          ref.CouponCode,
+	}
+
+	if tradeIn := ref.TradeIn; tradeIn != nil {
+		purchase.TradeIn = &entity.TradeIn{
+			Brand:          tradeIn.Brand,
+			Model:          tradeIn.Model,
+			Year:           tradeIn.Year,
+			Color:          tradeIn.Color,
+			Mileage:        tradeIn.Mileage,
+			LicensePlate:   valueobjects.LicensePlate(tradeIn.LicensePlate),
+			VIN:            valueobjects.VIN(tradeIn.VIN),
+			AppraisedValue: tradeIn.AppraisedValue,
+		}
+	}
+
+	if financing := ref.Financing; financing != nil {
+		purchase.Financing = &entity.FinancingChoice{
+			PlanID:      financing.PlanID,
+			DownPayment: financing.DownPayment,
+			Months:      financing.Months,
+		}
+	}
+
+	return purchase
+}
+
+// buyerDocumentKey limits buys by the hash of the buyer document, so the
+// document itself is not kept by the rate limit store. The body is cached by
+// gin, which lets the handler bind it again.
+var buyerDocumentKey = middlewares.RateLimitKey{
+	Name: "document",
+	Value: func(ctx *gin.Context) string {
+		var request buyVehicleRequest
+		if err := ctx.ShouldBindBodyWithJSON(&request); err != nil {
+			return ""
+		}
+
+		sum := sha256.Sum256([]byte(request.BuyerDocumentNumber))
+		return hex.EncodeToString(sum[:])
+	},
+}
+
+var vehicleKey = middlewares.RateLimitKey{
+	Name: "vehicle",
+	Value: func(ctx *gin.Context) string {
+		return ctx.Param("entity_id")
+	},
+}
+
+// vehicleETag is a strong entity tag of the vehicle version, which changes on
+// every update of the vehicle.
+func vehicleETag(vehicle entity.Vehicle) string {
+	return `"` + strconv.Itoa(vehicle.Version) + `"`
+}
+
+// ifMatchVersion reads the version from an If-Match header holding a single
+// ETag returned by this API. Weak tags never match, as If-Match compares
+// strongly.
+func ifMatchVersion(header string) (int, bool) {
+	tag := strings.TrimSpace(header)
+
+	if len(tag) < 2 || tag[0] != '"' || tag[len(tag)-1] != '"' {
+		return 0, false
+	}
+
+	version, err := strconv.Atoi(tag[1 : len(tag)-1])
+	if err != nil || version < 1 {
+		return 0, false
+	}
+
+	return version, true
+}
+
+// noneMatch tells whether an If-None-Match header matches etag, comparing the
+// tags in the list weakly.
+func noneMatch(header, etag string) bool {
+	for _, tag := range strings.Split(header, ",") {
+		tag = strings.TrimSpace(tag)
+
+		if tag == "*" || strings.TrimPrefix(tag, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
 }
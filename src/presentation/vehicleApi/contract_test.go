@@ -1,28 +1,41 @@
 package vehicleApi
 
 import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
 	"testing"
 
+	"github.com/gin-gonic/gin"
 	"github.com/stretchr/testify/assert"
 
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 )
 
 func Test_createVehicleRequestToDomain(t *testing.T) {
 	request := createVehicleRequest{
-		Brand: "Some Brand",
-		Model: "Some Model",
-		Year:  2025,
-		Color: "Gray",
-		Price: 80000,
+		Brand:        "Some Brand",
+		Model:        "Some Model",
+		Year:         2025,
+		Color:        "Gray",
+		Price:        80000,
+		Mileage:      12000,
+		FuelType:     "flex",
+		Doors:        4,
+		LicensePlate: "ABC1D23",
 	}
 
 	expected := &entity.Vehicle{
-		Brand: "Some Brand",
-		Model: "Some Model",
-		Year:  2025,
-		Color: "Gray",
-		Price: 80000,
+		Brand:        "Some Brand",
+		Model:        "Some Model",
+		Year:         2025,
+		Color:        "Gray",
+		Price:        80000,
+		Mileage:      12000,
+		FuelType:     "flex",
+		Doors:        4,
+		LicensePlate: "ABC1D23",
 	}
 
 	actual := request.ToDomain()
@@ -30,24 +43,178 @@ func Test_createVehicleRequestToDomain(t *testing.T) {
 	assert.Equal(t, expected, actual)
 }
 
-func Test_updateVehicleRequestToDomain(t *testing.T) {
-	request := updateVehicleRequest{
-		Brand: "Some Brand",
-		Model: "Some Model",
-		Year:  2025,
-		Color: "Gray",
-		Price: 80000,
+func Test_vehicleQueryToDomain(t *testing.T) {
+	isSold := false
+	maxMileage := 50000
+
+	query := vehicleQuery{
+		IsSold:     &isSold,
+		Status:     "DRAFT",
+		BodyType:   "SUV",
+		Doors:      4,
+		MaxMileage: &maxMileage,
 	}
 
-	expected := &entity.Vehicle{
-		Brand: "Some Brand",
-		Model: "Some Model",
-		Year:  2025,
-		Color: "Gray",
-		Price: 80000,
+	expected := entity.VehicleFilter{
+		IsSold:     &isSold,
+		Status:     valueobjects.ListingStatusDraft,
+		BodyType:   valueobjects.BodyTypeSUV,
+		Doors:      4,
+		MaxMileage: &maxMileage,
 	}
 
-	actual := request.ToDomain()
+	assert.Equal(t, expected, query.ToDomain())
+}
 
-	assert.Equal(t, expected, actual)
+func Test_buyVehicleRequestToDomain(t *testing.T) {
+	t.Run("should buy without trade-in", func(t *testing.T) {
+		request := buyVehicleRequest{BuyerDocumentNumber: "12345678900", CouponCode: "BLACKFRIDAY"}
+
+		expected := entity.Purchase{BuyerDocumentNumber: "12345678900", CouponCode: "BLACKFRIDAY"}
+
+		assert.Equal(t, expected, request.ToDomain())
+	})
+
+	t.Run("should buy with trade-in", func(t *testing.T) {
+		request := buyVehicleRequest{
+			BuyerDocumentNumber: "12345678900",
+			TradeIn: &tradeInRequest{
+				Brand:          "Ford",
+				Model:          "Ka",
+				Year:           2015,
+				Color:          "Red",
+				LicensePlate:   "ABC1D23",
+				AppraisedValue: 20000,
+			},
+		}
+
+		expected := entity.Purchase{
+			BuyerDocumentNumber: "12345678900",
+			TradeIn: &entity.TradeIn{
+				Brand:          "Ford",
+				Model:          "Ka",
+				Year:           2015,
+				Color:          "Red",
+				LicensePlate:   "ABC1D23",
+				AppraisedValue: 20000,
+			},
+		}
+
+		assert.Equal(t, expected, request.ToDomain())
+	})
+
+	t.Run("should buy financed", func(t *testing.T) {
+		request := buyVehicleRequest{
+			BuyerDocumentNumber: "12345678900",
+			Financing:           &financingRequest{PlanID: 2, DownPayment: 20000, Months: 48},
+		}
+
+		expected := entity.Purchase{
+			BuyerDocumentNumber: "12345678900",
+			Financing:           &entity.FinancingChoice{PlanID: 2, DownPayment: 20000, Months: 48},
+		}
+
+		assert.Equal(t, expected, request.ToDomain())
+	})
+}
+
+func Test_financingSimulationQueryValidate(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	bind := func(rawQuery string) financingSimulationQuery {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodGet, "/vehicles/1/financing-simulation?"+rawQuery, nil)
+
+		var query financingSimulationQuery
+		assert.Nil(t, ctx.ShouldBindQuery(&query))
+		return query
+	}
+
+	t.Run("should accept finite down payment", func(t *testing.T) {
+		assert.Nil(t, bind("down_payment=12000&months=12").Validate())
+	})
+
+	t.Run("should refuse down payment that is not finite", func(t *testing.T) {
+		for _, downPayment := range []string{"NaN", "Inf", "-Inf"} {
+			assert.NotNil(t, bind("down_payment="+downPayment+"&months=12").Validate(), downPayment)
+		}
+	})
+}
+
+func Test_buyerDocumentKey(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	newContext := func(body string) *gin.Context {
+		ctx, _ := gin.CreateTestContext(httptest.NewRecorder())
+		ctx.Request = httptest.NewRequest(http.MethodPost, "/vehicles/1/buy", strings.NewReader(body))
+		return ctx
+	}
+
+	t.Run("should hash the buyer document and keep the body for the handler", func(t *testing.T) {
+		ctx := newContext(`{"buyer_document_number": "12345678900"}`)
+
+		actual := buyerDocumentKey.Value(ctx)
+
+		var request buyVehicleRequest
+		err := ctx.ShouldBindBodyWithJSON(&request)
+
+		assert.Len(t, actual, 64)
+		assert.NotContains(t, actual, "12345678900")
+		assert.Nil(t, err)
+		assert.Equal(t, "12345678900", request.BuyerDocumentNumber)
+	})
+
+	t.Run("should skip invalid body", func(t *testing.T) {
+		ctx := newContext(`{}`)
+
+		assert.Empty(t, buyerDocumentKey.Value(ctx))
+	})
+}
+
+func Test_vehicleETag(t *testing.T) {
+	actual := vehicleETag(entity.Vehicle{Version: 3})
+
+	assert.Equal(t, `"3"`, actual)
+}
+
+func Test_ifMatchVersion(t *testing.T) {
+	t.Run("should read version from etag", func(t *testing.T) {
+		actual, ok := ifMatchVersion(` "3" `)
+
+		assert.True(t, ok)
+		assert.Equal(t, 3, actual)
+	})
+
+	t.Run("should not match weak etag", func(t *testing.T) {
+		_, ok := ifMatchVersion(`W/"3"`)
+
+		assert.False(t, ok)
+	})
+
+	t.Run("should not match invalid etag", func(t *testing.T) {
+		for _, header := range []string{`*`, `3`, `"abc"`, `"0"`, `"1", "2"`} {
+			_, ok := ifMatchVersion(header)
+
+			assert.False(t, ok, header)
+		}
+	})
+}
+
+func Test_noneMatch(t *testing.T) {
+	t.Run("should match etag in list", func(t *testing.T) {
+		assert.True(t, noneMatch(`"1", "3"`, `"3"`))
+	})
+
+	t.Run("should match weak etag", func(t *testing.T) {
+		assert.True(t, noneMatch(`W/"3"`, `"3"`))
+	})
+
+	t.Run("should match any etag with wildcard", func(t *testing.T) {
+		assert.True(t, noneMatch(`*`, `"3"`))
+	})
+
+	t.Run("should not match other etag", func(t *testing.T) {
+		assert.False(t, noneMatch(`"2"`, `"3"`))
+		assert.False(t, noneMatch(``, `"3"`))
+	})
 }
@@ -0,0 +1,75 @@
+package vehicleApi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+)
+
+// Create godoc
+// @Summary Simulate Financing
+// @Description Simulate financing the current price of a vehicle less down_payment in months installments, under both the PRICE and the SAC amortization systems. The monthly interest rate comes from the rate table for the term, and the fees are financed along with the principal and counted in the CET, the effective total cost
+// @Tags Vehicle
+// @Accept json
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Param down_payment query number false "Down payment, zero when left out"
+// @Param months query integer true "Number of monthly installments"
+// @Success 200 {object} responses.FinancingSimulation
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Router /vehicles/{entity_id}/financing-simulation [get]
+func (ref *vehicleApi) simulateFinancing(ctx *gin.Context) {
+	var uri entityUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	var query financingSimulationQuery
+	if err := ctx.ShouldBindQuery(&query); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if err := query.Validate(); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	simulation, err := ref.financingSimulationService.Simulate(ctx, uri.EntityID, query.DownPayment, query.Months)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domainerrors.ErrInvalidArgument):
+			statusCode = http.StatusBadRequest
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if simulation == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.VehicleDoesNotExist,
+		})
+		return
+	}
+
+	response := responses.FinancingSimulationFromDomain(*simulation)
+	ctx.JSON(http.StatusOK, response)
+}
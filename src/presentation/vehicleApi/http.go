@@ -1,42 +1,98 @@
 package vehicleApi
 
 import (
+	"errors"
+	"io"
 	"net/http"
+	"net/url"
 
 	"github.com/gin-gonic/gin"
 
 	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/metrics"
 	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/middlewares"
+	"github.com/caiiomp/vehicle-platform-sales/src/ratelimit"
 )
 
+// buyRateLimitGroup names the buckets of the buy route, which creates a
+// payment on every call.
+const buyRateLimitGroup = "buy"
+
 type vehicleApi struct {
-	vehicleService interfaces.VehicleService
+	vehicleService             interfaces.VehicleService
+	mediaService               interfaces.VehicleMediaService
+	offerService               interfaces.OfferService
+	financingSimulationService interfaces.FinancingSimulationService
 }
 
-func RegisterVehicleRoutes(app *gin.Engine, vehicleService interfaces.VehicleService) {
+// RegisterVehicleRoutes limits the buy route by client IP, caller and buyer
+// document with buyLimiter, and caps the buys running at once for a vehicle
+// with buyConcurrency. Either may be nil to disable it.
+func RegisterVehicleRoutes(app *gin.Engine, vehicleService interfaces.VehicleService, mediaService interfaces.VehicleMediaService, offerService interfaces.OfferService, financingSimulationService interfaces.FinancingSimulationService, buyLimiter *ratelimit.Limiter, buyConcurrency *ratelimit.Semaphore) {
 	service := vehicleApi{
-		vehicleService: vehicleService,
+		vehicleService:             vehicleService,
+		mediaService:               mediaService,
+		offerService:               offerService,
+		financingSimulationService: financingSimulationService,
 	}
 
-	app.POST("/vehicles", service.create)
+	staff := middlewares.RequireRoles(valueobjects.RolePlatformAdmin, valueobjects.RoleAdmin, valueobjects.RoleDealerStaff)
+	buyers := middlewares.RequireRoles(valueobjects.RolePlatformAdmin, valueobjects.RoleAdmin, valueobjects.RoleDealerStaff, valueobjects.RoleBuyer)
+
+	app.POST("/vehicles", staff, service.create)
 	app.GET("/vehicles", service.search)
+	app.GET("/vehicles/by-slug/:slug", service.getBySlug)
 	app.GET("/vehicles/:entity_id", service.get)
-	app.PATCH("/vehicles/:entity_id", service.update)
-	app.POST("/vehicles/:entity_id/buy", service.buy)
+	app.PATCH("/vehicles/:entity_id", staff, service.update)
+	app.DELETE("/vehicles/:entity_id", staff, service.delete)
+	app.POST("/vehicles/:entity_id/publish", staff, service.publish)
+	app.POST("/vehicles/:entity_id/unpublish", staff, service.unpublish)
+	app.POST("/vehicles/:entity_id/buy", buyers,
+		middlewares.RateLimit(buyLimiter, buyRateLimitGroup, middlewares.ClientIPKey, middlewares.PrincipalKey, buyerDocumentKey),
+		middlewares.ConcurrencyLimit(buyConcurrency, buyRateLimitGroup, vehicleKey),
+		service.buy,
+	)
+
+	app.POST("/vehicles/:entity_id/media", staff, service.uploadMedia)
+	app.GET("/vehicles/:entity_id/media", service.searchMedia)
+	app.PUT("/vehicles/:entity_id/media", staff, service.reorderMedia)
+	app.GET("/vehicles/:entity_id/media/:media_id", service.getMedia)
+	app.DELETE("/vehicles/:entity_id/media/:media_id", staff, service.deleteMedia)
+	app.GET("/vehicles/:entity_id/media/:media_id/thumbnail", service.getMediaThumbnail)
+	app.PUT("/vehicles/:entity_id/media/:media_id/cover", staff, service.setMediaCover)
+
+	app.POST("/vehicles/:entity_id/offers", buyers, service.createOffer)
+	app.GET("/vehicles/:entity_id/offers", buyers, service.searchOffers)
+	app.POST("/vehicles/:entity_id/offers/:offer_id/accept", buyers, service.acceptOffer)
+	app.POST("/vehicles/:entity_id/offers/:offer_id/reject", buyers, service.rejectOffer)
+	app.POST("/vehicles/:entity_id/offers/:offer_id/counter", staff, service.counterOffer)
+
+	app.GET("/vehicles/:entity_id/financing-simulation", service.simulateFinancing)
 }
 
 // Create godoc
 // @Summary Create Vehicle
-// @Description Create vehicle
+// @Description Create vehicle. Dealer staff create it in their own dealer, platform admins name the dealer with dealer_id. A vehicle_id left empty is generated as a UUIDv7
 // @Tags Vehicle
 // @Accept json
 // @Produce json
 // @Param vehicle body vehicleApi.createVehicleRequest true "Body"
 // @Success 201 {object} responses.Vehicle
+// @Header 201 {string} ETag "Version of the vehicle"
+// @Header 201 {string} Location "Path of the vehicle"
 // @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
 // @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
 // @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /vehicles [post]
 func (ref *vehicleApi) create(ctx *gin.Context) {
 	var request createVehicleRequest
@@ -49,7 +105,15 @@ func (ref *vehicleApi) create(ctx *gin.Context) {
 
 	vehicle, err := ref.vehicleService.Create(ctx, *request.ToDomain())
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domainerrors.ErrAlreadyExists):
+			statusCode = http.StatusConflict
+		case errors.Is(err, domainerrors.ErrReferenceNotFound), errors.Is(err, domainerrors.ErrInvalidArgument):
+			statusCode = http.StatusBadRequest
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
 			Error: err.Error(),
 		})
 		return
@@ -63,16 +127,25 @@ func (ref *vehicleApi) create(ctx *gin.Context) {
 	}
 
 	response := responses.VehicleFromDomain(*vehicle)
+	ctx.Header("ETag", vehicleETag(*vehicle))
+	ctx.Header("Location", "/vehicles/"+url.PathEscape(vehicle.EntityID))
 	ctx.JSON(http.StatusCreated, response)
 }
 
 // Create godoc
 // @Summary Search vehicles
-// @Description Seach vehicles
+// @Description Seach vehicles. Callers that are not staff only find the published vehicles
 // @Tags Vehicle
 // @Accept json
 // @Produce json
 // @Param is_sold query boolean false "Filter vehicles by sold status"
+// @Param fuel_type query string false "Filter vehicles by fuel type" Enums(FLEX, GASOLINE, ETHANOL, DIESEL, ELECTRIC, HYBRID)
+// @Param transmission query string false "Filter vehicles by transmission" Enums(MANUAL, AUTOMATIC, AUTOMATED, CVT)
+// @Param body_type query string false "Filter vehicles by body type" Enums(HATCH, SEDAN, SUV, PICKUP, COUPE, CONVERTIBLE, WAGON, MINIVAN, VAN)
+// @Param condition query string false "Filter vehicles by condition" Enums(NEW, USED, CERTIFIED)
+// @Param doors query integer false "Filter vehicles by number of doors"
+// @Param max_mileage query integer false "Filter vehicles up to this mileage, in kilometers"
+// @Param status query string false "Filter vehicles by listing status. Only staff see vehicles that are not published" Enums(DRAFT, PUBLISHED, UNLISTED)
 // @Success 200 {array} responses.Vehicle
 // @Failure 400 {object} responses.ErrorResponse
 // @Failure 500 {object} responses.ErrorResponse
@@ -86,18 +159,26 @@ func (ref *vehicleApi) search(ctx *gin.Context) {
 		return
 	}
 
-	vehicles, err := ref.vehicleService.Search(ctx, query.IsSold)
+	vehicles, err := ref.vehicleService.Search(ctx, query.ToDomain())
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domainerrors.ErrInvalidArgument):
+			statusCode = http.StatusBadRequest
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
 			Error: err.Error(),
 		})
 		return
 	}
 
-	response := make([]responses.Vehicle, len(vehicles))
-
-	for i, vehicle := range vehicles {
-		response[i] = responses.VehicleFromDomain(vehicle)
+	response, err := ref.vehicleResponses(ctx, vehicles...)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
 	}
 
 	ctx.JSON(http.StatusOK, response)
@@ -105,12 +186,15 @@ func (ref *vehicleApi) search(ctx *gin.Context) {
 
 // Create godoc
 // @Summary Get Vehicle
-// @Description Get vehicle
+// @Description Get vehicle. Vehicles that are not published are only found by staff
 // @Tags Vehicle
 // @Accept json
 // @Produce json
 // @Param entity_id path string true "Entity ID"
+// @Param If-None-Match header string false "ETag of a previously read vehicle"
 // @Success 200 {object} responses.Vehicle
+// @Header 200 {string} ETag "Version of the vehicle"
+// @Success 304 "Vehicle not modified"
 // @Failure 400 {object} responses.ErrorResponse
 // @Failure 404 {object} responses.ErrorResponse
 // @Failure 500 {object} responses.ErrorResponse
@@ -132,6 +216,46 @@ func (ref *vehicleApi) get(ctx *gin.Context) {
 		return
 	}
 
+	ref.writeVehicle(ctx, vehicle)
+}
+
+// Create godoc
+// @Summary Get Vehicle by slug
+// @Description Get vehicle by the slug in its response, as 2021-toyota-corolla-prata-3f9a1c. The slug follows the year, brand, model and color of the vehicle
+// @Tags Vehicle
+// @Accept json
+// @Produce json
+// @Param slug path string true "Slug"
+// @Param If-None-Match header string false "ETag of a previously read vehicle"
+// @Success 200 {object} responses.Vehicle
+// @Header 200 {string} ETag "Version of the vehicle"
+// @Success 304 "Vehicle not modified"
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Router /vehicles/by-slug/{slug} [get]
+func (ref *vehicleApi) getBySlug(ctx *gin.Context) {
+	var uri slugUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	vehicle, err := ref.vehicleService.GetBySlug(ctx, uri.Slug)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	ref.writeVehicle(ctx, vehicle)
+}
+
+// writeVehicle answers a read of vehicle, honoring If-None-Match.
+func (ref *vehicleApi) writeVehicle(ctx *gin.Context, vehicle *entity.Vehicle) {
 	if vehicle == nil {
 		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
 			Error: constants.VehicleDoesNotExist,
@@ -139,22 +263,49 @@ func (ref *vehicleApi) get(ctx *gin.Context) {
 		return
 	}
 
-	response := responses.VehicleFromDomain(*vehicle)
-	ctx.JSON(http.StatusOK, response)
+	etag := vehicleETag(*vehicle)
+	ctx.Header("ETag", etag)
+
+	if noneMatch(ctx.GetHeader("If-None-Match"), etag) {
+		ctx.Status(http.StatusNotModified)
+		return
+	}
+
+	response, err := ref.vehicleResponses(ctx, *vehicle)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response[0])
 }
 
 // Create godoc
 // @Summary Update Vehicle
-// @Description Update vehicle
+// @Description Update vehicle with a JSON Merge Patch (RFC 7396) or a JSON Patch (RFC 6902) of its fields. If-Match must carry the ETag of the vehicle being changed, so concurrent updates do not overwrite each other
 // @Tags Vehicle
+// @Accept application/merge-patch+json
+// @Accept application/json-patch+json
 // @Accept json
 // @Produce json
 // @Param entity_id path string true "Entity ID"
-// @Param vehicle body vehicleApi.updateVehicleRequest false "Body"
+// @Param If-Match header string true "ETag of the vehicle"
+// @Param vehicle body vehicleApi.updateVehicleRequest true "Merge patch, or an array of JSON Patch operations"
 // @Success 200 {object} responses.Vehicle
+// @Header 200 {string} ETag "Version of the updated vehicle"
 // @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
 // @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 412 {object} responses.ErrorResponse
+// @Failure 415 {object} responses.ErrorResponse
+// @Failure 428 {object} responses.ErrorResponse
 // @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /vehicles/{entity_id} [patch]
 func (ref *vehicleApi) update(ctx *gin.Context) {
 	var uri entityUri
@@ -165,17 +316,56 @@ func (ref *vehicleApi) update(ctx *gin.Context) {
 		return
 	}
 
-	var request updateVehicleRequest
-	if err := ctx.ShouldBindJSON(&request); err != nil {
+	ifMatch := ctx.GetHeader("If-Match")
+	if ifMatch == "" {
+		ctx.JSON(http.StatusPreconditionRequired, responses.ErrorResponse{
+			Error: constants.IfMatchRequired,
+		})
+		return
+	}
+
+	version, ok := ifMatchVersion(ifMatch)
+	if !ok {
+		ctx.JSON(http.StatusPreconditionFailed, responses.ErrorResponse{
+			Error: constants.VehicleVersionChanged,
+		})
+		return
+	}
+
+	body, err := ctx.GetRawData()
+	if err != nil {
 		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
 			Error: err.Error(),
 		})
 		return
 	}
 
-	vehicle, err := ref.vehicleService.Update(ctx, uri.EntityID, *request.ToDomain())
+	patch, err := parsePatch(ctx.ContentType(), body)
 	if err != nil {
-		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+		statusCode := http.StatusBadRequest
+		if errors.Is(err, errUnsupportedPatch) {
+			statusCode = http.StatusUnsupportedMediaType
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	vehicle, err := ref.vehicleService.Update(ctx, uri.EntityID, version, patch)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domainerrors.ErrVersionMismatch):
+			statusCode = http.StatusPreconditionFailed
+		case errors.Is(err, domainerrors.ErrInvalidArgument):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, domainerrors.ErrPatchTestFailed), errors.Is(err, domainerrors.ErrAlreadyExists):
+			statusCode = http.StatusConflict
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
 			Error: err.Error(),
 		})
 		return
@@ -188,13 +378,21 @@ func (ref *vehicleApi) update(ctx *gin.Context) {
 		return
 	}
 
-	response := responses.VehicleFromDomain(*vehicle)
-	ctx.JSON(http.StatusOK, response)
+	response, err := ref.vehicleResponses(ctx, *vehicle)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	ctx.Header("ETag", vehicleETag(*vehicle))
+	ctx.JSON(http.StatusOK, response[0])
 }
 
 // Create godoc
 // @Summary Buy Vehicle
-// @Description Buy vehicle
+// @Description Buy a published vehicle. A coupon_code of the dealer takes its discount off the price charged. Dealer staff may add a trade_in with its appraised value, and the payment only covers the remaining balance. A financing picks a financing plan of the dealer: the payment then charges the down payment and sends the installments of the rest
 // @Tags Vehicle
 // @Accept json
 // @Produce json
@@ -202,8 +400,14 @@ func (ref *vehicleApi) update(ctx *gin.Context) {
 // @Param buyer_document_number body vehicleApi.buyVehicleRequest true "Body"
 // @Success 200 {object} responses.Vehicle
 // @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
 // @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 429 {object} responses.ErrorResponse
 // @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
 // @Router /vehicles/{entity_id}/buy [post]
 func (ref *vehicleApi) buy(ctx *gin.Context) {
 	var uri entityUri
@@ -215,18 +419,23 @@ func (ref *vehicleApi) buy(ctx *gin.Context) {
 	}
 
 	var body buyVehicleRequest
-	if err := ctx.ShouldBindJSON(&body); err != nil {
+	if err := ctx.ShouldBindBodyWithJSON(&body); err != nil {
 		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
 			Error: err.Error(),
 		})
 		return
 	}
 
-	vehicle, err := ref.vehicleService.Buy(ctx, uri.EntityID, body.BuyerDocumentNumber)
+	vehicle, err := ref.vehicleService.Buy(ctx, uri.EntityID, body.ToDomain())
 	if err != nil {
 		statusCode := http.StatusInternalServerError
-		if err.Error() == constants.VehicleAlreadySold {
+		switch {
+		case err.Error() == constants.VehicleAlreadySold, errors.Is(err, domainerrors.ErrInvalidArgument):
 			statusCode = http.StatusBadRequest
+		case errors.Is(err, domainerrors.ErrPermissionDenied):
+			statusCode = http.StatusForbidden
+		case errors.Is(err, domainerrors.ErrAlreadyExists), errors.Is(err, domainerrors.ErrInvalidState):
+			statusCode = http.StatusConflict
 		}
 
 		ctx.JSON(statusCode, responses.ErrorResponse{
@@ -242,6 +451,204 @@ func (ref *vehicleApi) buy(ctx *gin.Context) {
 		return
 	}
 
-	response := responses.VehicleFromDomain(*vehicle)
-	ctx.JSON(http.StatusOK, response)
+	metrics.BuysStartedTotal.Inc()
+
+	response, err := ref.vehicleResponses(ctx, *vehicle)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, response[0])
+}
+
+// Create godoc
+// @Summary Publish Vehicle
+// @Description Publish vehicle in the catalog, right away or at publish_at. The vehicle must have a price and at least one photo
+// @Tags Vehicle
+// @Accept json
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Param schedule body vehicleApi.publishVehicleRequest false "Body"
+// @Success 200 {object} responses.Vehicle
+// @Header 200 {string} ETag "Version of the published vehicle"
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 422 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id}/publish [post]
+func (ref *vehicleApi) publish(ctx *gin.Context) {
+	var uri entityUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	var body publishVehicleRequest
+	if err := ctx.ShouldBindJSON(&body); err != nil && !errors.Is(err, io.EOF) {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	vehicle, err := ref.vehicleService.Publish(ctx, uri.EntityID, body.PublishAt)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domainerrors.ErrIncomplete):
+			statusCode = http.StatusUnprocessableEntity
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	ref.writeChangedVehicle(ctx, vehicle)
+}
+
+// Create godoc
+// @Summary Unpublish Vehicle
+// @Description Take a published or scheduled vehicle out of the catalog. Drafts and unlisted vehicles are left as they are
+// @Tags Vehicle
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Success 200 {object} responses.Vehicle
+// @Header 200 {string} ETag "Version of the vehicle"
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id}/unpublish [post]
+func (ref *vehicleApi) unpublish(ctx *gin.Context) {
+	var uri entityUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	vehicle, err := ref.vehicleService.Unpublish(ctx, uri.EntityID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	ref.writeChangedVehicle(ctx, vehicle)
+}
+
+// writeChangedVehicle answers a change of the listing status of vehicle.
+func (ref *vehicleApi) writeChangedVehicle(ctx *gin.Context, vehicle *entity.Vehicle) {
+	if vehicle == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.VehicleDoesNotExist,
+		})
+		return
+	}
+
+	response, err := ref.vehicleResponses(ctx, *vehicle)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	ctx.Header("ETag", vehicleETag(*vehicle))
+	ctx.JSON(http.StatusOK, response[0])
+}
+
+// Create godoc
+// @Summary Delete Vehicle
+// @Description Delete a vehicle that was never sold, along with its media
+// @Tags Vehicle
+// @Param entity_id path string true "Entity ID"
+// @Success 204 "Vehicle deleted"
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id} [delete]
+func (ref *vehicleApi) delete(ctx *gin.Context) {
+	var uri entityUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	vehicle, err := ref.vehicleService.Delete(ctx, uri.EntityID)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domainerrors.ErrInUse):
+			statusCode = http.StatusConflict
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if vehicle == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.VehicleDoesNotExist,
+		})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+// vehicleResponses reads the media of vehicles in a single search and adds
+// them to the response of each vehicle.
+func (ref *vehicleApi) vehicleResponses(ctx *gin.Context, vehicles ...entity.Vehicle) ([]responses.Vehicle, error) {
+	response := make([]responses.Vehicle, len(vehicles))
+	index := make(map[string]int, len(vehicles))
+	vehicleIDs := make([]string, len(vehicles))
+
+	for i, vehicle := range vehicles {
+		response[i] = responses.VehicleFromDomain(vehicle)
+		index[vehicle.EntityID] = i
+		vehicleIDs[i] = vehicle.EntityID
+	}
+
+	if len(vehicles) == 0 {
+		return response, nil
+	}
+
+	media, err := ref.mediaService.Search(ctx, vehicleIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range media {
+		if i, ok := index[item.VehicleID]; ok {
+			response[i].Media = append(response[i].Media, responses.VehicleMediaFromDomain(item))
+		}
+	}
+
+	return response, nil
 }
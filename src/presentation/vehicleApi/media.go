@@ -0,0 +1,406 @@
+package vehicleApi
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+)
+
+// mediaFileField is the multipart field holding the uploaded photo.
+const mediaFileField = "file"
+
+// Create godoc
+// @Summary Upload Vehicle Media
+// @Description Upload a JPEG or PNG photo of the vehicle. The type is read from the content rather than from the file name, and the size and dimensions are checked against the configured limits. The first photo becomes the cover
+// @Tags Vehicle Media
+// @Accept multipart/form-data
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Param file formData file true "Photo"
+// @Success 201 {object} responses.VehicleMedia
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 413 {object} responses.ErrorResponse
+// @Failure 415 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id}/media [post]
+func (ref *vehicleApi) uploadMedia(ctx *gin.Context) {
+	var uri entityUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	// The part is streamed to the service, which stops reading past the size
+	// limit, rather than parsed into memory or temporary files first.
+	reader, err := ctx.Request.MultipartReader()
+	if err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	var file io.Reader
+
+	for file == nil {
+		part, err := reader.NextPart()
+		if err != nil {
+			message := err.Error()
+			if errors.Is(err, io.EOF) {
+				message = constants.MediaFileRequired
+			}
+
+			ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+				Error: message,
+			})
+			return
+		}
+
+		if part.FormName() == mediaFileField {
+			file = part
+		}
+	}
+
+	media, err := ref.mediaService.Upload(ctx, uri.EntityID, file)
+	if err != nil {
+		statusCode := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, domainerrors.ErrMediaTooLarge):
+			statusCode = http.StatusRequestEntityTooLarge
+		case errors.Is(err, domainerrors.ErrUnsupportedMedia):
+			statusCode = http.StatusUnsupportedMediaType
+		case errors.Is(err, domainerrors.ErrInvalidArgument):
+			statusCode = http.StatusBadRequest
+		case errors.Is(err, domainerrors.ErrReferenceNotFound):
+			statusCode = http.StatusNotFound
+		case errors.Is(err, domainerrors.ErrVersionMismatch):
+			statusCode = http.StatusConflict
+		}
+
+		ctx.JSON(statusCode, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if media == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.VehicleDoesNotExist,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, responses.VehicleMediaFromDomain(*media))
+}
+
+// Create godoc
+// @Summary Search Vehicle Media
+// @Description List the media of the vehicle by position
+// @Tags Vehicle Media
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Success 200 {array} responses.VehicleMedia
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Router /vehicles/{entity_id}/media [get]
+func (ref *vehicleApi) searchMedia(ctx *gin.Context) {
+	var uri entityUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	vehicle, err := ref.vehicleService.GetByID(ctx, uri.EntityID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if vehicle == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.VehicleDoesNotExist,
+		})
+		return
+	}
+
+	media, err := ref.mediaService.Search(ctx, []string{uri.EntityID})
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, mediaResponse(media))
+}
+
+// Create godoc
+// @Summary Get Vehicle Media
+// @Description Download the photo of the vehicle
+// @Tags Vehicle Media
+// @Produce image/jpeg
+// @Produce image/png
+// @Param entity_id path string true "Entity ID"
+// @Param media_id path string true "Media ID"
+// @Success 200 {file} file
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Router /vehicles/{entity_id}/media/{media_id} [get]
+func (ref *vehicleApi) getMedia(ctx *gin.Context) {
+	ref.serveMedia(ctx, false)
+}
+
+// Create godoc
+// @Summary Get Vehicle Media Thumbnail
+// @Description Download the JPEG thumbnail of the photo of the vehicle
+// @Tags Vehicle Media
+// @Produce image/jpeg
+// @Param entity_id path string true "Entity ID"
+// @Param media_id path string true "Media ID"
+// @Success 200 {file} file
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Router /vehicles/{entity_id}/media/{media_id}/thumbnail [get]
+func (ref *vehicleApi) getMediaThumbnail(ctx *gin.Context) {
+	ref.serveMedia(ctx, true)
+}
+
+// Create godoc
+// @Summary Reorder Vehicle Media
+// @Description Set the order of the media of the vehicle, listing every one of them once
+// @Tags Vehicle Media
+// @Accept json
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Param order body vehicleApi.reorderMediaRequest true "Body"
+// @Success 200 {array} responses.VehicleMedia
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id}/media [put]
+func (ref *vehicleApi) reorderMedia(ctx *gin.Context) {
+	var uri entityUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	var request reorderMediaRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	media, err := ref.mediaService.Reorder(ctx, uri.EntityID, request.MediaIDs)
+	if err != nil {
+		writeMediaError(ctx, err)
+		return
+	}
+
+	if media == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.VehicleDoesNotExist,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, mediaResponse(media))
+}
+
+// Create godoc
+// @Summary Set Vehicle Cover
+// @Description Make the media the cover of the vehicle
+// @Tags Vehicle Media
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Param media_id path string true "Media ID"
+// @Success 200 {array} responses.VehicleMedia
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id}/media/{media_id}/cover [put]
+func (ref *vehicleApi) setMediaCover(ctx *gin.Context) {
+	var uri mediaUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	media, err := ref.mediaService.SetCover(ctx, uri.EntityID, uri.MediaID)
+	if err != nil {
+		writeMediaError(ctx, err)
+		return
+	}
+
+	if media == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.MediaDoesNotExist,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, mediaResponse(media))
+}
+
+// Create godoc
+// @Summary Delete Vehicle Media
+// @Description Delete the media of the vehicle. When it was the cover, the next media becomes the cover
+// @Tags Vehicle Media
+// @Param entity_id path string true "Entity ID"
+// @Param media_id path string true "Media ID"
+// @Success 204 "Media deleted"
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id}/media/{media_id} [delete]
+func (ref *vehicleApi) deleteMedia(ctx *gin.Context) {
+	var uri mediaUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	media, err := ref.mediaService.Delete(ctx, uri.EntityID, uri.MediaID)
+	if err != nil {
+		writeMediaError(ctx, err)
+		return
+	}
+
+	if media == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.MediaDoesNotExist,
+		})
+		return
+	}
+
+	ctx.Status(http.StatusNoContent)
+}
+
+func (ref *vehicleApi) serveMedia(ctx *gin.Context, thumbnail bool) {
+	var uri mediaUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	// The media of a vehicle are hidden along with it, as in searchMedia.
+	vehicle, err := ref.vehicleService.GetByID(ctx, uri.EntityID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if vehicle == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.VehicleDoesNotExist,
+		})
+		return
+	}
+
+	media, file, err := ref.mediaService.Open(ctx, uri.EntityID, uri.MediaID, thumbnail)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if media == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.MediaDoesNotExist,
+		})
+		return
+	}
+	defer file.Close()
+
+	contentType, size := media.ContentType, media.Size
+	if thumbnail {
+		contentType, size = "image/jpeg", -1
+	}
+
+	// Media are never changed once uploaded, a new photo gets a new id. Only
+	// the media of published vehicles may be kept by shared caches, the others
+	// are only served to staff.
+	cacheControl := "private, no-store"
+	if vehicle.IsPublishedAt(time.Now()) {
+		cacheControl = "public, max-age=31536000, immutable"
+	}
+
+	ctx.DataFromReader(http.StatusOK, size, contentType, file, map[string]string{
+		"Cache-Control": cacheControl,
+	})
+}
+
+func writeMediaError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainerrors.ErrInvalidArgument):
+		statusCode = http.StatusBadRequest
+	case errors.Is(err, domainerrors.ErrVersionMismatch):
+		statusCode = http.StatusConflict
+	}
+
+	ctx.JSON(statusCode, responses.ErrorResponse{
+		Error: err.Error(),
+	})
+}
+
+func mediaResponse(media []entity.VehicleMedia) []responses.VehicleMedia {
+	response := make([]responses.VehicleMedia, len(media))
+
+	for i, item := range media {
+		response[i] = responses.VehicleMediaFromDomain(item)
+	}
+
+	return response
+}
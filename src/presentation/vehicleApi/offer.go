@@ -0,0 +1,250 @@
+package vehicleApi
+
+import (
+	"errors"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/responses"
+	"github.com/caiiomp/vehicle-platform-sales/src/presentation/constants"
+)
+
+// Create godoc
+// @Summary Create Offer
+// @Description Offer to buy a published vehicle for less than its price. The offer is answered by the dealer staff and expires when left unanswered
+// @Tags Vehicle Offer
+// @Accept json
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Param offer body vehicleApi.createOfferRequest true "Body"
+// @Success 201 {object} responses.Offer
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id}/offers [post]
+func (ref *vehicleApi) createOffer(ctx *gin.Context) {
+	var uri entityUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	var body createOfferRequest
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	offer, err := ref.offerService.Create(ctx, entity.Offer{
+		VehicleID:           uri.EntityID,
+		BuyerDocumentNumber: body.BuyerDocumentNumber,
+		Amount:              body.Amount,
+	})
+	if err != nil {
+		writeOfferError(ctx, err)
+		return
+	}
+
+	if offer == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.VehicleDoesNotExist,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusCreated, responses.OfferFromDomain(*offer))
+}
+
+// Create godoc
+// @Summary Search Offers
+// @Description List the negotiation history of the vehicle, oldest first. Staff see every offer, buyers only the ones they made
+// @Tags Vehicle Offer
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Success 200 {array} responses.Offer
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id}/offers [get]
+func (ref *vehicleApi) searchOffers(ctx *gin.Context) {
+	var uri entityUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	offers, err := ref.offerService.Search(ctx, uri.EntityID)
+	if err != nil {
+		ctx.JSON(http.StatusInternalServerError, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	if offers == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.VehicleDoesNotExist,
+		})
+		return
+	}
+
+	response := make([]responses.Offer, len(offers))
+
+	for i, offer := range offers {
+		response[i] = responses.OfferFromDomain(offer)
+	}
+
+	ctx.JSON(http.StatusOK, response)
+}
+
+// Create godoc
+// @Summary Accept Offer
+// @Description Accept the offer, or the counter of the dealer, creating the sale of the vehicle at the negotiated price through the payment flow of a buy. Staff accept pending offers, the buyer accepts a counter. The other open offers of the vehicle are rejected
+// @Tags Vehicle Offer
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Param offer_id path string true "Offer ID"
+// @Success 200 {object} responses.Offer
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id}/offers/{offer_id}/accept [post]
+func (ref *vehicleApi) acceptOffer(ctx *gin.Context) {
+	var uri offerUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	offer, err := ref.offerService.Accept(ctx, uri.EntityID, uri.OfferID)
+	writeAnsweredOffer(ctx, offer, err)
+}
+
+// Create godoc
+// @Summary Reject Offer
+// @Description Reject the offer, or the counter of the dealer. Staff reject pending offers, the buyer rejects a counter
+// @Tags Vehicle Offer
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Param offer_id path string true "Offer ID"
+// @Success 200 {object} responses.Offer
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id}/offers/{offer_id}/reject [post]
+func (ref *vehicleApi) rejectOffer(ctx *gin.Context) {
+	var uri offerUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	offer, err := ref.offerService.Reject(ctx, uri.EntityID, uri.OfferID)
+	writeAnsweredOffer(ctx, offer, err)
+}
+
+// Create godoc
+// @Summary Counter Offer
+// @Description Answer a pending offer with a higher amount, which the buyer may accept or reject until it expires
+// @Tags Vehicle Offer
+// @Accept json
+// @Produce json
+// @Param entity_id path string true "Entity ID"
+// @Param offer_id path string true "Offer ID"
+// @Param counter body vehicleApi.counterOfferRequest true "Body"
+// @Success 200 {object} responses.Offer
+// @Failure 400 {object} responses.ErrorResponse
+// @Failure 401 {object} responses.ErrorResponse
+// @Failure 403 {object} responses.ErrorResponse
+// @Failure 404 {object} responses.ErrorResponse
+// @Failure 409 {object} responses.ErrorResponse
+// @Failure 500 {object} responses.ErrorResponse
+// @Security ApiKeyAuth
+// @Security BearerAuth
+// @Router /vehicles/{entity_id}/offers/{offer_id}/counter [post]
+func (ref *vehicleApi) counterOffer(ctx *gin.Context) {
+	var uri offerUri
+	if err := ctx.ShouldBindUri(&uri); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	var body counterOfferRequest
+	if err := ctx.ShouldBindJSON(&body); err != nil {
+		ctx.JSON(http.StatusBadRequest, responses.ErrorResponse{
+			Error: err.Error(),
+		})
+		return
+	}
+
+	offer, err := ref.offerService.Counter(ctx, uri.EntityID, uri.OfferID, body.Amount)
+	writeAnsweredOffer(ctx, offer, err)
+}
+
+func writeAnsweredOffer(ctx *gin.Context, offer *entity.Offer, err error) {
+	if err != nil {
+		writeOfferError(ctx, err)
+		return
+	}
+
+	if offer == nil {
+		ctx.JSON(http.StatusNotFound, responses.ErrorResponse{
+			Error: constants.OfferDoesNotExist,
+		})
+		return
+	}
+
+	ctx.JSON(http.StatusOK, responses.OfferFromDomain(*offer))
+}
+
+func writeOfferError(ctx *gin.Context, err error) {
+	statusCode := http.StatusInternalServerError
+	switch {
+	case errors.Is(err, domainerrors.ErrInvalidArgument):
+		statusCode = http.StatusBadRequest
+	case errors.Is(err, domainerrors.ErrPermissionDenied):
+		statusCode = http.StatusForbidden
+	case errors.Is(err, domainerrors.ErrReferenceNotFound):
+		statusCode = http.StatusNotFound
+	case errors.Is(err, domainerrors.ErrInvalidState), errors.Is(err, domainerrors.ErrAlreadyExists):
+		statusCode = http.StatusConflict
+	}
+
+	ctx.JSON(statusCode, responses.ErrorResponse{
+		Error: err.Error(),
+	})
+}
@@ -0,0 +1,201 @@
+package vehicleApi
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+// Media types accepted by PATCH /vehicles/:entity_id. A plain JSON body is
+// read as a merge patch, which is what the route took before both formats.
+const (
+	mergePatchContentType = "application/merge-patch+json"
+	jsonPatchContentType  = "application/json-patch+json"
+	jsonContentType       = "application/json"
+)
+
+var errUnsupportedPatch = errors.New("unsupported patch media type")
+
+// patchFields decodes the value of every field a patch may change.
+var patchFields = map[string]func(raw json.RawMessage) (any, error){
+	entity.VehicleFieldBrand:              decodePatchValue[string],
+	entity.VehicleFieldModel:              decodePatchValue[string],
+	entity.VehicleFieldYear:               decodePatchValue[int],
+	entity.VehicleFieldColor:              decodePatchValue[string],
+	entity.VehicleFieldPrice:              decodePatchValue[float64],
+	entity.VehicleFieldMileage:            decodePatchValue[int],
+	entity.VehicleFieldFuelType:           decodePatchValue[string],
+	entity.VehicleFieldTransmission:       decodePatchValue[string],
+	entity.VehicleFieldBodyType:           decodePatchValue[string],
+	entity.VehicleFieldCondition:          decodePatchValue[string],
+	entity.VehicleFieldDoors:              decodePatchValue[int],
+	entity.VehicleFieldEngineDisplacement: decodePatchValue[int],
+	entity.VehicleFieldLicensePlate:       decodePatchValue[string],
+	entity.VehicleFieldVIN:                decodePatchValue[string],
+}
+
+// immutableFields are part of the vehicle representation but can not be
+// patched. The slug follows the fields it is built from, and the listing
+// status changes through publish and unpublish only.
+var immutableFields = map[string]bool{
+	"id":           true,
+	"vehicle_id":   true,
+	"entity_id":    true,
+	"slug":         true,
+	"dealer_id":    true,
+	"status":       true,
+	"published_at": true,
+	"version":      true,
+	"created_at":   true,
+	"updated_at":   true,
+}
+
+// parsePatch reads body as a merge patch or a JSON Patch depending on its
+// media type, failing with errUnsupportedPatch for any other.
+func parsePatch(contentType string, body []byte) (entity.VehiclePatch, error) {
+	switch contentType {
+	case mergePatchContentType, jsonContentType, "":
+		return parseMergePatch(body)
+	case jsonPatchContentType:
+		return parseJSONPatch(body)
+	}
+
+	return nil, fmt.Errorf("%w %q", errUnsupportedPatch, contentType)
+}
+
+// parseMergePatch reads an RFC 7396 merge patch, where a member replaces the
+// field of the same name and a null member removes it. The vehicle has no
+// nested objects, so only the top level members are merged.
+func parseMergePatch(body []byte) (entity.VehiclePatch, error) {
+	var members map[string]json.RawMessage
+	if err := json.Unmarshal(body, &members); err != nil || members == nil {
+		return nil, errors.New("merge patch must be a JSON object")
+	}
+
+	fields := make([]string, 0, len(members))
+	for field := range members {
+		fields = append(fields, field)
+	}
+	slices.Sort(fields)
+
+	patch := make(entity.VehiclePatch, 0, len(fields))
+
+	for _, field := range fields {
+		operation := valueobjects.PatchOperationReplace
+		if isNull(members[field]) {
+			operation = valueobjects.PatchOperationRemove
+		}
+
+		change, err := patchChange(operation, field, members[field])
+		if err != nil {
+			return nil, err
+		}
+
+		patch = append(patch, change)
+	}
+
+	return patch, nil
+}
+
+type jsonPatchOperation struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// parseJSONPatch reads an RFC 6902 JSON Patch. Every path names a field of
+// the vehicle, which always exists, so add behaves as replace. move and copy
+// are not supported.
+func parseJSONPatch(body []byte) (entity.VehiclePatch, error) {
+	var operations []jsonPatchOperation
+	if err := json.Unmarshal(body, &operations); err != nil || operations == nil {
+		return nil, errors.New("json patch must be a JSON array of operations")
+	}
+
+	patch := make(entity.VehiclePatch, 0, len(operations))
+
+	for i, operation := range operations {
+		field, ok := strings.CutPrefix(operation.Path, "/")
+		if !ok || strings.Contains(field, "/") {
+			return nil, fmt.Errorf("operation %d: invalid path %q", i, operation.Path)
+		}
+
+		var patchOperation valueobjects.PatchOperation
+
+		switch operation.Op {
+		case "add", "replace":
+			patchOperation = valueobjects.PatchOperationReplace
+		case "remove":
+			patchOperation = valueobjects.PatchOperationRemove
+		case "test":
+			patchOperation = valueobjects.PatchOperationTest
+		default:
+			return nil, fmt.Errorf("operation %d: unsupported op %q", i, operation.Op)
+		}
+
+		if patchOperation != valueobjects.PatchOperationRemove && len(operation.Value) == 0 {
+			return nil, fmt.Errorf("operation %d: value is required", i)
+		}
+
+		change, err := patchChange(patchOperation, field, operation.Value)
+		if err != nil {
+			return nil, fmt.Errorf("operation %d: %w", i, err)
+		}
+
+		patch = append(patch, change)
+	}
+
+	return patch, nil
+}
+
+// patchChange decodes the value of a change to field. A null value removes
+// the field when replacing it and is kept as nil when testing it.
+func patchChange(operation valueobjects.PatchOperation, field string, raw json.RawMessage) (entity.VehicleChange, error) {
+	if immutableFields[field] {
+		return entity.VehicleChange{}, fmt.Errorf("field %q can not be changed", field)
+	}
+
+	decode, ok := patchFields[field]
+	if !ok {
+		return entity.VehicleChange{}, fmt.Errorf("unknown field %q", field)
+	}
+
+	change := entity.VehicleChange{
+		Operation: operation,
+		Field:     field,
+	}
+
+	if operation == valueobjects.PatchOperationRemove || isNull(raw) {
+		if operation == valueobjects.PatchOperationReplace {
+			change.Operation = valueobjects.PatchOperationRemove
+		}
+		return change, nil
+	}
+
+	value, err := decode(raw)
+	if err != nil {
+		return entity.VehicleChange{}, fmt.Errorf("invalid value of field %q: %w", field, err)
+	}
+
+	change.Value = value
+	return change, nil
+}
+
+func decodePatchValue[T any](raw json.RawMessage) (any, error) {
+	var value T
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return nil, err
+	}
+
+	return value, nil
+}
+
+func isNull(raw json.RawMessage) bool {
+	return bytes.Equal(bytes.TrimSpace(raw), []byte("null"))
+}
@@ -0,0 +1,135 @@
+package vehicleApi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func Test_parsePatch(t *testing.T) {
+	t.Run("should read merge patch from merge patch and plain json bodies", func(t *testing.T) {
+		for _, contentType := range []string{mergePatchContentType, jsonContentType, ""} {
+			actual, err := parsePatch(contentType, []byte(`{"price": 75000, "color": "Blue"}`))
+
+			expected := entity.VehiclePatch{
+				{Operation: valueobjects.PatchOperationReplace, Field: entity.VehicleFieldColor, Value: "Blue"},
+				{Operation: valueobjects.PatchOperationReplace, Field: entity.VehicleFieldPrice, Value: float64(75000)},
+			}
+
+			assert.Nil(t, err, contentType)
+			assert.Equal(t, expected, actual, contentType)
+		}
+	})
+
+	t.Run("should read json patch", func(t *testing.T) {
+		actual, err := parsePatch(jsonPatchContentType, []byte(`[
+			{"op": "test", "path": "/year", "value": 2025},
+			{"op": "replace", "path": "/year", "value": 2024},
+			{"op": "add", "path": "/model", "value": "Other Model"},
+			{"op": "remove", "path": "/color"}
+		]`))
+
+		expected := entity.VehiclePatch{
+			{Operation: valueobjects.PatchOperationTest, Field: entity.VehicleFieldYear, Value: 2025},
+			{Operation: valueobjects.PatchOperationReplace, Field: entity.VehicleFieldYear, Value: 2024},
+			{Operation: valueobjects.PatchOperationReplace, Field: entity.VehicleFieldModel, Value: "Other Model"},
+			{Operation: valueobjects.PatchOperationRemove, Field: entity.VehicleFieldColor},
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("should reject other media types", func(t *testing.T) {
+		_, err := parsePatch("text/plain", []byte(`{}`))
+
+		assert.ErrorIs(t, err, errUnsupportedPatch)
+	})
+}
+
+func Test_parseMergePatch(t *testing.T) {
+	t.Run("should remove fields set to null", func(t *testing.T) {
+		actual, err := parseMergePatch([]byte(`{"color": null}`))
+
+		expected := entity.VehiclePatch{
+			{Operation: valueobjects.PatchOperationRemove, Field: entity.VehicleFieldColor},
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("should read empty patch", func(t *testing.T) {
+		actual, err := parseMergePatch([]byte(`{}`))
+
+		assert.Nil(t, err)
+		assert.Empty(t, actual)
+	})
+
+	t.Run("should reject patch that is not an object", func(t *testing.T) {
+		for _, body := range []string{`[]`, `null`, `"Blue"`, `{`} {
+			_, err := parseMergePatch([]byte(body))
+
+			assert.NotNil(t, err, body)
+		}
+	})
+
+	t.Run("should reject unknown and immutable fields", func(t *testing.T) {
+		_, err := parseMergePatch([]byte(`{"colour": "Blue"}`))
+		assert.ErrorContains(t, err, `unknown field "colour"`)
+
+		_, err = parseMergePatch([]byte(`{"vehicle_id": "2"}`))
+		assert.ErrorContains(t, err, `field "vehicle_id" can not be changed`)
+
+		_, err = parseMergePatch([]byte(`{"id": 2}`))
+		assert.ErrorContains(t, err, `field "id" can not be changed`)
+
+		_, err = parseMergePatch([]byte(`{"slug": "some-slug"}`))
+		assert.ErrorContains(t, err, `field "slug" can not be changed`)
+
+		_, err = parseMergePatch([]byte(`{"status": "PUBLISHED"}`))
+		assert.ErrorContains(t, err, `field "status" can not be changed`)
+	})
+
+	t.Run("should reject value of another type", func(t *testing.T) {
+		_, err := parseMergePatch([]byte(`{"year": "2025"}`))
+
+		assert.ErrorContains(t, err, `invalid value of field "year"`)
+	})
+}
+
+func Test_parseJSONPatch(t *testing.T) {
+	t.Run("should replace with null as remove and keep null in tests", func(t *testing.T) {
+		actual, err := parseJSONPatch([]byte(`[
+			{"op": "test", "path": "/color", "value": null},
+			{"op": "replace", "path": "/color", "value": null}
+		]`))
+
+		expected := entity.VehiclePatch{
+			{Operation: valueobjects.PatchOperationTest, Field: entity.VehicleFieldColor},
+			{Operation: valueobjects.PatchOperationRemove, Field: entity.VehicleFieldColor},
+		}
+
+		assert.Nil(t, err)
+		assert.Equal(t, expected, actual)
+	})
+
+	t.Run("should reject invalid operations", func(t *testing.T) {
+		for _, body := range []string{
+			`{"op": "replace", "path": "/color", "value": "Blue"}`,
+			`[{"op": "move", "from": "/brand", "path": "/model"}]`,
+			`[{"op": "replace", "path": "color", "value": "Blue"}]`,
+			`[{"op": "replace", "path": "/color/name", "value": "Blue"}]`,
+			`[{"op": "replace", "path": "/color"}]`,
+			`[{"op": "replace", "path": "/dealer_id", "value": 2}]`,
+			`[{"op": "replace", "path": "/colour", "value": "Blue"}]`,
+		} {
+			_, err := parseJSONPatch([]byte(body))
+
+			assert.NotNil(t, err, body)
+		}
+	})
+}
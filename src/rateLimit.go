@@ -0,0 +1,59 @@
+package main
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/config"
+	"github.com/caiiomp/vehicle-platform-sales/src/ratelimit"
+)
+
+const rateLimitPruneInterval = time.Minute
+
+// rateLimits holds the limiters of each route group, left nil when the group
+// is not limited.
+type rateLimits struct {
+	store          ratelimit.Store
+	buy            *ratelimit.Limiter
+	buyConcurrency *ratelimit.Semaphore
+	webhook        *ratelimit.Limiter
+}
+
+func newRateLimits(cfg config.RateLimit, storage *storage) *rateLimits {
+	var store ratelimit.Store = ratelimit.NewMemoryStore()
+	if cfg.Store == config.RateLimitStorePostgres {
+		store = storage.rateLimitStore
+	}
+
+	limits := &rateLimits{
+		store: store,
+	}
+
+	if cfg.BuyPerMinute > 0 {
+		limits.buy = ratelimit.NewLimiter(store, ratelimit.PerMinute(cfg.BuyPerMinute, cfg.BuyBurst), timeGenerator)
+	}
+
+	if cfg.BuyConcurrencyPerVehicle > 0 {
+		limits.buyConcurrency = ratelimit.NewSemaphore(cfg.BuyConcurrencyPerVehicle)
+	}
+
+	if cfg.WebhookPerMinute > 0 {
+		limits.webhook = ratelimit.NewLimiter(store, ratelimit.PerMinute(cfg.WebhookPerMinute, cfg.WebhookBurst), timeGenerator)
+	}
+
+	return limits
+}
+
+// idle is the longest refill time of the enabled limiters, after which a
+// bucket of any group is full again and can be pruned. It is zero when no
+// group is limited.
+func (ref *rateLimits) idle() time.Duration {
+	var idle time.Duration
+
+	for _, limiter := range []*ratelimit.Limiter{ref.buy, ref.webhook} {
+		if limiter != nil {
+			idle = max(idle, limiter.Limit().RefillTime())
+		}
+	}
+
+	return idle
+}
@@ -0,0 +1,48 @@
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// MemoryStore keeps the buckets of this instance only, so each replica
+// enforces the limits on its own.
+type MemoryStore struct {
+	mu      sync.Mutex
+	buckets map[string]Bucket
+}
+
+func NewMemoryStore() *MemoryStore {
+	return &MemoryStore{
+		buckets: make(map[string]Bucket),
+	}
+}
+
+func (ref *MemoryStore) Take(_ context.Context, key string, limit Limit, now time.Time) (Decision, error) {
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	bucket, ok := ref.buckets[key]
+	if !ok {
+		bucket = NewBucket(limit, now)
+	}
+
+	next, decision := bucket.Take(limit, now)
+	ref.buckets[key] = next
+
+	return decision, nil
+}
+
+func (ref *MemoryStore) Prune(_ context.Context, before time.Time) error {
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	for key, bucket := range ref.buckets {
+		if bucket.UpdatedAt.Before(before) {
+			delete(ref.buckets, key)
+		}
+	}
+
+	return nil
+}
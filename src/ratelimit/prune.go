@@ -0,0 +1,27 @@
+package ratelimit
+
+import (
+	"context"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+)
+
+// Prune drops, every interval, the buckets idle for longer than idle, until
+// ctx is done. idle should be the longest RefillTime of the limits sharing the
+// store, so only buckets that are full again are dropped.
+func Prune(ctx context.Context, store Store, interval, idle time.Duration, now func() time.Time) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := store.Prune(ctx, now().Add(-idle)); err != nil && ctx.Err() == nil {
+				logger.FromContext(ctx).WarnContext(ctx, "error to prune rate limit buckets", "error", err)
+			}
+		}
+	}
+}
@@ -0,0 +1,96 @@
+// Package ratelimit implements token buckets kept by a Store, in memory for a
+// single instance or shared between instances, and a per key concurrency cap.
+package ratelimit
+
+import (
+	"context"
+	"math"
+	"time"
+)
+
+// Limit lets Burst requests through at once and refills Rate tokens per
+// second after that.
+type Limit struct {
+	Rate  float64
+	Burst int
+}
+
+func PerMinute(requests, burst int) Limit {
+	return Limit{
+		Rate:  float64(requests) / 60,
+		Burst: burst,
+	}
+}
+
+// RefillTime is how long an empty bucket takes to be full again. A bucket
+// idle for that long behaves the same as a missing one.
+func (ref Limit) RefillTime() time.Duration {
+	return time.Duration(float64(ref.Burst) / ref.Rate * float64(time.Second))
+}
+
+type Bucket struct {
+	Tokens    float64
+	UpdatedAt time.Time
+}
+
+func NewBucket(limit Limit, now time.Time) Bucket {
+	return Bucket{
+		Tokens:    float64(limit.Burst),
+		UpdatedAt: now,
+	}
+}
+
+type Decision struct {
+	Allowed    bool
+	RetryAfter time.Duration
+}
+
+// Take refills the bucket up to now and spends a token when there is one.
+// When there is not, the decision tells how long until there is.
+func (ref Bucket) Take(limit Limit, now time.Time) (Bucket, Decision) {
+	elapsed := max(now.Sub(ref.UpdatedAt).Seconds(), 0)
+
+	next := Bucket{
+		Tokens:    math.Min(float64(limit.Burst), ref.Tokens+elapsed*limit.Rate),
+		UpdatedAt: now,
+	}
+
+	if next.Tokens >= 1 {
+		next.Tokens--
+		return next, Decision{Allowed: true}
+	}
+
+	return next, Decision{
+		RetryAfter: time.Duration((1 - next.Tokens) / limit.Rate * float64(time.Second)),
+	}
+}
+
+// Store keeps the buckets by key. Take must be atomic for a key, since
+// concurrent requests share the bucket.
+type Store interface {
+	Take(ctx context.Context, key string, limit Limit, now time.Time) (Decision, error)
+	Prune(ctx context.Context, before time.Time) error
+}
+
+// Limiter applies one limit to every key of a route group.
+type Limiter struct {
+	store Store
+	limit Limit
+	now   func() time.Time
+}
+
+func NewLimiter(store Store, limit Limit, now func() time.Time) *Limiter {
+	return &Limiter{
+		store: store,
+		limit: limit,
+		now:   now,
+	}
+}
+
+func (ref *Limiter) Allow(ctx context.Context, key string) (Decision, error) {
+	return ref.store.Take(ctx, key, ref.limit, ref.now())
+}
+
+func (ref *Limiter) Limit() Limit {
+	return ref.limit
+}
@@ -0,0 +1,99 @@
+package ratelimit
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBucketTake(t *testing.T) {
+	limit := PerMinute(60, 2)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should spend the burst at once", func(t *testing.T) {
+		bucket := NewBucket(limit, now)
+
+		bucket, first := bucket.Take(limit, now)
+		bucket, second := bucket.Take(limit, now)
+		_, third := bucket.Take(limit, now)
+
+		assert.True(t, first.Allowed)
+		assert.True(t, second.Allowed)
+		assert.False(t, third.Allowed)
+		assert.Equal(t, time.Second, third.RetryAfter)
+	})
+
+	t.Run("should refill over time", func(t *testing.T) {
+		bucket := Bucket{Tokens: 0, UpdatedAt: now}
+
+		_, early := bucket.Take(limit, now.Add(500*time.Millisecond))
+		_, late := bucket.Take(limit, now.Add(time.Second))
+
+		assert.False(t, early.Allowed)
+		assert.Equal(t, 500*time.Millisecond, early.RetryAfter)
+		assert.True(t, late.Allowed)
+	})
+
+	t.Run("should not refill beyond the burst", func(t *testing.T) {
+		bucket := Bucket{Tokens: 0, UpdatedAt: now}
+
+		next, _ := bucket.Take(limit, now.Add(time.Hour))
+
+		assert.Equal(t, float64(limit.Burst-1), next.Tokens)
+	})
+}
+
+func TestMemoryStore(t *testing.T) {
+	ctx := context.TODO()
+	limit := PerMinute(1, 1)
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should keep a bucket per key", func(t *testing.T) {
+		store := NewMemoryStore()
+
+		first, _ := store.Take(ctx, "ip:1", limit, now)
+		second, _ := store.Take(ctx, "ip:1", limit, now)
+		other, _ := store.Take(ctx, "ip:2", limit, now)
+
+		assert.True(t, first.Allowed)
+		assert.False(t, second.Allowed)
+		assert.True(t, other.Allowed)
+	})
+
+	t.Run("should prune idle buckets", func(t *testing.T) {
+		store := NewMemoryStore()
+
+		store.Take(ctx, "ip:1", limit, now)
+		store.Take(ctx, "ip:2", limit, now.Add(time.Minute))
+
+		err := store.Prune(ctx, now.Add(time.Second))
+
+		assert.Nil(t, err)
+		assert.Len(t, store.buckets, 1)
+		assert.Contains(t, store.buckets, "ip:2")
+	})
+}
+
+func TestSemaphore(t *testing.T) {
+	t.Run("should cap the holders of a key", func(t *testing.T) {
+		semaphore := NewSemaphore(1)
+
+		release, first := semaphore.TryAcquire("vehicle:1")
+		_, second := semaphore.TryAcquire("vehicle:1")
+		_, other := semaphore.TryAcquire("vehicle:2")
+
+		assert.True(t, first)
+		assert.False(t, second)
+		assert.True(t, other)
+
+		release()
+		release()
+
+		_, again := semaphore.TryAcquire("vehicle:1")
+
+		assert.True(t, again)
+		assert.Equal(t, 1, semaphore.held["vehicle:1"])
+	})
+}
@@ -0,0 +1,44 @@
+package ratelimit
+
+import "sync"
+
+// Semaphore caps how many requests may hold a key at once in this instance.
+type Semaphore struct {
+	mu    sync.Mutex
+	limit int
+	held  map[string]int
+}
+
+func NewSemaphore(limit int) *Semaphore {
+	return &Semaphore{
+		limit: limit,
+		held:  make(map[string]int),
+	}
+}
+
+// TryAcquire does not wait: it either takes a slot of the key, returning the
+// function that gives it back, or reports that every slot is taken.
+func (ref *Semaphore) TryAcquire(key string) (func(), bool) {
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	if ref.held[key] >= ref.limit {
+		return nil, false
+	}
+
+	ref.held[key]++
+
+	var once sync.Once
+	release := func() {
+		once.Do(func() {
+			ref.mu.Lock()
+			defer ref.mu.Unlock()
+
+			if ref.held[key]--; ref.held[key] <= 0 {
+				delete(ref.held, key)
+			}
+		})
+	}
+
+	return release, true
+}
@@ -0,0 +1,152 @@
+package cache
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+	"github.com/caiiomp/vehicle-platform-sales/src/metrics"
+)
+
+// CatalogName labels the metrics of the catalog cache.
+const CatalogName = "catalog"
+
+const (
+	resultHit  = "hit"
+	resultMiss = "miss"
+)
+
+// Catalog caches the vehicle reads of the storefront. Keys carry the dealer of
+// the tenant scope, since a dealer scope sees fewer vehicles than the
+// platform. Writes delete the entries of the vehicle right away and again
+// once the unit of work they ran in commits, so a read racing the transaction
+// can not keep the old value until the TTL.
+type Catalog struct {
+	store Store
+	ttl   time.Duration
+	group singleflight.Group
+}
+
+func NewCatalog(store Store, ttl time.Duration) *Catalog {
+	return &Catalog{
+		store: store,
+		ttl:   ttl,
+	}
+}
+
+func vehicleKey(dealerID int, entityID string) string {
+	return fmt.Sprintf("vehicle:%d:%s", dealerID, entityID)
+}
+
+func vehiclesKey(dealerID int, isSold *bool, publishedOnly bool) string {
+	filter := "all"
+	if isSold != nil {
+		filter = strconv.FormatBool(*isSold)
+	}
+
+	listing := "any"
+	if publishedOnly {
+		listing = "published"
+	}
+
+	return fmt.Sprintf("vehicles:%d:%s:%s", dealerID, filter, listing)
+}
+
+// vehicleKeys are every entry a change to the vehicle may have made stale: the
+// ones read with a scope reaching every dealer and the ones read with the
+// scope of its dealer.
+func vehicleKeys(entityID string, dealerID int) []string {
+	sold, notSold := true, false
+
+	var keys []string
+	for _, scope := range []int{0, dealerID} {
+		keys = append(keys, vehicleKey(scope, entityID))
+
+		for _, publishedOnly := range []bool{false, true} {
+			keys = append(keys,
+				vehiclesKey(scope, nil, publishedOnly),
+				vehiclesKey(scope, &sold, publishedOnly),
+				vehiclesKey(scope, &notSold, publishedOnly),
+			)
+		}
+	}
+
+	return keys
+}
+
+// read returns the cached value of key, or loads it once for every caller
+// waiting on the same key and stores it. Reads inside a unit of work skip the
+// cache, as they must see the writes of the transaction and take its locks.
+func read[T any](ref *Catalog, ctx context.Context, key func(scope tenant.Scope) string, load func(ctx context.Context) (T, error)) (T, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil || inUnitOfWork(ctx) {
+		return load(ctx)
+	}
+
+	cacheKey := key(scope)
+
+	var value T
+	if data, ok, err := ref.store.Get(ctx, cacheKey); err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "error to read cache", "key", cacheKey, "error", err)
+	} else if ok && json.Unmarshal(data, &value) == nil {
+		metrics.CacheRequestsTotal.WithLabelValues(CatalogName, resultHit).Inc()
+		return value, nil
+	}
+
+	metrics.CacheRequestsTotal.WithLabelValues(CatalogName, resultMiss).Inc()
+
+	// The load is shared, so it must not fail because the first caller gave
+	// up. Each caller still stops waiting when its own context is done.
+	result := ref.group.DoChan(cacheKey, func() (any, error) {
+		loadCtx := context.WithoutCancel(ctx)
+
+		loaded, err := load(loadCtx)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := json.Marshal(loaded)
+		if err == nil {
+			err = ref.store.Set(loadCtx, cacheKey, data, ref.ttl)
+		}
+		if err != nil {
+			logger.FromContext(ctx).WarnContext(ctx, "error to write cache", "key", cacheKey, "error", err)
+		}
+
+		return loaded, nil
+	})
+
+	select {
+	case <-ctx.Done():
+		return value, ctx.Err()
+	case loaded := <-result:
+		if loaded.Err != nil {
+			return value, loaded.Err
+		}
+		return loaded.Val.(T), nil
+	}
+}
+
+// invalidate deletes the entries of the vehicle now and, inside a unit of
+// work, once more after it commits.
+func (ref *Catalog) invalidate(ctx context.Context, entityID string, dealerID int) {
+	keys := vehicleKeys(entityID, dealerID)
+
+	ref.delete(ctx, keys)
+
+	if unit := unitFromContext(ctx); unit != nil {
+		unit.add(keys)
+	}
+}
+
+func (ref *Catalog) delete(ctx context.Context, keys []string) {
+	if err := ref.store.Delete(ctx, keys...); err != nil {
+		logger.FromContext(ctx).WarnContext(ctx, "error to invalidate cache", "keys", keys, "error", err)
+	}
+}
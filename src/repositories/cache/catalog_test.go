@@ -0,0 +1,308 @@
+package cache
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	mocks "github.com/caiiomp/vehicle-platform-sales/src/core/_mocks"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/metrics"
+)
+
+// scoped matches the context of a load, which is detached from the caller
+// but keeps its tenant scope.
+func scoped(dealerID int) any {
+	return mock.MatchedBy(func(ctx context.Context) bool {
+		scope, err := tenant.FromContext(ctx)
+		return err == nil && scope.DealerID == dealerID
+	})
+}
+
+func newCatalog() *Catalog {
+	return NewCatalog(NewLRU(100, func() time.Time { return time.Now().UTC() }), time.Minute)
+}
+
+func TestVehicles(t *testing.T) {
+	ctx := tenant.WithAllDealers(context.TODO())
+	vehicle := entity.Vehicle{EntityID: "1", DealerID: 3, Brand: "Some Brand", Price: 80000}
+
+	t.Run("should serve repeated searches from the cache", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("Search", scoped(0), entity.VehicleFilter{}).
+			Return([]entity.Vehicle{vehicle}, nil).
+			Once()
+
+		repository := newCatalog().Vehicles(vehicleRepositoryMocked)
+
+		hits := testutil.ToFloat64(metrics.CacheRequestsTotal.WithLabelValues(CatalogName, resultHit))
+
+		first, err := repository.Search(ctx, entity.VehicleFilter{})
+		assert.Nil(t, err)
+
+		second, err := repository.Search(ctx, entity.VehicleFilter{})
+
+		assert.Nil(t, err)
+		assert.Equal(t, first, second)
+		assert.Equal(t, []entity.Vehicle{vehicle}, second)
+		assert.Equal(t, hits+1, testutil.ToFloat64(metrics.CacheRequestsTotal.WithLabelValues(CatalogName, resultHit)))
+	})
+
+	t.Run("should not cache searches filtering attributes", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		filter := entity.VehicleFilter{FuelType: valueobjects.FuelTypeFlex}
+
+		vehicleRepositoryMocked.On("Search", ctx, filter).
+			Return([]entity.Vehicle{vehicle}, nil).
+			Twice()
+
+		repository := newCatalog().Vehicles(vehicleRepositoryMocked)
+
+		repository.Search(ctx, filter)
+		actual, err := repository.Search(ctx, filter)
+
+		assert.Nil(t, err)
+		assert.Equal(t, []entity.Vehicle{vehicle}, actual)
+	})
+
+	t.Run("should keep published searches apart", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("Search", scoped(0), entity.VehicleFilter{}).
+			Return([]entity.Vehicle{vehicle}, nil).
+			Once()
+
+		vehicleRepositoryMocked.On("Search", scoped(0), entity.VehicleFilter{PublishedOnly: true}).
+			Return([]entity.Vehicle{}, nil).
+			Once()
+
+		repository := newCatalog().Vehicles(vehicleRepositoryMocked)
+
+		repository.Search(ctx, entity.VehicleFilter{})
+		repository.Search(ctx, entity.VehicleFilter{PublishedOnly: true})
+		actual, err := repository.Search(ctx, entity.VehicleFilter{PublishedOnly: true})
+
+		assert.Nil(t, err)
+		assert.Empty(t, actual)
+	})
+
+	t.Run("should cache missing vehicle", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("GetByID", scoped(0), "1").
+			Return(nil, nil).
+			Once()
+
+		repository := newCatalog().Vehicles(vehicleRepositoryMocked)
+
+		repository.GetByID(ctx, "1")
+		actual, err := repository.GetByID(ctx, "1")
+
+		assert.Nil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("should keep dealer scopes apart", func(t *testing.T) {
+		dealerCtx := tenant.WithDealer(ctx, 4)
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("Search", scoped(0), entity.VehicleFilter{}).
+			Return([]entity.Vehicle{vehicle}, nil).
+			Once()
+
+		vehicleRepositoryMocked.On("Search", scoped(4), entity.VehicleFilter{}).
+			Return([]entity.Vehicle{}, nil).
+			Once()
+
+		repository := newCatalog().Vehicles(vehicleRepositoryMocked)
+
+		repository.Search(ctx, entity.VehicleFilter{})
+		actual, err := repository.Search(dealerCtx, entity.VehicleFilter{})
+
+		assert.Nil(t, err)
+		assert.Empty(t, actual)
+	})
+
+	t.Run("should invalidate vehicle on update", func(t *testing.T) {
+		updated := vehicle
+		updated.Price = 70000
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("Search", scoped(0), entity.VehicleFilter{}).
+			Return([]entity.Vehicle{vehicle}, nil).
+			Once()
+
+		vehicleRepositoryMocked.On("Update", ctx, "1", mock.Anything).
+			Return(&updated, nil)
+
+		vehicleRepositoryMocked.On("Search", scoped(0), entity.VehicleFilter{}).
+			Return([]entity.Vehicle{updated}, nil).
+			Once()
+
+		repository := newCatalog().Vehicles(vehicleRepositoryMocked)
+
+		repository.Search(ctx, entity.VehicleFilter{})
+		repository.Update(ctx, "1", updated)
+		actual, err := repository.Search(ctx, entity.VehicleFilter{})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []entity.Vehicle{updated}, actual)
+	})
+
+	t.Run("should invalidate vehicle on sale status change", func(t *testing.T) {
+		isSold := true
+		soldDate := time.Now()
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		saleRepositoryMocked := mocks.NewSaleRepository(t)
+
+		vehicleRepositoryMocked.On("Search", scoped(0), entity.VehicleFilter{IsSold: &isSold}).
+			Return([]entity.Vehicle{}, nil).
+			Once()
+
+		saleRepositoryMocked.On("UpdateStatusByPaymentID", ctx, "payment", "APPROVED", soldDate).
+			Return(&entity.Sale{EntityID: "1", DealerID: 3}, valueobjects.SaleStatusTypePending, nil)
+
+		vehicleRepositoryMocked.On("Search", scoped(0), entity.VehicleFilter{IsSold: &isSold}).
+			Return([]entity.Vehicle{vehicle}, nil).
+			Once()
+
+		catalog := newCatalog()
+		vehicles := catalog.Vehicles(vehicleRepositoryMocked)
+		sales := catalog.Sales(saleRepositoryMocked)
+
+		vehicles.Search(ctx, entity.VehicleFilter{IsSold: &isSold})
+		sales.UpdateStatusByPaymentID(ctx, "payment", "APPROVED", soldDate)
+		actual, err := vehicles.Search(ctx, entity.VehicleFilter{IsSold: &isSold})
+
+		assert.Nil(t, err)
+		assert.Equal(t, []entity.Vehicle{vehicle}, actual)
+	})
+
+	t.Run("should load once for concurrent callers", func(t *testing.T) {
+		var loads atomic.Int32
+		started := make(chan struct{})
+		release := make(chan struct{})
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+
+		vehicleRepositoryMocked.On("Search", mock.Anything, entity.VehicleFilter{}).
+			Run(func(mock.Arguments) {
+				if loads.Add(1) == 1 {
+					close(started)
+				}
+				<-release
+			}).
+			Return([]entity.Vehicle{vehicle}, nil)
+
+		repository := newCatalog().Vehicles(vehicleRepositoryMocked)
+
+		var wg sync.WaitGroup
+		results := make([][]entity.Vehicle, 5)
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[0], _ = repository.Search(ctx, entity.VehicleFilter{})
+		}()
+
+		<-started
+
+		for i := 1; i < len(results); i++ {
+			wg.Add(1)
+			go func() {
+				defer wg.Done()
+				results[i], _ = repository.Search(ctx, entity.VehicleFilter{})
+			}()
+		}
+
+		close(release)
+		wg.Wait()
+
+		assert.Equal(t, int32(1), loads.Load())
+		for _, result := range results {
+			assert.Equal(t, []entity.Vehicle{vehicle}, result)
+		}
+	})
+}
+
+func TestTxManager(t *testing.T) {
+	ctx := tenant.WithAllDealers(context.TODO())
+	vehicle := entity.Vehicle{EntityID: "1", DealerID: 3, Price: 80000}
+
+	t.Run("should read through inside a unit of work", func(t *testing.T) {
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).Return(ctx, nil)
+
+		vehicleRepositoryMocked.On("GetByID", mock.Anything, "1").
+			Return(&vehicle, nil).
+			Twice()
+
+		catalog := newCatalog()
+		repository := catalog.Vehicles(vehicleRepositoryMocked)
+
+		txCtx, err := catalog.TxManager(txManagerMocked).Begin(ctx)
+		assert.Nil(t, err)
+
+		repository.GetByID(txCtx, "1")
+		actual, err := repository.GetByID(txCtx, "1")
+
+		assert.Nil(t, err)
+		assert.Equal(t, &vehicle, actual)
+	})
+
+	t.Run("should invalidate again after commit", func(t *testing.T) {
+		updated := vehicle
+		updated.Price = 70000
+
+		vehicleRepositoryMocked := mocks.NewVehicleRepository(t)
+		txManagerMocked := mocks.NewTxManager(t)
+
+		txManagerMocked.On("Begin", ctx).Return(ctx, nil)
+		txManagerMocked.On("Commit", mock.Anything).Return(nil)
+
+		vehicleRepositoryMocked.On("Update", mock.Anything, "1", updated).
+			Return(&updated, nil)
+
+		// Read by a request racing the transaction, before the commit.
+		vehicleRepositoryMocked.On("GetByID", scoped(0), "1").
+			Return(&vehicle, nil).
+			Once()
+
+		vehicleRepositoryMocked.On("GetByID", scoped(0), "1").
+			Return(&updated, nil).
+			Once()
+
+		catalog := newCatalog()
+		repository := catalog.Vehicles(vehicleRepositoryMocked)
+		txManager := catalog.TxManager(txManagerMocked)
+
+		txCtx, err := txManager.Begin(ctx)
+		assert.Nil(t, err)
+
+		repository.Update(txCtx, "1", updated)
+		stale, _ := repository.GetByID(ctx, "1")
+
+		assert.Nil(t, txManager.Commit(txCtx))
+
+		actual, err := repository.GetByID(ctx, "1")
+
+		assert.Nil(t, err)
+		assert.Equal(t, &vehicle, stale)
+		assert.Equal(t, &updated, actual)
+	})
+}
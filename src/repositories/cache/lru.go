@@ -0,0 +1,119 @@
+package cache
+
+import (
+	"container/list"
+	"context"
+	"sync"
+	"time"
+)
+
+type lruEntry struct {
+	key       string
+	value     []byte
+	expiresAt time.Time
+}
+
+// LRU is an in-process Store holding at most capacity entries, evicting the
+// least recently used one when full. Each instance has its own, so a write
+// seen by one instance only reaches the others once their entries expire.
+type LRU struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+	now      func() time.Time
+	onEvict  func()
+}
+
+func NewLRU(capacity int, now func() time.Time) *LRU {
+	return &LRU{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+		now:      now,
+		onEvict:  func() {},
+	}
+}
+
+func (ref *LRU) Get(_ context.Context, key string) ([]byte, bool, error) {
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	element, ok := ref.entries[key]
+	if !ok {
+		return nil, false, nil
+	}
+
+	entry := element.Value.(*lruEntry)
+	if !ref.now().Before(entry.expiresAt) {
+		ref.remove(element)
+		return nil, false, nil
+	}
+
+	ref.order.MoveToFront(element)
+
+	return entry.value, true, nil
+}
+
+func (ref *LRU) Set(_ context.Context, key string, value []byte, ttl time.Duration) error {
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	expiresAt := ref.now().Add(ttl)
+
+	if element, ok := ref.entries[key]; ok {
+		entry := element.Value.(*lruEntry)
+		entry.value = value
+		entry.expiresAt = expiresAt
+		ref.order.MoveToFront(element)
+		return nil
+	}
+
+	ref.entries[key] = ref.order.PushFront(&lruEntry{
+		key:       key,
+		value:     value,
+		expiresAt: expiresAt,
+	})
+
+	for ref.order.Len() > ref.capacity {
+		ref.remove(ref.order.Back())
+		ref.onEvict()
+	}
+
+	return nil
+}
+
+func (ref *LRU) Delete(_ context.Context, keys ...string) error {
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	for _, key := range keys {
+		if element, ok := ref.entries[key]; ok {
+			ref.remove(element)
+		}
+	}
+
+	return nil
+}
+
+// Len counts the entries held, expired ones included until they are read or
+// evicted.
+func (ref *LRU) Len() int {
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	return ref.order.Len()
+}
+
+// OnEvict sets a function called each time an entry is evicted to make room.
+func (ref *LRU) OnEvict(fn func()) {
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	ref.onEvict = fn
+}
+
+func (ref *LRU) remove(element *list.Element) {
+	ref.order.Remove(element)
+	delete(ref.entries, element.Value.(*lruEntry).key)
+}
@@ -0,0 +1,68 @@
+package cache
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLRU(t *testing.T) {
+	ctx := context.TODO()
+	now := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	t.Run("should evict the least recently used entry", func(t *testing.T) {
+		evictions := 0
+		lru := NewLRU(2, func() time.Time { return now })
+		lru.OnEvict(func() { evictions++ })
+
+		lru.Set(ctx, "a", []byte("1"), time.Minute)
+		lru.Set(ctx, "b", []byte("2"), time.Minute)
+		lru.Get(ctx, "a")
+		lru.Set(ctx, "c", []byte("3"), time.Minute)
+
+		_, hasA, _ := lru.Get(ctx, "a")
+		_, hasB, _ := lru.Get(ctx, "b")
+		_, hasC, _ := lru.Get(ctx, "c")
+
+		assert.True(t, hasA)
+		assert.False(t, hasB)
+		assert.True(t, hasC)
+		assert.Equal(t, 1, evictions)
+	})
+
+	t.Run("should expire entries after the ttl", func(t *testing.T) {
+		current := now
+		lru := NewLRU(2, func() time.Time { return current })
+
+		lru.Set(ctx, "a", []byte("1"), time.Minute)
+
+		_, fresh, _ := lru.Get(ctx, "a")
+
+		current = now.Add(time.Minute)
+
+		_, expired, _ := lru.Get(ctx, "a")
+
+		assert.True(t, fresh)
+		assert.False(t, expired)
+		assert.Equal(t, 0, lru.Len())
+	})
+
+	t.Run("should delete entries", func(t *testing.T) {
+		lru := NewLRU(2, func() time.Time { return now })
+
+		lru.Set(ctx, "a", []byte("1"), time.Minute)
+		lru.Set(ctx, "b", []byte("2"), time.Minute)
+
+		err := lru.Delete(ctx, "a", "missing")
+
+		_, hasA, _ := lru.Get(ctx, "a")
+		value, hasB, _ := lru.Get(ctx, "b")
+
+		assert.Nil(t, err)
+		assert.False(t, hasA)
+		assert.True(t, hasB)
+		assert.Equal(t, []byte("2"), value)
+	})
+}
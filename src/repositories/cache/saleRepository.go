@@ -0,0 +1,115 @@
+package cache
+
+import (
+	"context"
+	"time"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type saleRepository struct {
+	next    interfaces.SaleRepository
+	catalog *Catalog
+}
+
+// Sales does not cache sales. It invalidates the vehicle of a sale that is
+// created, deleted or changes status, since that may move the vehicle between the
+// sold and not sold listings.
+func (ref *Catalog) Sales(next interfaces.SaleRepository) interfaces.SaleRepository {
+	return &saleRepository{
+		next:    next,
+		catalog: ref,
+	}
+}
+
+func (ref *saleRepository) Create(ctx context.Context, sale entity.Sale) (*entity.Sale, error) {
+	created, err := ref.next.Create(ctx, sale)
+	if err != nil {
+		return nil, err
+	}
+
+	if created == nil {
+		return nil, nil
+	}
+
+	ref.catalog.invalidate(ctx, created.EntityID, created.DealerID)
+
+	return created, nil
+}
+
+func (ref *saleRepository) GetByEntityID(ctx context.Context, entityID string) (*entity.Sale, error) {
+	return ref.next.GetByEntityID(ctx, entityID)
+}
+
+func (ref *saleRepository) Search(ctx context.Context) ([]entity.Sale, error) {
+	return ref.next.Search(ctx)
+}
+
+func (ref *saleRepository) CountByStatus(ctx context.Context, status string) (int, error) {
+	return ref.next.CountByStatus(ctx, status)
+}
+
+func (ref *saleRepository) UpdateStatusByPaymentID(ctx context.Context, paymentID, status string, soldDate time.Time) (*entity.Sale, valueobjects.SaleStatusType, error) {
+	sale, previous, err := ref.next.UpdateStatusByPaymentID(ctx, paymentID, status, soldDate)
+	if err != nil {
+		return nil, "", err
+	}
+
+	if sale != nil {
+		ref.catalog.invalidate(ctx, sale.EntityID, sale.DealerID)
+	}
+
+	return sale, previous, nil
+}
+
+func (ref *saleRepository) LinkTradeInVehicle(ctx context.Context, id int, vehicleID string) (*entity.Sale, error) {
+	return ref.next.LinkTradeInVehicle(ctx, id, vehicleID)
+}
+
+func (ref *saleRepository) AttachPayment(ctx context.Context, id int, paymentID string) (*entity.Sale, error) {
+	return ref.next.AttachPayment(ctx, id, paymentID)
+}
+
+func (ref *saleRepository) DeleteReservation(ctx context.Context, id int) (*entity.Sale, error) {
+	deleted, err := ref.next.DeleteReservation(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if deleted != nil {
+		ref.catalog.invalidate(ctx, deleted.EntityID, deleted.DealerID)
+	}
+
+	return deleted, nil
+}
+
+func (ref *saleRepository) DeleteRejected(ctx context.Context, id int) (*entity.Sale, error) {
+	deleted, err := ref.next.DeleteRejected(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if deleted != nil {
+		ref.catalog.invalidate(ctx, deleted.EntityID, deleted.DealerID)
+	}
+
+	return deleted, nil
+}
+
+func (ref *saleRepository) GetByID(ctx context.Context, id int) (*entity.Sale, error) {
+	return ref.next.GetByID(ctx, id)
+}
+
+func (ref *saleRepository) CreateInstallments(ctx context.Context, id int, installments []entity.Installment) ([]entity.Installment, error) {
+	return ref.next.CreateInstallments(ctx, id, installments)
+}
+
+func (ref *saleRepository) SearchInstallments(ctx context.Context, id int) ([]entity.Installment, error) {
+	return ref.next.SearchInstallments(ctx, id)
+}
+
+func (ref *saleRepository) PayInstallment(ctx context.Context, paymentID string, number int, paidAt time.Time) (*entity.Installment, error) {
+	return ref.next.PayInstallment(ctx, paymentID, number, paidAt)
+}
@@ -0,0 +1,17 @@
+// Package cache decorates the repositories read by the storefront with a
+// read-through cache. Values are kept encoded, so the in-process LRU can be
+// swapped for a cache shared between instances.
+package cache
+
+import (
+	"context"
+	"time"
+)
+
+// Store keeps encoded values by key until their TTL expires. A shared cache
+// only has to implement these three calls to replace the LRU.
+type Store interface {
+	Get(ctx context.Context, key string) ([]byte, bool, error)
+	Set(ctx context.Context, key string, value []byte, ttl time.Duration) error
+	Delete(ctx context.Context, keys ...string) error
+}
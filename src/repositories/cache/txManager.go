@@ -0,0 +1,91 @@
+package cache
+
+import (
+	"context"
+	"sync"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+)
+
+type unitKey struct{}
+
+// unit collects the keys invalidated during a unit of work, deleted again
+// when the outermost Begin commits.
+type unit struct {
+	mu   sync.Mutex
+	keys []string
+}
+
+func (ref *unit) add(keys []string) {
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	ref.keys = append(ref.keys, keys...)
+}
+
+type unitHandle struct {
+	unit   *unit
+	nested bool
+}
+
+func unitFromContext(ctx context.Context) *unit {
+	handle, ok := ctx.Value(unitKey{}).(*unitHandle)
+	if !ok {
+		return nil
+	}
+
+	return handle.unit
+}
+
+func inUnitOfWork(ctx context.Context) bool {
+	return unitFromContext(ctx) != nil
+}
+
+type txManager struct {
+	next    interfaces.TxManager
+	catalog *Catalog
+}
+
+// TxManager marks the contexts of a unit of work, so the cached repositories
+// read through to the store inside it and invalidate again after commit.
+func (ref *Catalog) TxManager(next interfaces.TxManager) interfaces.TxManager {
+	return &txManager{
+		next:    next,
+		catalog: ref,
+	}
+}
+
+func (ref *txManager) Begin(ctx context.Context) (context.Context, error) {
+	txCtx, err := ref.next.Begin(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if current := unitFromContext(ctx); current != nil {
+		return context.WithValue(txCtx, unitKey{}, &unitHandle{unit: current, nested: true}), nil
+	}
+
+	return context.WithValue(txCtx, unitKey{}, &unitHandle{unit: &unit{}}), nil
+}
+
+func (ref *txManager) Commit(ctx context.Context) error {
+	if err := ref.next.Commit(ctx); err != nil {
+		return err
+	}
+
+	if handle, ok := ctx.Value(unitKey{}).(*unitHandle); ok && !handle.nested {
+		handle.unit.mu.Lock()
+		keys := handle.unit.keys
+		handle.unit.mu.Unlock()
+
+		if len(keys) > 0 {
+			ref.catalog.delete(ctx, keys)
+		}
+	}
+
+	return nil
+}
+
+func (ref *txManager) Rollback(ctx context.Context) error {
+	return ref.next.Rollback(ctx)
+}
@@ -0,0 +1,102 @@
+package cache
+
+import (
+	"context"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+)
+
+type vehicleRepository struct {
+	next    interfaces.VehicleRepository
+	catalog *Catalog
+}
+
+// Vehicles caches GetByID and Search, missing vehicles included, and
+// invalidates the entries of a vehicle when it is created, updated or deleted.
+func (ref *Catalog) Vehicles(next interfaces.VehicleRepository) interfaces.VehicleRepository {
+	return &vehicleRepository{
+		next:    next,
+		catalog: ref,
+	}
+}
+
+func (ref *vehicleRepository) Create(ctx context.Context, vehicle entity.Vehicle) (*entity.Vehicle, error) {
+	created, err := ref.next.Create(ctx, vehicle)
+	if err != nil {
+		return nil, err
+	}
+
+	if created == nil {
+		return nil, nil
+	}
+
+	ref.catalog.invalidate(ctx, created.EntityID, created.DealerID)
+
+	return created, nil
+}
+
+func (ref *vehicleRepository) GetByID(ctx context.Context, id string) (*entity.Vehicle, error) {
+	return read(ref.catalog, ctx, func(scope tenant.Scope) string {
+		return vehicleKey(scope.DealerID, id)
+	}, func(ctx context.Context) (*entity.Vehicle, error) {
+		return ref.next.GetByID(ctx, id)
+	})
+}
+
+// GetBySlug is not cached, as a write would have to know every slug a vehicle
+// was ever read by to invalidate it.
+func (ref *vehicleRepository) GetBySlug(ctx context.Context, slug string) (*entity.Vehicle, error) {
+	return ref.next.GetBySlug(ctx, slug)
+}
+
+// ExistsByVIN is not cached, as it is not scoped like the catalog entries.
+func (ref *vehicleRepository) ExistsByVIN(ctx context.Context, vin valueobjects.VIN) (bool, error) {
+	return ref.next.ExistsByVIN(ctx, vin)
+}
+
+// Search only caches the listings filtered by sold status, whose keys a write
+// can enumerate. Searches by vehicle attributes or listing status always reach
+// the repository. A vehicle scheduled for publication joins a cached listing
+// once the entry expires.
+func (ref *vehicleRepository) Search(ctx context.Context, filter entity.VehicleFilter) ([]entity.Vehicle, error) {
+	// Only the catalog listings are cached, searches filtering attributes vary
+	// too much to be worth keeping.
+	if filter != (entity.VehicleFilter{IsSold: filter.IsSold, PublishedOnly: filter.PublishedOnly}) {
+		return ref.next.Search(ctx, filter)
+	}
+
+	return read(ref.catalog, ctx, func(scope tenant.Scope) string {
+		return vehiclesKey(scope.DealerID, filter.IsSold, filter.PublishedOnly)
+	}, func(ctx context.Context) ([]entity.Vehicle, error) {
+		return ref.next.Search(ctx, filter)
+	})
+}
+
+func (ref *vehicleRepository) Update(ctx context.Context, id string, vehicle entity.Vehicle) (*entity.Vehicle, error) {
+	updated, err := ref.next.Update(ctx, id, vehicle)
+	if err != nil {
+		return nil, err
+	}
+
+	if updated != nil {
+		ref.catalog.invalidate(ctx, updated.EntityID, updated.DealerID)
+	}
+
+	return updated, nil
+}
+
+func (ref *vehicleRepository) Delete(ctx context.Context, id string) (*entity.Vehicle, error) {
+	deleted, err := ref.next.Delete(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	if deleted != nil {
+		ref.catalog.invalidate(ctx, deleted.EntityID, deleted.DealerID)
+	}
+
+	return deleted, nil
+}
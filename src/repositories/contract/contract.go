@@ -0,0 +1,2398 @@
+// Package contract holds the behaviour every repository implementation must
+// share, so the memory store can stand in for Postgres in demos and tests.
+package contract
+
+import (
+	"context"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/ratelimit"
+)
+
+// Repositories is one backend. Every call of a Factory must return empty
+// repositories sharing the same storage. TxManager is left nil by backends
+// that can not roll back, which skips the unit of work tests, and RateLimits
+// by backends that can not share rate limit buckets.
+type Repositories struct {
+	Dealers        interfaces.DealerRepository
+	Vehicles       interfaces.VehicleRepository
+	Media          interfaces.VehicleMediaRepository
+	Offers         interfaces.OfferRepository
+	Coupons        interfaces.CouponRepository
+	FinancingPlans interfaces.FinancingPlanRepository
+	Sales          interfaces.SaleRepository
+	APIKeys        interfaces.APIKeyRepository
+	TxManager      interfaces.TxManager
+	RateLimits     ratelimit.Store
+
+	// dealerID is the dealer Run creates for the rows of each test.
+	dealerID int
+}
+
+type Factory func(t *testing.T) Repositories
+
+// Run calls every repository with a scope reaching every dealer, except for the
+// tenancy tests, which check that a dealer scope hides the other dealers.
+func Run(t *testing.T, newBackend Factory) {
+	newRepositories := func(t *testing.T) Repositories {
+		repositories := newBackend(t)
+		repositories.dealerID = mustCreateDealer(t, repositories, "Some Dealer").ID
+		return repositories
+	}
+
+	t.Run("DealerRepository", func(t *testing.T) {
+		testDealerRepository(t, newRepositories)
+	})
+
+	t.Run("TenantScope", func(t *testing.T) {
+		testTenantScope(t, newRepositories)
+	})
+
+	t.Run("VehicleRepository", func(t *testing.T) {
+		testVehicleRepository(t, newRepositories)
+	})
+
+	t.Run("VehicleMediaRepository", func(t *testing.T) {
+		testVehicleMediaRepository(t, newRepositories)
+	})
+
+	t.Run("OfferRepository", func(t *testing.T) {
+		testOfferRepository(t, newRepositories)
+	})
+
+	t.Run("CouponRepository", func(t *testing.T) {
+		testCouponRepository(t, newRepositories)
+	})
+
+	t.Run("FinancingPlanRepository", func(t *testing.T) {
+		testFinancingPlanRepository(t, newRepositories)
+	})
+
+	t.Run("SaleRepository", func(t *testing.T) {
+		testSaleRepository(t, newRepositories)
+	})
+
+	t.Run("APIKeyRepository", func(t *testing.T) {
+		testAPIKeyRepository(t, newRepositories)
+	})
+
+	t.Run("TxManager", func(t *testing.T) {
+		testTxManager(t, newRepositories)
+	})
+
+	t.Run("RateLimitStore", func(t *testing.T) {
+		testRateLimitStore(t, newRepositories)
+	})
+}
+
+func testDealerRepository(t *testing.T, newRepositories Factory) {
+	ctx := tenant.WithAllDealers(context.TODO())
+
+	t.Run("should create dealer with id", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Dealers.Create(ctx, entity.Dealer{Name: "Another Dealer"})
+
+		require.Nil(t, err)
+		assert.NotZero(t, actual.ID)
+		assert.NotEqual(t, repositories.dealerID, actual.ID)
+		assert.Equal(t, "Another Dealer", actual.Name)
+		assert.False(t, actual.CreatedAt.IsZero())
+	})
+
+	t.Run("should not create dealer inside a dealer scope", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Dealers.Create(tenant.WithDealer(ctx, repositories.dealerID), entity.Dealer{Name: "Another Dealer"})
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrPermissionDenied)
+	})
+
+	t.Run("should get and search dealers in scope", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+
+		all, err := repositories.Dealers.Search(ctx)
+
+		require.Nil(t, err)
+		assert.Len(t, all, 2)
+
+		scopedCtx := tenant.WithDealer(ctx, repositories.dealerID)
+
+		scoped, err := repositories.Dealers.Search(scopedCtx)
+
+		require.Nil(t, err)
+		require.Len(t, scoped, 1)
+		assert.Equal(t, repositories.dealerID, scoped[0].ID)
+
+		own, err := repositories.Dealers.GetByID(scopedCtx, repositories.dealerID)
+
+		require.Nil(t, err)
+		assert.Equal(t, "Some Dealer", own.Name)
+
+		hidden, err := repositories.Dealers.GetByID(scopedCtx, other.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, hidden)
+	})
+}
+
+// testTenantScope checks every repository keeps one dealer out of the rows of
+// another.
+func testTenantScope(t *testing.T, newRepositories Factory) {
+	ctx := tenant.WithAllDealers(context.TODO())
+
+	t.Run("should refuse to run without a scope", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		_, err := repositories.Vehicles.Search(context.TODO(), entity.VehicleFilter{})
+		assert.ErrorIs(t, err, tenant.ErrMissingScope)
+
+		_, err = repositories.Sales.Search(context.TODO())
+		assert.ErrorIs(t, err, tenant.ErrMissingScope)
+
+		_, err = repositories.APIKeys.Search(context.TODO())
+		assert.ErrorIs(t, err, tenant.ErrMissingScope)
+
+		_, err = repositories.Dealers.Search(context.TODO())
+		assert.ErrorIs(t, err, tenant.ErrMissingScope)
+	})
+
+	t.Run("should create vehicle in the dealer of the scope", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Vehicles.Create(tenant.WithDealer(ctx, repositories.dealerID), newVehicle(0, 10000))
+
+		require.Nil(t, err)
+		assert.Equal(t, repositories.dealerID, actual.DealerID)
+	})
+
+	t.Run("should not create vehicle for another dealer", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+
+		actual, err := repositories.Vehicles.Create(tenant.WithDealer(ctx, repositories.dealerID), newVehicle(other.ID, 10000))
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+	})
+
+	t.Run("should not create vehicle for dealer that does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Vehicles.Create(ctx, newVehicle(999, 10000))
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+	})
+
+	t.Run("should hide vehicles of other dealers", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+
+		own := mustCreateVehicle(t, repositories, 10000)
+
+		foreign, err := repositories.Vehicles.Create(ctx, newVehicle(other.ID, 20000))
+		require.Nil(t, err)
+
+		scopedCtx := tenant.WithDealer(ctx, repositories.dealerID)
+
+		vehicles, err := repositories.Vehicles.Search(scopedCtx, entity.VehicleFilter{})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{own.EntityID}, entityIDs(vehicles))
+
+		hidden, err := repositories.Vehicles.GetByID(scopedCtx, foreign.EntityID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, hidden)
+
+		hidden, err = repositories.Vehicles.GetBySlug(scopedCtx, foreign.Slug.String())
+
+		assert.Nil(t, err)
+		assert.Nil(t, hidden)
+
+		changed := *foreign
+		changed.Color = "Blue"
+
+		updated, err := repositories.Vehicles.Update(scopedCtx, foreign.EntityID, changed)
+
+		assert.Nil(t, err)
+		assert.Nil(t, updated)
+
+		stored, err := repositories.Vehicles.GetByID(ctx, foreign.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, foreign.Color, stored.Color)
+
+		all, err := repositories.Vehicles.Search(ctx, entity.VehicleFilter{})
+
+		require.Nil(t, err)
+		assert.Len(t, all, 2)
+	})
+
+	t.Run("should not create sale in another dealer than the vehicle", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		actual, err := repositories.Sales.Create(ctx, entity.Sale{
+			EntityID:            vehicle.EntityID,
+			DealerID:            other.ID,
+			BuyerDocumentNumber: "12345678900",
+			Status:              valueobjects.SaleStatusTypePending,
+		})
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+	})
+
+	t.Run("should hide sales of other dealers", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+
+		mustCreateSale(t, repositories, mustCreateVehicle(t, repositories, 10000), valueobjects.SaleStatusTypePending, nil)
+
+		foreignVehicle, err := repositories.Vehicles.Create(ctx, newVehicle(other.ID, 20000))
+		require.Nil(t, err)
+		foreignSale := mustCreateSale(t, repositories, *foreignVehicle, valueobjects.SaleStatusTypePending, nil)
+
+		scopedCtx := tenant.WithDealer(ctx, repositories.dealerID)
+
+		sales, err := repositories.Sales.Search(scopedCtx)
+
+		require.Nil(t, err)
+		require.Len(t, sales, 1)
+		assert.Equal(t, repositories.dealerID, sales[0].DealerID)
+
+		pending, err := repositories.Sales.CountByStatus(scopedCtx, valueobjects.SaleStatusTypePending.String())
+
+		require.Nil(t, err)
+		assert.Equal(t, 1, pending)
+
+		hidden, err := repositories.Sales.GetByEntityID(scopedCtx, foreignVehicle.EntityID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, hidden)
+
+		updated, _, err := repositories.Sales.UpdateStatusByPaymentID(scopedCtx, foreignSale.PaymentID, valueobjects.SaleStatusTypeApproved.String(), time.Now())
+
+		assert.Nil(t, err)
+		assert.Nil(t, updated)
+
+		linked, err := repositories.Sales.LinkTradeInVehicle(scopedCtx, foreignSale.ID, uuid.NewString())
+
+		assert.Nil(t, err)
+		assert.Nil(t, linked)
+
+		hidden, err = repositories.Sales.GetByID(scopedCtx, foreignSale.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, hidden)
+
+		created, err := repositories.Sales.CreateInstallments(scopedCtx, foreignSale.ID, newInstallments(2))
+
+		assert.Nil(t, created)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+
+		_, err = repositories.Sales.CreateInstallments(ctx, foreignSale.ID, newInstallments(2))
+		require.Nil(t, err)
+
+		installments, err := repositories.Sales.SearchInstallments(scopedCtx, foreignSale.ID)
+
+		require.Nil(t, err)
+		assert.Empty(t, installments)
+
+		paid, err := repositories.Sales.PayInstallment(scopedCtx, foreignSale.PaymentID, 1, time.Now())
+
+		assert.Nil(t, err)
+		assert.Nil(t, paid)
+
+		stored, err := repositories.Sales.GetByEntityID(ctx, foreignVehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, valueobjects.SaleStatusTypePending, stored.Status)
+	})
+
+	t.Run("should hide media of other dealers", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+
+		foreignVehicle, err := repositories.Vehicles.Create(ctx, newVehicle(other.ID, 20000))
+		require.Nil(t, err)
+		foreignMedia := mustCreateMedia(t, repositories, *foreignVehicle, 0)
+
+		scopedCtx := tenant.WithDealer(ctx, repositories.dealerID)
+
+		created, err := repositories.Media.Create(scopedCtx, newVehicleMedia(*foreignVehicle, 1))
+
+		assert.Nil(t, created)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+
+		media, err := repositories.Media.SearchByVehicleIDs(scopedCtx, []string{foreignVehicle.EntityID})
+
+		require.Nil(t, err)
+		assert.Empty(t, media)
+
+		hidden, err := repositories.Media.GetByID(scopedCtx, foreignMedia.EntityID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, hidden)
+
+		deleted, err := repositories.Media.Delete(scopedCtx, foreignMedia.EntityID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, deleted)
+
+		deletedVehicle, err := repositories.Vehicles.Delete(scopedCtx, foreignVehicle.EntityID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, deletedVehicle)
+
+		stored, err := repositories.Media.GetByID(ctx, foreignMedia.EntityID)
+
+		require.Nil(t, err)
+		assert.NotNil(t, stored)
+	})
+
+	t.Run("should hide offers of other dealers", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+
+		foreignVehicle, err := repositories.Vehicles.Create(ctx, newVehicle(other.ID, 20000))
+		require.Nil(t, err)
+		foreignOffer := mustCreateOffer(t, repositories, *foreignVehicle, 18000)
+
+		scopedCtx := tenant.WithDealer(ctx, repositories.dealerID)
+
+		created, err := repositories.Offers.Create(scopedCtx, newOffer(*foreignVehicle, 17000))
+
+		assert.Nil(t, created)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+
+		offers, err := repositories.Offers.SearchByVehicleID(scopedCtx, foreignVehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Empty(t, offers)
+
+		hidden, err := repositories.Offers.GetByID(scopedCtx, foreignOffer.EntityID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, hidden)
+
+		foreignOffer.Status = valueobjects.OfferStatusRejected
+
+		updated, err := repositories.Offers.Update(scopedCtx, foreignOffer)
+
+		assert.Nil(t, err)
+		assert.Nil(t, updated)
+	})
+
+	t.Run("should hide coupons of other dealers", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+		foreignCoupon := mustCreateCoupon(t, repositories, newCoupon(other.ID, "FOREIGN"))
+
+		scopedCtx := tenant.WithDealer(ctx, repositories.dealerID)
+
+		created, err := repositories.Coupons.Create(scopedCtx, newCoupon(other.ID, "ANOTHER"))
+
+		assert.Nil(t, created)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+
+		coupons, err := repositories.Coupons.Search(scopedCtx)
+
+		require.Nil(t, err)
+		assert.Empty(t, coupons)
+
+		hidden, err := repositories.Coupons.GetByID(scopedCtx, foreignCoupon.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, hidden)
+
+		hidden, err = repositories.Coupons.GetByCode(scopedCtx, other.ID, foreignCoupon.Code)
+
+		assert.Nil(t, err)
+		assert.Nil(t, hidden)
+
+		redeemed, err := repositories.Coupons.Redeem(scopedCtx, foreignCoupon.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, redeemed)
+
+		deleted, err := repositories.Coupons.Delete(scopedCtx, foreignCoupon.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, deleted)
+	})
+
+	t.Run("should hide financing plans of other dealers", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+		foreignPlan := mustCreateFinancingPlan(t, repositories, newFinancingPlan(other.ID))
+
+		scopedCtx := tenant.WithDealer(ctx, repositories.dealerID)
+
+		created, err := repositories.FinancingPlans.Create(scopedCtx, newFinancingPlan(other.ID))
+
+		assert.Nil(t, created)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+
+		plans, err := repositories.FinancingPlans.Search(scopedCtx)
+
+		require.Nil(t, err)
+		assert.Empty(t, plans)
+
+		hidden, err := repositories.FinancingPlans.GetByID(scopedCtx, foreignPlan.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, hidden)
+
+		deleted, err := repositories.FinancingPlans.Delete(scopedCtx, foreignPlan.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, deleted)
+	})
+
+	t.Run("should hide api keys of other dealers and of the platform", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+
+		platformKey, err := repositories.APIKeys.Create(ctx, newAPIKey(valueobjects.RolePlatformAdmin))
+		require.Nil(t, err)
+
+		ownKey := newAPIKey(valueobjects.RoleDealerStaff)
+		ownKey.DealerID = repositories.dealerID
+		_, err = repositories.APIKeys.Create(ctx, ownKey)
+		require.Nil(t, err)
+
+		foreignKey := newAPIKey(valueobjects.RoleDealerStaff)
+		foreignKey.DealerID = other.ID
+		foreign, err := repositories.APIKeys.Create(ctx, foreignKey)
+		require.Nil(t, err)
+
+		scopedCtx := tenant.WithDealer(ctx, repositories.dealerID)
+
+		apiKeys, err := repositories.APIKeys.Search(scopedCtx)
+
+		require.Nil(t, err)
+		require.Len(t, apiKeys, 1)
+		assert.Equal(t, repositories.dealerID, apiKeys[0].DealerID)
+
+		for _, id := range []int{platformKey.ID, foreign.ID} {
+			revoked, err := repositories.APIKeys.Revoke(scopedCtx, id, time.Now())
+
+			assert.Nil(t, err)
+			assert.Nil(t, revoked)
+		}
+
+		stored, err := repositories.APIKeys.GetByHash(ctx, foreign.KeyHash)
+
+		require.Nil(t, err)
+		assert.Equal(t, other.ID, stored.DealerID)
+		assert.False(t, stored.IsRevoked())
+	})
+}
+
+func testVehicleRepository(t *testing.T, newRepositories Factory) {
+	ctx := tenant.WithAllDealers(context.TODO())
+
+	t.Run("should create vehicle with id and timestamps", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := newVehicle(repositories.dealerID, 80000.126)
+
+		actual, err := repositories.Vehicles.Create(ctx, vehicle)
+
+		require.Nil(t, err)
+		assert.NotZero(t, actual.ID)
+		assert.Equal(t, vehicle.EntityID, actual.EntityID)
+		assert.Equal(t, vehicle.Brand, actual.Brand)
+		assert.Equal(t, vehicle.Year, actual.Year)
+		assert.Equal(t, 80000.13, actual.Price)
+		assert.False(t, actual.CreatedAt.IsZero())
+		assert.Equal(t, actual.CreatedAt, actual.UpdatedAt)
+	})
+
+	t.Run("should not create vehicle with duplicated entity id", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		actual, err := repositories.Vehicles.Create(ctx, vehicle)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+	})
+
+	t.Run("should return nil when vehicle does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Vehicles.GetByID(ctx, uuid.NewString())
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should get vehicle by id", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		actual, err := repositories.Vehicles.GetByID(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, vehicle.ID, actual.ID)
+		assert.Equal(t, vehicle.Model, actual.Model)
+		assert.True(t, vehicle.CreatedAt.Equal(actual.CreatedAt))
+	})
+
+	t.Run("should get vehicle by slug", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		actual, err := repositories.Vehicles.GetBySlug(ctx, vehicle.Slug.String())
+
+		require.Nil(t, err)
+		assert.Equal(t, vehicle.EntityID, actual.EntityID)
+		assert.Equal(t, vehicle.Slug, actual.Slug)
+
+		missing, err := repositories.Vehicles.GetBySlug(ctx, "2020-some-brand-some-model-black-000000")
+
+		assert.Nil(t, err)
+		assert.Nil(t, missing)
+	})
+
+	t.Run("should search vehicles ordered by price", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		expensive := mustCreateVehicle(t, repositories, 90000)
+		cheap := mustCreateVehicle(t, repositories, 15000)
+		middle := mustCreateVehicle(t, repositories, 40000)
+
+		actual, err := repositories.Vehicles.Search(ctx, entity.VehicleFilter{})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{cheap.EntityID, middle.EntityID, expensive.EntityID}, entityIDs(actual))
+	})
+
+	t.Run("should return empty list when there are no vehicles", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Vehicles.Search(ctx, entity.VehicleFilter{})
+
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+		assert.Empty(t, actual)
+	})
+
+	t.Run("should search sold and not sold vehicles", func(t *testing.T) {
+		repositories := newRepositories(t)
+		soldDate := time.Now().UTC()
+
+		available := mustCreateVehicle(t, repositories, 30000)
+		pending := mustCreateVehicle(t, repositories, 20000)
+		sold := mustCreateVehicle(t, repositories, 10000)
+		rejected := mustCreateVehicle(t, repositories, 40000)
+
+		mustCreateSale(t, repositories, pending, valueobjects.SaleStatusTypePending, nil)
+		mustCreateSale(t, repositories, sold, valueobjects.SaleStatusTypeApproved, &soldDate)
+		mustCreateSale(t, repositories, rejected, valueobjects.SaleStatusTypeRejected, &soldDate)
+
+		isSold := true
+		actual, err := repositories.Vehicles.Search(ctx, entity.VehicleFilter{IsSold: &isSold})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{sold.EntityID}, entityIDs(actual))
+
+		isSold = false
+		actual, err = repositories.Vehicles.Search(ctx, entity.VehicleFilter{IsSold: &isSold})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{pending.EntityID, available.EntityID}, entityIDs(actual))
+	})
+
+	t.Run("should keep vehicle attributes", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := withAttributes(newVehicle(repositories.dealerID, 10000))
+
+		created, err := repositories.Vehicles.Create(ctx, vehicle)
+		require.Nil(t, err)
+
+		actual, err := repositories.Vehicles.GetByID(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, vehicle.Mileage, actual.Mileage)
+		assert.Equal(t, vehicle.FuelType, actual.FuelType)
+		assert.Equal(t, vehicle.Transmission, actual.Transmission)
+		assert.Equal(t, vehicle.BodyType, actual.BodyType)
+		assert.Equal(t, vehicle.Condition, actual.Condition)
+		assert.Equal(t, vehicle.Doors, actual.Doors)
+		assert.Equal(t, vehicle.EngineDisplacement, actual.EngineDisplacement)
+		assert.Equal(t, vehicle.LicensePlate, actual.LicensePlate)
+		assert.Equal(t, vehicle.VIN, actual.VIN)
+
+		cleared := *created
+		cleared.FuelType = ""
+		cleared.VIN = ""
+		cleared.Mileage = 0
+
+		updated, err := repositories.Vehicles.Update(ctx, vehicle.EntityID, cleared)
+
+		require.Nil(t, err)
+		assert.Empty(t, updated.FuelType)
+		assert.Empty(t, updated.VIN)
+		assert.Zero(t, updated.Mileage)
+		assert.Equal(t, vehicle.LicensePlate, updated.LicensePlate)
+	})
+
+	t.Run("should not keep two vehicles with the same vin", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := withAttributes(newVehicle(repositories.dealerID, 10000))
+		_, err := repositories.Vehicles.Create(ctx, vehicle)
+		require.Nil(t, err)
+
+		duplicated := withAttributes(newVehicle(repositories.dealerID, 20000))
+		actual, err := repositories.Vehicles.Create(ctx, duplicated)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+
+		other := mustCreateVehicle(t, repositories, 30000)
+		other.VIN = vehicle.VIN
+		actual, err = repositories.Vehicles.Update(ctx, other.EntityID, other)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+
+		// Vehicles without a VIN never collide.
+		mustCreateVehicle(t, repositories, 40000)
+	})
+
+	t.Run("should tell whether a vin exists in any dealer", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+
+		_, err := repositories.Vehicles.Create(ctx, withAttributes(newVehicle(other.ID, 10000)))
+		require.Nil(t, err)
+
+		scopedCtx := tenant.WithDealer(ctx, repositories.dealerID)
+
+		exists, err := repositories.Vehicles.ExistsByVIN(scopedCtx, "9BWZZZ377VT004251")
+
+		require.Nil(t, err)
+		assert.True(t, exists)
+
+		exists, err = repositories.Vehicles.ExistsByVIN(scopedCtx, "9BWZZZ377VT004252")
+
+		require.Nil(t, err)
+		assert.False(t, exists)
+	})
+
+	t.Run("should keep slugs unique and follow them on update", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		duplicated := newVehicle(repositories.dealerID, 20000)
+		duplicated.Slug = vehicle.Slug
+		actual, err := repositories.Vehicles.Create(ctx, duplicated)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+
+		other := mustCreateVehicle(t, repositories, 30000)
+		other.Slug = vehicle.Slug
+		actual, err = repositories.Vehicles.Update(ctx, other.EntityID, other)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+
+		renamed := vehicle
+		renamed.Slug = "2020-some-brand-some-model-blue-123abc"
+		actual, err = repositories.Vehicles.Update(ctx, vehicle.EntityID, renamed)
+
+		require.Nil(t, err)
+		assert.Equal(t, renamed.Slug, actual.Slug)
+
+		found, err := repositories.Vehicles.GetBySlug(ctx, renamed.Slug.String())
+
+		require.Nil(t, err)
+		assert.Equal(t, vehicle.EntityID, found.EntityID)
+
+		gone, err := repositories.Vehicles.GetBySlug(ctx, vehicle.Slug.String())
+
+		assert.Nil(t, err)
+		assert.Nil(t, gone)
+	})
+
+	t.Run("should search vehicles by attributes", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		suv := withAttributes(newVehicle(repositories.dealerID, 90000))
+		_, err := repositories.Vehicles.Create(ctx, suv)
+		require.Nil(t, err)
+
+		hatch := newVehicle(repositories.dealerID, 50000)
+		hatch.BodyType = valueobjects.BodyTypeHatch
+		hatch.FuelType = valueobjects.FuelTypeFlex
+		hatch.Mileage = 80000
+		hatch.Doors = 4
+		_, err = repositories.Vehicles.Create(ctx, hatch)
+		require.Nil(t, err)
+
+		actual, err := repositories.Vehicles.Search(ctx, entity.VehicleFilter{FuelType: valueobjects.FuelTypeFlex})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{hatch.EntityID, suv.EntityID}, entityIDs(actual))
+
+		actual, err = repositories.Vehicles.Search(ctx, entity.VehicleFilter{BodyType: valueobjects.BodyTypeSUV, Doors: 4})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{suv.EntityID}, entityIDs(actual))
+
+		maxMileage := 20000
+		actual, err = repositories.Vehicles.Search(ctx, entity.VehicleFilter{MaxMileage: &maxMileage})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{suv.EntityID}, entityIDs(actual))
+
+		actual, err = repositories.Vehicles.Search(ctx, entity.VehicleFilter{Transmission: valueobjects.TransmissionCVT})
+
+		require.Nil(t, err)
+		assert.Empty(t, actual)
+	})
+
+	t.Run("should search vehicles by listing status and publish time", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		draft := mustCreateVehicle(t, repositories, 10000)
+
+		publishedAt := time.Now().Add(-time.Hour).UTC().Truncate(time.Millisecond)
+		published := newVehicle(repositories.dealerID, 20000)
+		published.Status = valueobjects.ListingStatusPublished
+		published.PublishedAt = &publishedAt
+		_, err := repositories.Vehicles.Create(ctx, published)
+		require.Nil(t, err)
+
+		scheduledAt := time.Now().Add(time.Hour).UTC()
+		scheduled := newVehicle(repositories.dealerID, 30000)
+		scheduled.Status = valueobjects.ListingStatusPublished
+		scheduled.PublishedAt = &scheduledAt
+		_, err = repositories.Vehicles.Create(ctx, scheduled)
+		require.Nil(t, err)
+
+		actual, err := repositories.Vehicles.Search(ctx, entity.VehicleFilter{PublishedOnly: true})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{published.EntityID}, entityIDs(actual))
+		assert.True(t, publishedAt.Equal(*actual[0].PublishedAt))
+
+		actual, err = repositories.Vehicles.Search(ctx, entity.VehicleFilter{Status: valueobjects.ListingStatusPublished})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{published.EntityID, scheduled.EntityID}, entityIDs(actual))
+
+		unlisted := draft
+		unlisted.Status = valueobjects.ListingStatusUnlisted
+		_, err = repositories.Vehicles.Update(ctx, draft.EntityID, unlisted)
+		require.Nil(t, err)
+
+		actual, err = repositories.Vehicles.Search(ctx, entity.VehicleFilter{Status: valueobjects.ListingStatusUnlisted})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{draft.EntityID}, entityIDs(actual))
+		assert.Nil(t, actual[0].PublishedAt)
+	})
+
+	t.Run("should update the vehicle fields", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		changed := vehicle
+		changed.Color = "Blue"
+		changed.Price = 12000.129
+
+		actual, err := repositories.Vehicles.Update(ctx, vehicle.EntityID, changed)
+
+		require.Nil(t, err)
+		assert.Equal(t, vehicle.ID, actual.ID)
+		assert.Equal(t, vehicle.DealerID, actual.DealerID)
+		assert.Equal(t, vehicle.Brand, actual.Brand)
+		assert.Equal(t, "Blue", actual.Color)
+		assert.Equal(t, 12000.13, actual.Price)
+		assert.False(t, actual.UpdatedAt.Before(vehicle.UpdatedAt))
+
+		stored, err := repositories.Vehicles.GetByID(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, "Blue", stored.Color)
+	})
+
+	t.Run("should create vehicle at version 1 and increment it on every update", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		assert.Equal(t, 1, vehicle.Version)
+
+		vehicle.Color = "Blue"
+		first, err := repositories.Vehicles.Update(ctx, vehicle.EntityID, vehicle)
+
+		require.Nil(t, err)
+		assert.Equal(t, 2, first.Version)
+
+		first.Color = "Red"
+		second, err := repositories.Vehicles.Update(ctx, vehicle.EntityID, *first)
+
+		require.Nil(t, err)
+		assert.Equal(t, 3, second.Version)
+
+		stored, err := repositories.Vehicles.GetByID(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, 3, stored.Version)
+	})
+
+	t.Run("should not update vehicle changed since the given version", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		blue := vehicle
+		blue.Color = "Blue"
+		_, err := repositories.Vehicles.Update(ctx, vehicle.EntityID, blue)
+		require.Nil(t, err)
+
+		red := vehicle
+		red.Color = "Red"
+		actual, err := repositories.Vehicles.Update(ctx, vehicle.EntityID, red)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrVersionMismatch)
+
+		stored, err := repositories.Vehicles.GetByID(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, "Blue", stored.Color)
+		assert.Equal(t, 2, stored.Version)
+	})
+
+	t.Run("should return nil when updating vehicle that does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Vehicles.Update(ctx, uuid.NewString(), newVehicle(repositories.dealerID, 10000))
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should delete vehicle with its media and offers", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+		mustCreateMedia(t, repositories, vehicle, 0)
+		mustCreateMedia(t, repositories, vehicle, 1)
+		mustCreateOffer(t, repositories, vehicle, 9000)
+
+		deleted, err := repositories.Vehicles.Delete(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, vehicle.EntityID, deleted.EntityID)
+
+		stored, err := repositories.Vehicles.GetByID(ctx, vehicle.EntityID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, stored)
+
+		media, err := repositories.Media.SearchByVehicleIDs(ctx, []string{vehicle.EntityID})
+
+		require.Nil(t, err)
+		assert.Empty(t, media)
+
+		offers, err := repositories.Offers.SearchByVehicleID(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Empty(t, offers)
+	})
+
+	t.Run("should not delete vehicle with a sale", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+		mustCreateSale(t, repositories, vehicle, valueobjects.SaleStatusTypePending, nil)
+
+		deleted, err := repositories.Vehicles.Delete(ctx, vehicle.EntityID)
+
+		assert.Nil(t, deleted)
+		assert.ErrorIs(t, err, domainerrors.ErrInUse)
+
+		stored, err := repositories.Vehicles.GetByID(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.NotNil(t, stored)
+	})
+
+	t.Run("should return nil when deleting vehicle that does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Vehicles.Delete(ctx, uuid.NewString())
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+}
+
+func testVehicleMediaRepository(t *testing.T, newRepositories Factory) {
+	ctx := tenant.WithAllDealers(context.TODO())
+
+	t.Run("should create media with id and dealer of the vehicle", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+		media := newVehicleMedia(vehicle, 0)
+
+		actual, err := repositories.Media.Create(ctx, media)
+
+		require.Nil(t, err)
+		assert.NotZero(t, actual.ID)
+		assert.Equal(t, repositories.dealerID, actual.DealerID)
+		assert.False(t, actual.CreatedAt.IsZero())
+
+		stored, err := repositories.Media.GetByID(ctx, media.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, media.VehicleID, stored.VehicleID)
+		assert.Equal(t, media.ContentType, stored.ContentType)
+		assert.Equal(t, media.Size, stored.Size)
+		assert.Equal(t, media.Width, stored.Width)
+		assert.Equal(t, media.Height, stored.Height)
+		assert.Equal(t, media.IsCover, stored.IsCover)
+		assert.Equal(t, media.StorageKey, stored.StorageKey)
+		assert.Equal(t, media.ThumbnailKey, stored.ThumbnailKey)
+	})
+
+	t.Run("should not create media with duplicated entity id", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+		media := mustCreateMedia(t, repositories, vehicle, 0)
+
+		duplicated := newVehicleMedia(vehicle, 1)
+		duplicated.EntityID = media.EntityID
+
+		actual, err := repositories.Media.Create(ctx, duplicated)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+	})
+
+	t.Run("should not create media for vehicle that does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := newVehicle(repositories.dealerID, 10000)
+
+		actual, err := repositories.Media.Create(ctx, newVehicleMedia(vehicle, 0))
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+	})
+
+	t.Run("should return nil when media does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Media.GetByID(ctx, uuid.NewString())
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should search media ordered by vehicle and position", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+		other := mustCreateVehicle(t, repositories, 20000)
+
+		second := mustCreateMedia(t, repositories, vehicle, 1)
+		first := mustCreateMedia(t, repositories, vehicle, 0)
+		mustCreateMedia(t, repositories, other, 0)
+
+		actual, err := repositories.Media.SearchByVehicleIDs(ctx, []string{vehicle.EntityID})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{first.EntityID, second.EntityID}, mediaIDs(actual))
+
+		all, err := repositories.Media.SearchByVehicleIDs(ctx, []string{vehicle.EntityID, other.EntityID})
+
+		require.Nil(t, err)
+		assert.Len(t, all, 3)
+
+		none, err := repositories.Media.SearchByVehicleIDs(ctx, nil)
+
+		require.Nil(t, err)
+		assert.Empty(t, none)
+	})
+
+	t.Run("should update position and cover", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		media := mustCreateMedia(t, repositories, mustCreateVehicle(t, repositories, 10000), 0)
+		media.Position = 3
+		media.IsCover = false
+		media.StorageKey = "other"
+
+		actual, err := repositories.Media.Update(ctx, media)
+
+		require.Nil(t, err)
+		assert.Equal(t, 3, actual.Position)
+		assert.False(t, actual.IsCover)
+		assert.NotEqual(t, "other", actual.StorageKey)
+
+		missing, err := repositories.Media.Update(ctx, newVehicleMedia(newVehicle(repositories.dealerID, 10000), 0))
+
+		assert.Nil(t, err)
+		assert.Nil(t, missing)
+	})
+
+	t.Run("should delete media once", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		media := mustCreateMedia(t, repositories, mustCreateVehicle(t, repositories, 10000), 0)
+
+		deleted, err := repositories.Media.Delete(ctx, media.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, media.StorageKey, deleted.StorageKey)
+
+		again, err := repositories.Media.Delete(ctx, media.EntityID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, again)
+	})
+
+	t.Run("should delete media of a vehicle", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+		other := mustCreateVehicle(t, repositories, 20000)
+
+		first := mustCreateMedia(t, repositories, vehicle, 0)
+		second := mustCreateMedia(t, repositories, vehicle, 1)
+		kept := mustCreateMedia(t, repositories, other, 0)
+
+		deleted, err := repositories.Media.DeleteByVehicleID(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.ElementsMatch(t, []string{first.EntityID, second.EntityID}, mediaIDs(deleted))
+
+		remaining, err := repositories.Media.SearchByVehicleIDs(ctx, []string{vehicle.EntityID, other.EntityID})
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{kept.EntityID}, mediaIDs(remaining))
+	})
+}
+
+func testOfferRepository(t *testing.T, newRepositories Factory) {
+	ctx := tenant.WithAllDealers(context.TODO())
+
+	t.Run("should create offer with id, dealer of the vehicle and timestamps", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+		offer := newOffer(vehicle, 9000.129)
+
+		actual, err := repositories.Offers.Create(ctx, offer)
+
+		require.Nil(t, err)
+		assert.NotZero(t, actual.ID)
+		assert.Equal(t, repositories.dealerID, actual.DealerID)
+		assert.False(t, actual.CreatedAt.IsZero())
+		assert.False(t, actual.UpdatedAt.IsZero())
+
+		stored, err := repositories.Offers.GetByID(ctx, offer.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, vehicle.EntityID, stored.VehicleID)
+		assert.Equal(t, offer.BuyerSubject, stored.BuyerSubject)
+		assert.Equal(t, offer.BuyerDocumentNumber, stored.BuyerDocumentNumber)
+		assert.Equal(t, 9000.13, stored.Amount)
+		assert.Nil(t, stored.CounterAmount)
+		assert.Equal(t, valueobjects.OfferStatusPending, stored.Status)
+		assert.Empty(t, stored.PaymentID)
+		assert.WithinDuration(t, offer.ExpiresAt, stored.ExpiresAt, time.Millisecond)
+	})
+
+	t.Run("should not create offer with duplicated entity id", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+		offer := mustCreateOffer(t, repositories, vehicle, 9000)
+
+		duplicated := newOffer(vehicle, 8000)
+		duplicated.EntityID = offer.EntityID
+
+		actual, err := repositories.Offers.Create(ctx, duplicated)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+	})
+
+	t.Run("should not create offer for vehicle that does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Offers.Create(ctx, newOffer(newVehicle(repositories.dealerID, 10000), 9000))
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+	})
+
+	t.Run("should return nil when offer does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Offers.GetByID(ctx, uuid.NewString())
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should search offers of a vehicle from the oldest", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+		other := mustCreateVehicle(t, repositories, 20000)
+
+		first := mustCreateOffer(t, repositories, vehicle, 9000)
+		mustCreateOffer(t, repositories, other, 19000)
+		second := mustCreateOffer(t, repositories, vehicle, 9500)
+
+		actual, err := repositories.Offers.SearchByVehicleID(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, []string{first.EntityID, second.EntityID}, offerIDs(actual))
+
+		none, err := repositories.Offers.SearchByVehicleID(ctx, uuid.NewString())
+
+		require.Nil(t, err)
+		assert.Empty(t, none)
+	})
+
+	t.Run("should update status, counter, payment and expiration", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		offer := mustCreateOffer(t, repositories, mustCreateVehicle(t, repositories, 10000), 9000)
+
+		counterAmount := 9500.555
+		expiresAt := time.Now().Add(48 * time.Hour).UTC()
+
+		changed := offer
+		changed.CounterAmount = &counterAmount
+		changed.Status = valueobjects.OfferStatusCountered
+		changed.PaymentID = "some-payment"
+		changed.ExpiresAt = expiresAt
+		changed.Amount = 1
+
+		actual, err := repositories.Offers.Update(ctx, changed)
+
+		require.Nil(t, err)
+		assert.Equal(t, valueobjects.OfferStatusCountered, actual.Status)
+		assert.Equal(t, 9500.56, *actual.CounterAmount)
+		assert.Equal(t, "some-payment", actual.PaymentID)
+		assert.WithinDuration(t, expiresAt, actual.ExpiresAt, time.Millisecond)
+		assert.Equal(t, float64(9000), actual.Amount)
+		assert.False(t, actual.UpdatedAt.Before(offer.UpdatedAt))
+
+		stored, err := repositories.Offers.GetByID(ctx, offer.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, valueobjects.OfferStatusCountered, stored.Status)
+
+		missing, err := repositories.Offers.Update(ctx, newOffer(newVehicle(repositories.dealerID, 10000), 9000))
+
+		assert.Nil(t, err)
+		assert.Nil(t, missing)
+	})
+}
+
+func testCouponRepository(t *testing.T, newRepositories Factory) {
+	ctx := tenant.WithAllDealers(context.TODO())
+
+	t.Run("should create coupon with id, no uses and timestamps", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		validUntil := time.Now().UTC().Add(24 * time.Hour)
+
+		coupon := newCoupon(repositories.dealerID, "BLACKFRIDAY")
+		coupon.Uses = 5
+		coupon.ValidUntil = &validUntil
+		coupon.Brand = "Some Brand"
+		coupon.MinYear = 2018
+
+		actual, err := repositories.Coupons.Create(ctx, coupon)
+
+		require.Nil(t, err)
+		assert.NotZero(t, actual.ID)
+		assert.Equal(t, repositories.dealerID, actual.DealerID)
+		assert.Equal(t, valueobjects.CouponCode("BLACKFRIDAY"), actual.Code)
+		assert.Equal(t, valueobjects.DiscountTypePercentage, actual.DiscountType)
+		assert.Equal(t, 10.0, actual.DiscountValue)
+		assert.Equal(t, 0, actual.Uses)
+		assert.Nil(t, actual.ValidFrom)
+		require.NotNil(t, actual.ValidUntil)
+		assert.WithinDuration(t, validUntil, *actual.ValidUntil, time.Millisecond)
+		assert.Equal(t, "Some Brand", actual.Brand)
+		assert.Equal(t, 2018, actual.MinYear)
+		assert.False(t, actual.CreatedAt.IsZero())
+	})
+
+	t.Run("should not create two coupons with the same code in a dealer", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+
+		mustCreateCoupon(t, repositories, newCoupon(repositories.dealerID, "BLACKFRIDAY"))
+		mustCreateCoupon(t, repositories, newCoupon(other.ID, "BLACKFRIDAY"))
+
+		actual, err := repositories.Coupons.Create(ctx, newCoupon(repositories.dealerID, "BLACKFRIDAY"))
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+	})
+
+	t.Run("should not create coupon for dealer that does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Coupons.Create(ctx, newCoupon(repositories.dealerID+100, "BLACKFRIDAY"))
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+	})
+
+	t.Run("should get coupon by id and by code of the dealer", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		other := mustCreateDealer(t, repositories, "Another Dealer")
+
+		coupon := mustCreateCoupon(t, repositories, newCoupon(repositories.dealerID, "BLACKFRIDAY"))
+		mustCreateCoupon(t, repositories, newCoupon(other.ID, "BLACKFRIDAY"))
+
+		actual, err := repositories.Coupons.GetByID(ctx, coupon.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, coupon.Code, actual.Code)
+
+		actual, err = repositories.Coupons.GetByCode(ctx, repositories.dealerID, "BLACKFRIDAY")
+
+		require.Nil(t, err)
+		assert.Equal(t, coupon.ID, actual.ID)
+
+		actual, err = repositories.Coupons.GetByCode(ctx, repositories.dealerID, "CYBERMONDAY")
+
+		assert.Nil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("should search coupons ordered by id", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		first := mustCreateCoupon(t, repositories, newCoupon(repositories.dealerID, "FIRST"))
+		second := mustCreateCoupon(t, repositories, newCoupon(repositories.dealerID, "SECOND"))
+
+		coupons, err := repositories.Coupons.Search(ctx)
+
+		require.Nil(t, err)
+		require.Len(t, coupons, 2)
+		assert.Equal(t, first.ID, coupons[0].ID)
+		assert.Equal(t, second.ID, coupons[1].ID)
+	})
+
+	t.Run("should redeem coupon up to its max uses", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		coupon := newCoupon(repositories.dealerID, "BLACKFRIDAY")
+		coupon.MaxUses = 2
+		coupon = mustCreateCoupon(t, repositories, coupon)
+
+		for uses := 1; uses <= 2; uses++ {
+			redeemed, err := repositories.Coupons.Redeem(ctx, coupon.ID)
+
+			require.Nil(t, err)
+			assert.Equal(t, uses, redeemed.Uses)
+		}
+
+		redeemed, err := repositories.Coupons.Redeem(ctx, coupon.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, redeemed)
+	})
+
+	t.Run("should redeem coupon without max uses any number of times", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		coupon := mustCreateCoupon(t, repositories, newCoupon(repositories.dealerID, "BLACKFRIDAY"))
+
+		for uses := 1; uses <= 3; uses++ {
+			redeemed, err := repositories.Coupons.Redeem(ctx, coupon.ID)
+
+			require.Nil(t, err)
+			assert.Equal(t, uses, redeemed.Uses)
+		}
+	})
+
+	t.Run("should release redeemed uses of coupon", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		coupon := newCoupon(repositories.dealerID, "BLACKFRIDAY")
+		coupon.MaxUses = 1
+		coupon = mustCreateCoupon(t, repositories, coupon)
+
+		released, err := repositories.Coupons.Release(ctx, coupon.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, released)
+
+		_, err = repositories.Coupons.Redeem(ctx, coupon.ID)
+		require.Nil(t, err)
+
+		released, err = repositories.Coupons.Release(ctx, coupon.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, 0, released.Uses)
+
+		redeemed, err := repositories.Coupons.Redeem(ctx, coupon.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, 1, redeemed.Uses)
+	})
+
+	t.Run("should delete coupon once", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		coupon := mustCreateCoupon(t, repositories, newCoupon(repositories.dealerID, "BLACKFRIDAY"))
+
+		deleted, err := repositories.Coupons.Delete(ctx, coupon.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, coupon.ID, deleted.ID)
+
+		deleted, err = repositories.Coupons.Delete(ctx, coupon.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, deleted)
+	})
+}
+
+func testFinancingPlanRepository(t *testing.T, newRepositories Factory) {
+	ctx := tenant.WithAllDealers(context.TODO())
+
+	t.Run("should create financing plan with id and timestamps", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		plan := newFinancingPlan(repositories.dealerID)
+		plan.MonthlyInterestRate = 1.23456
+
+		actual, err := repositories.FinancingPlans.Create(ctx, plan)
+
+		require.Nil(t, err)
+		assert.NotZero(t, actual.ID)
+		assert.Equal(t, repositories.dealerID, actual.DealerID)
+		assert.Equal(t, "Some Plan", actual.Name)
+		assert.Equal(t, valueobjects.AmortizationSystemPrice, actual.AmortizationSystem)
+		assert.Equal(t, 1.2346, actual.MonthlyInterestRate)
+		assert.Equal(t, 48, actual.MaxMonths)
+		assert.Equal(t, 20.0, actual.MinDownPaymentPercentage)
+		assert.False(t, actual.CreatedAt.IsZero())
+
+		stored, err := repositories.FinancingPlans.GetByID(ctx, actual.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, 1.2346, stored.MonthlyInterestRate)
+	})
+
+	t.Run("should not create financing plan for dealer that does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.FinancingPlans.Create(ctx, newFinancingPlan(repositories.dealerID+100))
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+	})
+
+	t.Run("should search financing plans ordered by id", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		first := mustCreateFinancingPlan(t, repositories, newFinancingPlan(repositories.dealerID))
+		second := mustCreateFinancingPlan(t, repositories, newFinancingPlan(repositories.dealerID))
+
+		plans, err := repositories.FinancingPlans.Search(ctx)
+
+		require.Nil(t, err)
+		require.Len(t, plans, 2)
+		assert.Equal(t, first.ID, plans[0].ID)
+		assert.Equal(t, second.ID, plans[1].ID)
+	})
+
+	t.Run("should return nil when financing plan does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.FinancingPlans.GetByID(ctx, 100)
+
+		assert.Nil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("should delete financing plan once", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		plan := mustCreateFinancingPlan(t, repositories, newFinancingPlan(repositories.dealerID))
+
+		deleted, err := repositories.FinancingPlans.Delete(ctx, plan.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, plan.ID, deleted.ID)
+
+		deleted, err = repositories.FinancingPlans.Delete(ctx, plan.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, deleted)
+	})
+}
+
+func testSaleRepository(t *testing.T, newRepositories Factory) {
+	ctx := tenant.WithAllDealers(context.TODO())
+
+	t.Run("should create sale with id and timestamps", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		actual, err := repositories.Sales.Create(ctx, entity.Sale{
+			EntityID:            vehicle.EntityID,
+			DealerID:            vehicle.DealerID,
+			PaymentID:           uuid.NewString(),
+			BuyerDocumentNumber: "12345678900",
+			ListPrice:           vehicle.Price,
+			Discount:            1000.5,
+			Price:               vehicle.Price - 1000.5,
+			CouponCode:          "BLACKFRIDAY",
+			Status:              valueobjects.SaleStatusTypePending,
+		})
+
+		require.Nil(t, err)
+		assert.NotZero(t, actual.ID)
+		assert.Equal(t, vehicle.EntityID, actual.EntityID)
+		assert.Equal(t, vehicle.Price, actual.ListPrice)
+		assert.Equal(t, 1000.5, actual.Discount)
+		assert.Equal(t, vehicle.Price-1000.5, actual.Price)
+		assert.Equal(t, "BLACKFRIDAY", actual.CouponCode)
+		assert.Equal(t, valueobjects.SaleStatusTypePending, actual.Status)
+		assert.Nil(t, actual.SoldAt)
+		assert.False(t, actual.CreatedAt.IsZero())
+	})
+
+	t.Run("should not create two sales for the same vehicle", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+		mustCreateSale(t, repositories, vehicle, valueobjects.SaleStatusTypePending, nil)
+
+		actual, err := repositories.Sales.Create(ctx, entity.Sale{
+			EntityID:            vehicle.EntityID,
+			DealerID:            vehicle.DealerID,
+			BuyerDocumentNumber: "12345678900",
+			Status:              valueobjects.SaleStatusTypePending,
+		})
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+	})
+
+	t.Run("should not create sale for vehicle that does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Sales.Create(ctx, entity.Sale{
+			EntityID:            uuid.NewString(),
+			DealerID:            repositories.dealerID,
+			BuyerDocumentNumber: "12345678900",
+			Status:              valueobjects.SaleStatusTypePending,
+		})
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+	})
+
+	t.Run("should return nil when sale does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.Sales.GetByEntityID(ctx, uuid.NewString())
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should search and count sales", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		mustCreateSale(t, repositories, mustCreateVehicle(t, repositories, 10000), valueobjects.SaleStatusTypePending, nil)
+		mustCreateSale(t, repositories, mustCreateVehicle(t, repositories, 20000), valueobjects.SaleStatusTypePending, nil)
+		mustCreateSale(t, repositories, mustCreateVehicle(t, repositories, 30000), valueobjects.SaleStatusTypeRejected, nil)
+
+		sales, err := repositories.Sales.Search(ctx)
+
+		require.Nil(t, err)
+		assert.Len(t, sales, 3)
+
+		pending, err := repositories.Sales.CountByStatus(ctx, valueobjects.SaleStatusTypePending.String())
+
+		require.Nil(t, err)
+		assert.Equal(t, 2, pending)
+	})
+
+	t.Run("should update sale status by payment id", func(t *testing.T) {
+		repositories := newRepositories(t)
+		soldDate := time.Now().UTC()
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+		sale := mustCreateSale(t, repositories, vehicle, valueobjects.SaleStatusTypePending, nil)
+
+		actual, previous, err := repositories.Sales.UpdateStatusByPaymentID(ctx, sale.PaymentID, valueobjects.SaleStatusTypeApproved.String(), soldDate)
+
+		require.Nil(t, err)
+		assert.Equal(t, sale.ID, actual.ID)
+		assert.Equal(t, valueobjects.SaleStatusTypeApproved, actual.Status)
+		assert.Equal(t, valueobjects.SaleStatusTypePending, previous)
+		require.NotNil(t, actual.SoldAt)
+		assert.WithinDuration(t, soldDate, *actual.SoldAt, time.Millisecond)
+
+		stored, err := repositories.Sales.GetByEntityID(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, valueobjects.SaleStatusTypeApproved, stored.Status)
+
+		_, previous, err = repositories.Sales.UpdateStatusByPaymentID(ctx, sale.PaymentID, valueobjects.SaleStatusTypeApproved.String(), soldDate)
+
+		require.Nil(t, err)
+		assert.Equal(t, valueobjects.SaleStatusTypeApproved, previous)
+	})
+
+	t.Run("should return nil when no sale has the payment id", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, _, err := repositories.Sales.UpdateStatusByPaymentID(ctx, uuid.NewString(), valueobjects.SaleStatusTypeApproved.String(), time.Now())
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should create sale with trade-in and link its vehicle once", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 50000)
+
+		tradeIn := newTradeIn()
+
+		created, err := repositories.Sales.Create(ctx, entity.Sale{
+			EntityID:            vehicle.EntityID,
+			DealerID:            vehicle.DealerID,
+			PaymentID:           uuid.NewString(),
+			BuyerDocumentNumber: "12345678900",
+			ListPrice:           vehicle.Price,
+			Price:               vehicle.Price,
+			TradeIn:             &tradeIn,
+			Status:              valueobjects.SaleStatusTypePending,
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, &tradeIn, created.TradeIn)
+		assert.Equal(t, 29999.9, created.AmountDue())
+
+		tradeInVehicle := mustCreateVehicle(t, repositories, tradeIn.AppraisedValue)
+
+		linked, err := repositories.Sales.LinkTradeInVehicle(ctx, created.ID, tradeInVehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, tradeInVehicle.EntityID, linked.TradeIn.VehicleID)
+		assert.Equal(t, tradeIn.Brand, linked.TradeIn.Brand)
+
+		stored, err := repositories.Sales.GetByEntityID(ctx, vehicle.EntityID)
+
+		require.Nil(t, err)
+		assert.Equal(t, tradeInVehicle.EntityID, stored.TradeIn.VehicleID)
+
+		linked, err = repositories.Sales.LinkTradeInVehicle(ctx, created.ID, uuid.NewString())
+
+		assert.Nil(t, err)
+		assert.Nil(t, linked)
+	})
+
+	t.Run("should not link vehicle to sale without trade-in", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		sale := mustCreateSale(t, repositories, mustCreateVehicle(t, repositories, 10000), valueobjects.SaleStatusTypeApproved, nil)
+
+		assert.Nil(t, sale.TradeIn)
+
+		linked, err := repositories.Sales.LinkTradeInVehicle(ctx, sale.ID, uuid.NewString())
+
+		assert.Nil(t, err)
+		assert.Nil(t, linked)
+	})
+
+	t.Run("should delete rejected sale with its installments and sell vehicle again", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		sale := mustCreateSale(t, repositories, vehicle, valueobjects.SaleStatusTypeRejected, nil)
+
+		_, err := repositories.Sales.CreateInstallments(ctx, sale.ID, newInstallments(2))
+		require.Nil(t, err)
+
+		deleted, err := repositories.Sales.DeleteRejected(ctx, sale.ID)
+
+		require.Nil(t, err)
+		require.NotNil(t, deleted)
+		assert.Equal(t, sale.ID, deleted.ID)
+
+		stored, err := repositories.Sales.GetByEntityID(ctx, vehicle.EntityID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, stored)
+
+		installments, err := repositories.Sales.SearchInstallments(ctx, sale.ID)
+
+		assert.Nil(t, err)
+		assert.Empty(t, installments)
+
+		again := mustCreateSale(t, repositories, vehicle, valueobjects.SaleStatusTypePending, nil)
+
+		assert.NotEqual(t, sale.ID, again.ID)
+
+		deleted, err = repositories.Sales.DeleteRejected(ctx, sale.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, deleted)
+	})
+
+	t.Run("should attach payment to reserved sale once", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		reserved, err := repositories.Sales.Create(ctx, entity.Sale{
+			EntityID:            vehicle.EntityID,
+			DealerID:            vehicle.DealerID,
+			BuyerDocumentNumber: "12345678900",
+			ListPrice:           vehicle.Price,
+			Price:               vehicle.Price,
+			Status:              valueobjects.SaleStatusTypePending,
+		})
+		require.Nil(t, err)
+
+		paymentID := uuid.NewString()
+
+		attached, err := repositories.Sales.AttachPayment(ctx, reserved.ID, paymentID)
+
+		require.Nil(t, err)
+		require.NotNil(t, attached)
+		assert.Equal(t, paymentID, attached.PaymentID)
+
+		updated, _, err := repositories.Sales.UpdateStatusByPaymentID(ctx, paymentID, valueobjects.SaleStatusTypeApproved.String(), time.Now())
+
+		require.Nil(t, err)
+		assert.Equal(t, reserved.ID, updated.ID)
+
+		attached, err = repositories.Sales.AttachPayment(ctx, reserved.ID, uuid.NewString())
+
+		assert.Nil(t, err)
+		assert.Nil(t, attached)
+
+		deleted, err := repositories.Sales.DeleteReservation(ctx, reserved.ID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, deleted)
+	})
+
+	t.Run("should delete reserved sale with its installments", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		reserved, err := repositories.Sales.Create(ctx, entity.Sale{
+			EntityID:            vehicle.EntityID,
+			DealerID:            vehicle.DealerID,
+			BuyerDocumentNumber: "12345678900",
+			ListPrice:           vehicle.Price,
+			Price:               vehicle.Price,
+			Status:              valueobjects.SaleStatusTypePending,
+		})
+		require.Nil(t, err)
+
+		_, err = repositories.Sales.CreateInstallments(ctx, reserved.ID, newInstallments(2))
+		require.Nil(t, err)
+
+		deleted, err := repositories.Sales.DeleteReservation(ctx, reserved.ID)
+
+		require.Nil(t, err)
+		require.NotNil(t, deleted)
+		assert.Equal(t, reserved.ID, deleted.ID)
+
+		stored, err := repositories.Sales.GetByEntityID(ctx, vehicle.EntityID)
+
+		assert.Nil(t, err)
+		assert.Nil(t, stored)
+
+		installments, err := repositories.Sales.SearchInstallments(ctx, reserved.ID)
+
+		assert.Nil(t, err)
+		assert.Empty(t, installments)
+	})
+
+	t.Run("should not delete sale that was not rejected", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		for _, status := range []valueobjects.SaleStatusType{valueobjects.SaleStatusTypePending, valueobjects.SaleStatusTypeApproved} {
+			sale := mustCreateSale(t, repositories, mustCreateVehicle(t, repositories, 10000), status, nil)
+
+			deleted, err := repositories.Sales.DeleteRejected(ctx, sale.ID)
+
+			assert.Nil(t, err)
+			assert.Nil(t, deleted)
+
+			stored, err := repositories.Sales.GetByID(ctx, sale.ID)
+
+			assert.Nil(t, err)
+			assert.NotNil(t, stored)
+		}
+	})
+
+	t.Run("should create financed sale and get it by id", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 50000)
+
+		financing := entity.Financing{
+			PlanID:              7,
+			AmortizationSystem:  valueobjects.AmortizationSystemSAC,
+			MonthlyInterestRate: 1.49,
+			DownPayment:         10000.1,
+			Principal:           39999.9,
+			Months:              24,
+		}
+
+		created, err := repositories.Sales.Create(ctx, entity.Sale{
+			EntityID:            vehicle.EntityID,
+			DealerID:            vehicle.DealerID,
+			PaymentID:           uuid.NewString(),
+			BuyerDocumentNumber: "12345678900",
+			ListPrice:           vehicle.Price,
+			Price:               vehicle.Price,
+			Financing:           &financing,
+			Status:              valueobjects.SaleStatusTypePending,
+		})
+
+		require.Nil(t, err)
+		assert.Equal(t, &financing, created.Financing)
+
+		stored, err := repositories.Sales.GetByID(ctx, created.ID)
+
+		require.Nil(t, err)
+		assert.Equal(t, vehicle.EntityID, stored.EntityID)
+		assert.Equal(t, &financing, stored.Financing)
+
+		missing, err := repositories.Sales.GetByID(ctx, created.ID+100)
+
+		assert.Nil(t, err)
+		assert.Nil(t, missing)
+	})
+
+	t.Run("should create and search installments of sale by number", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		sale := mustCreateSale(t, repositories, mustCreateVehicle(t, repositories, 10000), valueobjects.SaleStatusTypePending, nil)
+
+		installments := newInstallments(3)
+		slices.Reverse(installments)
+
+		created, err := repositories.Sales.CreateInstallments(ctx, sale.ID, installments)
+
+		require.Nil(t, err)
+		assert.Len(t, created, 3)
+
+		stored, err := repositories.Sales.SearchInstallments(ctx, sale.ID)
+
+		require.Nil(t, err)
+		require.Len(t, stored, 3)
+
+		for i, installment := range stored {
+			assert.Equal(t, sale.ID, installment.SaleID)
+			assert.Equal(t, i+1, installment.Number)
+			assert.Equal(t, time.Date(2026, time.Month(i+2), 15, 0, 0, 0, 0, time.UTC), installment.DueDate.UTC())
+			assert.Equal(t, 350.5, installment.Amount)
+			assert.Equal(t, 50.25, installment.Interest)
+			assert.Equal(t, 300.25, installment.Amortization)
+			assert.False(t, installment.IsPaid())
+		}
+
+		cash := mustCreateSale(t, repositories, mustCreateVehicle(t, repositories, 20000), valueobjects.SaleStatusTypePending, nil)
+
+		none, err := repositories.Sales.SearchInstallments(ctx, cash.ID)
+
+		require.Nil(t, err)
+		assert.Empty(t, none)
+	})
+
+	t.Run("should not create installments of sale that does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		created, err := repositories.Sales.CreateInstallments(ctx, 100, newInstallments(1))
+
+		assert.Nil(t, created)
+		assert.ErrorIs(t, err, domainerrors.ErrReferenceNotFound)
+	})
+
+	t.Run("should pay installment keeping the time of the first payment", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		sale := mustCreateSale(t, repositories, mustCreateVehicle(t, repositories, 10000), valueobjects.SaleStatusTypeApproved, nil)
+
+		_, err := repositories.Sales.CreateInstallments(ctx, sale.ID, newInstallments(2))
+		require.Nil(t, err)
+
+		paidAt := time.Now().UTC()
+
+		paid, err := repositories.Sales.PayInstallment(ctx, sale.PaymentID, 2, paidAt)
+
+		require.Nil(t, err)
+		assert.Equal(t, 2, paid.Number)
+		require.NotNil(t, paid.PaidAt)
+		assert.WithinDuration(t, paidAt, *paid.PaidAt, time.Millisecond)
+
+		paid, err = repositories.Sales.PayInstallment(ctx, sale.PaymentID, 2, paidAt.Add(time.Hour))
+
+		require.Nil(t, err)
+		assert.WithinDuration(t, paidAt, *paid.PaidAt, time.Millisecond)
+
+		stored, err := repositories.Sales.SearchInstallments(ctx, sale.ID)
+
+		require.Nil(t, err)
+		assert.False(t, stored[0].IsPaid())
+		assert.True(t, stored[1].IsPaid())
+
+		missing, err := repositories.Sales.PayInstallment(ctx, sale.PaymentID, 3, paidAt)
+
+		assert.Nil(t, err)
+		assert.Nil(t, missing)
+
+		missing, err = repositories.Sales.PayInstallment(ctx, uuid.NewString(), 1, paidAt)
+
+		assert.Nil(t, err)
+		assert.Nil(t, missing)
+	})
+}
+
+func testAPIKeyRepository(t *testing.T, newRepositories Factory) {
+	ctx := tenant.WithAllDealers(context.TODO())
+
+	t.Run("should create api key with id and roles", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		apiKey := newAPIKey(valueobjects.RoleAdmin, valueobjects.RoleBuyer)
+
+		actual, err := repositories.APIKeys.Create(ctx, apiKey)
+
+		require.Nil(t, err)
+		assert.NotZero(t, actual.ID)
+		assert.Equal(t, apiKey.Name, actual.Name)
+		assert.Equal(t, apiKey.KeyHash, actual.KeyHash)
+		assert.Equal(t, apiKey.Roles, actual.Roles)
+		assert.False(t, actual.CreatedAt.IsZero())
+		assert.Nil(t, actual.RevokedAt)
+	})
+
+	t.Run("should not create api key with duplicated hash", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		apiKey := newAPIKey(valueobjects.RoleAdmin)
+
+		_, err := repositories.APIKeys.Create(ctx, apiKey)
+		require.Nil(t, err)
+
+		actual, err := repositories.APIKeys.Create(ctx, apiKey)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, domainerrors.ErrAlreadyExists)
+	})
+
+	t.Run("should get api key by hash", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		created, err := repositories.APIKeys.Create(ctx, newAPIKey(valueobjects.RolePaymentsSystem))
+		require.Nil(t, err)
+
+		actual, err := repositories.APIKeys.GetByHash(ctx, created.KeyHash)
+
+		require.Nil(t, err)
+		assert.Equal(t, created.ID, actual.ID)
+		assert.Equal(t, []valueobjects.Role{valueobjects.RolePaymentsSystem}, actual.Roles)
+	})
+
+	t.Run("should return nil when api key does not exist", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.APIKeys.GetByHash(ctx, uuid.NewString())
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should search api keys ordered by id", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		first, err := repositories.APIKeys.Create(ctx, newAPIKey(valueobjects.RoleAdmin))
+		require.Nil(t, err)
+		second, err := repositories.APIKeys.Create(ctx, newAPIKey(valueobjects.RoleBuyer))
+		require.Nil(t, err)
+
+		actual, err := repositories.APIKeys.Search(ctx)
+
+		require.Nil(t, err)
+		require.Len(t, actual, 2)
+		assert.Equal(t, first.ID, actual[0].ID)
+		assert.Equal(t, second.ID, actual[1].ID)
+	})
+
+	t.Run("should revoke api key once", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		created, err := repositories.APIKeys.Create(ctx, newAPIKey(valueobjects.RoleAdmin))
+		require.Nil(t, err)
+
+		revokedAt := time.Now().Add(-time.Hour)
+
+		actual, err := repositories.APIKeys.Revoke(ctx, created.ID, revokedAt)
+
+		require.Nil(t, err)
+		require.NotNil(t, actual.RevokedAt)
+		assert.WithinDuration(t, revokedAt, *actual.RevokedAt, time.Millisecond)
+
+		actual, err = repositories.APIKeys.Revoke(ctx, created.ID, time.Now())
+
+		require.Nil(t, err)
+		assert.WithinDuration(t, revokedAt, *actual.RevokedAt, time.Millisecond)
+
+		stored, err := repositories.APIKeys.GetByHash(ctx, created.KeyHash)
+
+		require.Nil(t, err)
+		assert.True(t, stored.IsRevoked())
+	})
+
+	t.Run("should return nil when revoking unknown api key", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		actual, err := repositories.APIKeys.Revoke(ctx, 999, time.Now())
+
+		assert.Nil(t, actual)
+		assert.Nil(t, err)
+	})
+}
+
+func testTxManager(t *testing.T, newRepositories Factory) {
+	ctx := tenant.WithAllDealers(context.TODO())
+
+	if newRepositories(t).TxManager == nil {
+		t.Skip("backend does not support transactions")
+	}
+
+	t.Run("should keep writes after commit", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		txCtx, err := repositories.TxManager.Begin(ctx)
+		require.Nil(t, err)
+
+		vehicle, err := repositories.Vehicles.Create(txCtx, newVehicle(repositories.dealerID, 10000))
+		require.Nil(t, err)
+
+		require.Nil(t, repositories.TxManager.Commit(txCtx))
+
+		actual, err := repositories.Vehicles.GetByID(ctx, vehicle.EntityID)
+
+		assert.Nil(t, err)
+		assert.NotNil(t, actual)
+	})
+
+	t.Run("should discard writes of every repository after rollback", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		vehicle := mustCreateVehicle(t, repositories, 10000)
+
+		txCtx, err := repositories.TxManager.Begin(ctx)
+		require.Nil(t, err)
+
+		changed := vehicle
+		changed.Color = "Blue"
+
+		_, err = repositories.Vehicles.Update(txCtx, vehicle.EntityID, changed)
+		require.Nil(t, err)
+
+		sale := entity.Sale{
+			EntityID:            vehicle.EntityID,
+			DealerID:            vehicle.DealerID,
+			BuyerDocumentNumber: "12345678900",
+			Status:              valueobjects.SaleStatusTypePending,
+		}
+		_, err = repositories.Sales.Create(txCtx, sale)
+		require.Nil(t, err)
+
+		require.Nil(t, repositories.TxManager.Rollback(txCtx))
+
+		storedVehicle, err := repositories.Vehicles.GetByID(ctx, vehicle.EntityID)
+		require.Nil(t, err)
+		assert.Equal(t, vehicle.Color, storedVehicle.Color)
+
+		storedSale, err := repositories.Sales.GetByEntityID(ctx, vehicle.EntityID)
+		assert.Nil(t, err)
+		assert.Nil(t, storedSale)
+	})
+
+	t.Run("should not see uncommitted writes outside of the transaction", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		txCtx, err := repositories.TxManager.Begin(ctx)
+		require.Nil(t, err)
+		defer repositories.TxManager.Rollback(txCtx)
+
+		vehicle, err := repositories.Vehicles.Create(txCtx, newVehicle(repositories.dealerID, 10000))
+		require.Nil(t, err)
+
+		inside, err := repositories.Vehicles.GetByID(txCtx, vehicle.EntityID)
+		require.Nil(t, err)
+		assert.NotNil(t, inside)
+
+		outside, err := repositories.Vehicles.GetByID(ctx, vehicle.EntityID)
+		assert.Nil(t, err)
+		assert.Nil(t, outside)
+	})
+
+	t.Run("should not commit when a nested unit of work rolled back", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		outerCtx, err := repositories.TxManager.Begin(ctx)
+		require.Nil(t, err)
+
+		vehicle, err := repositories.Vehicles.Create(outerCtx, newVehicle(repositories.dealerID, 10000))
+		require.Nil(t, err)
+
+		innerCtx, err := repositories.TxManager.Begin(outerCtx)
+		require.Nil(t, err)
+		require.Nil(t, repositories.TxManager.Rollback(innerCtx))
+
+		err = repositories.TxManager.Commit(outerCtx)
+		assert.NotNil(t, err)
+
+		actual, err := repositories.Vehicles.GetByID(ctx, vehicle.EntityID)
+		assert.Nil(t, err)
+		assert.Nil(t, actual)
+	})
+
+	t.Run("should return error when committing without transaction", func(t *testing.T) {
+		repositories := newRepositories(t)
+
+		assert.NotNil(t, repositories.TxManager.Commit(ctx))
+		assert.NotNil(t, repositories.TxManager.Rollback(ctx))
+	})
+}
+
+func testRateLimitStore(t *testing.T, newRepositories Factory) {
+	ctx := context.TODO()
+	limit := ratelimit.PerMinute(60, 1)
+	now := time.Now().UTC().Truncate(time.Millisecond)
+
+	if newRepositories(t).RateLimits == nil {
+		t.Skip("backend does not share rate limit buckets")
+	}
+
+	t.Run("should spend and refill the bucket of a key", func(t *testing.T) {
+		repositories := newRepositories(t)
+		key := "contract:" + uuid.NewString()
+
+		first, err := repositories.RateLimits.Take(ctx, key, limit, now)
+		require.Nil(t, err)
+
+		second, err := repositories.RateLimits.Take(ctx, key, limit, now.Add(500*time.Millisecond))
+		require.Nil(t, err)
+
+		third, err := repositories.RateLimits.Take(ctx, key, limit, now.Add(time.Second))
+		require.Nil(t, err)
+
+		other, err := repositories.RateLimits.Take(ctx, key+":other", limit, now)
+		require.Nil(t, err)
+
+		assert.True(t, first.Allowed)
+		assert.False(t, second.Allowed)
+		assert.Equal(t, 500*time.Millisecond, second.RetryAfter)
+		assert.True(t, third.Allowed)
+		assert.True(t, other.Allowed)
+	})
+
+	t.Run("should prune idle buckets", func(t *testing.T) {
+		repositories := newRepositories(t)
+		key := "contract:" + uuid.NewString()
+
+		_, err := repositories.RateLimits.Take(ctx, key, limit, now)
+		require.Nil(t, err)
+
+		require.Nil(t, repositories.RateLimits.Prune(ctx, now.Add(time.Second)))
+
+		actual, err := repositories.RateLimits.Take(ctx, key, limit, now)
+
+		assert.Nil(t, err)
+		assert.True(t, actual.Allowed)
+	})
+}
+
+func newVehicle(dealerID int, price float64) entity.Vehicle {
+	vehicle := entity.Vehicle{
+		EntityID: uuid.NewString(),
+		DealerID: dealerID,
+		Brand:    "Some Brand",
+		Model:    "Some Model",
+		Year:     2020,
+		Color:    "Black",
+		Price:    price,
+		Status:   valueobjects.ListingStatusDraft,
+	}
+
+	vehicle.Slug = valueobjects.NewVehicleSlug(vehicle.Year, vehicle.Brand, vehicle.Model, vehicle.Color, vehicle.EntityID)
+
+	return vehicle
+}
+
+// withAttributes fills the optional attributes of vehicle, with the same VIN
+// on every call.
+func withAttributes(vehicle entity.Vehicle) entity.Vehicle {
+	vehicle.Mileage = 15000
+	vehicle.FuelType = valueobjects.FuelTypeFlex
+	vehicle.Transmission = valueobjects.TransmissionAutomatic
+	vehicle.BodyType = valueobjects.BodyTypeSUV
+	vehicle.Condition = valueobjects.VehicleConditionUsed
+	vehicle.Doors = 4
+	vehicle.EngineDisplacement = 2000
+	vehicle.LicensePlate = "ABC1D23"
+	vehicle.VIN = "9BWZZZ377VT004251"
+	return vehicle
+}
+
+func newVehicleMedia(vehicle entity.Vehicle, position int) entity.VehicleMedia {
+	entityID := uuid.NewString()
+
+	return entity.VehicleMedia{
+		EntityID:     entityID,
+		VehicleID:    vehicle.EntityID,
+		DealerID:     vehicle.DealerID,
+		ContentType:  "image/jpeg",
+		Size:         2048,
+		Width:        1280,
+		Height:       960,
+		Position:     position,
+		IsCover:      position == 0,
+		StorageKey:   "vehicles/" + vehicle.EntityID + "/" + entityID + ".jpg",
+		ThumbnailKey: "vehicles/" + vehicle.EntityID + "/" + entityID + "_thumbnail.jpg",
+	}
+}
+
+func newOffer(vehicle entity.Vehicle, amount float64) entity.Offer {
+	return entity.Offer{
+		EntityID:            uuid.NewString(),
+		VehicleID:           vehicle.EntityID,
+		DealerID:            vehicle.DealerID,
+		BuyerSubject:        "api_key:1",
+		BuyerDocumentNumber: "12345678900",
+		Amount:              amount,
+		Status:              valueobjects.OfferStatusPending,
+		ExpiresAt:           time.Now().Add(72 * time.Hour).UTC(),
+	}
+}
+
+func newCoupon(dealerID int, code valueobjects.CouponCode) entity.Coupon {
+	return entity.Coupon{
+		DealerID:      dealerID,
+		Code:          code,
+		DiscountType:  valueobjects.DiscountTypePercentage,
+		DiscountValue: 10,
+	}
+}
+
+func newFinancingPlan(dealerID int) entity.FinancingPlan {
+	return entity.FinancingPlan{
+		DealerID:                 dealerID,
+		Name:                     "Some Plan",
+		AmortizationSystem:       valueobjects.AmortizationSystemPrice,
+		MonthlyInterestRate:      1.49,
+		MaxMonths:                48,
+		MinDownPaymentPercentage: 20,
+	}
+}
+
+// newInstallments returns count installments due monthly from February 2026.
+func newInstallments(count int) []entity.Installment {
+	installments := make([]entity.Installment, count)
+
+	for i := range installments {
+		installments[i] = entity.Installment{
+			Number:       i + 1,
+			DueDate:      time.Date(2026, time.Month(i+2), 15, 0, 0, 0, 0, time.UTC),
+			Amount:       350.5,
+			Interest:     50.25,
+			Amortization: 300.25,
+			Balance:      float64(count-i-1) * 300.25,
+		}
+	}
+
+	return installments
+}
+
+func newTradeIn() entity.TradeIn {
+	return entity.TradeIn{
+		Brand:          "Some Brand",
+		Model:          "Some Model",
+		Year:           2015,
+		Color:          "Red",
+		Mileage:        90000,
+		LicensePlate:   "ABC1D23",
+		VIN:            "9BWZZZ377VT004251",
+		AppraisedValue: 20000.1,
+		AppraisedBy:    "api_key:1",
+	}
+}
+
+func newAPIKey(roles ...valueobjects.Role) entity.APIKey {
+	return entity.APIKey{
+		Name:    "Some Key",
+		Prefix:  "vps_abcdefgh",
+		KeyHash: uuid.NewString(),
+		Roles:   roles,
+	}
+}
+
+func mustCreateDealer(t *testing.T, repositories Repositories, name string) entity.Dealer {
+	t.Helper()
+
+	dealer, err := repositories.Dealers.Create(tenant.WithAllDealers(context.TODO()), entity.Dealer{Name: name})
+	require.Nil(t, err)
+
+	return *dealer
+}
+
+func mustCreateVehicle(t *testing.T, repositories Repositories, price float64) entity.Vehicle {
+	t.Helper()
+
+	vehicle, err := repositories.Vehicles.Create(tenant.WithAllDealers(context.TODO()), newVehicle(repositories.dealerID, price))
+	require.Nil(t, err)
+
+	return *vehicle
+}
+
+func mustCreateMedia(t *testing.T, repositories Repositories, vehicle entity.Vehicle, position int) entity.VehicleMedia {
+	t.Helper()
+
+	media, err := repositories.Media.Create(tenant.WithAllDealers(context.TODO()), newVehicleMedia(vehicle, position))
+	require.Nil(t, err)
+
+	return *media
+}
+
+func mustCreateOffer(t *testing.T, repositories Repositories, vehicle entity.Vehicle, amount float64) entity.Offer {
+	t.Helper()
+
+	offer, err := repositories.Offers.Create(tenant.WithAllDealers(context.TODO()), newOffer(vehicle, amount))
+	require.Nil(t, err)
+
+	return *offer
+}
+
+func mustCreateCoupon(t *testing.T, repositories Repositories, coupon entity.Coupon) entity.Coupon {
+	t.Helper()
+
+	created, err := repositories.Coupons.Create(tenant.WithAllDealers(context.TODO()), coupon)
+	require.Nil(t, err)
+
+	return *created
+}
+
+func mustCreateFinancingPlan(t *testing.T, repositories Repositories, plan entity.FinancingPlan) entity.FinancingPlan {
+	t.Helper()
+
+	created, err := repositories.FinancingPlans.Create(tenant.WithAllDealers(context.TODO()), plan)
+	require.Nil(t, err)
+
+	return *created
+}
+
+func mustCreateSale(t *testing.T, repositories Repositories, vehicle entity.Vehicle, status valueobjects.SaleStatusType, soldAt *time.Time) entity.Sale {
+	t.Helper()
+
+	sale, err := repositories.Sales.Create(tenant.WithAllDealers(context.TODO()), entity.Sale{
+		EntityID:            vehicle.EntityID,
+		DealerID:            vehicle.DealerID,
+		PaymentID:           uuid.NewString(),
+		BuyerDocumentNumber: "12345678900",
+		ListPrice:           vehicle.Price,
+		Price:               vehicle.Price,
+		Status:              status,
+		SoldAt:              soldAt,
+	})
+	require.Nil(t, err)
+
+	return *sale
+}
+
+func entityIDs(vehicles []entity.Vehicle) []string {
+	ids := make([]string, len(vehicles))
+	for i, vehicle := range vehicles {
+		ids[i] = vehicle.EntityID
+	}
+	return ids
+}
+
+func offerIDs(offers []entity.Offer) []string {
+	ids := make([]string, len(offers))
+	for i, offer := range offers {
+		ids[i] = offer.EntityID
+	}
+	return ids
+}
+
+func mediaIDs(media []entity.VehicleMedia) []string {
+	ids := make([]string, len(media))
+	for i, item := range media {
+		ids[i] = item.EntityID
+	}
+	return ids
+}
@@ -0,0 +1,181 @@
+package contract_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"io/fs"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	_ "github.com/jackc/pgx/v5/stdlib"
+	"github.com/stretchr/testify/require"
+
+	"github.com/caiiomp/vehicle-platform-sales/db"
+	"github.com/caiiomp/vehicle-platform-sales/src/ratelimit"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/cache"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/contract"
+	memoryapikeyrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/apiKeyRepository"
+	memorycouponrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/couponRepository"
+	memorydealerrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/dealerRepository"
+	memoryfinancingplanrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/financingPlanRepository"
+	memoryofferrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/offerRepository"
+	memorysalerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/saleRepository"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/store"
+	memoryvehiclemediarepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/vehicleMediaRepository"
+	memoryvehiclerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/vehicleRepository"
+	mongoapikeyrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/apiKeyRepository"
+	mongocouponrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/couponRepository"
+	mongodealerrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/dealerRepository"
+	mongofinancingplanrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/financingPlanRepository"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/mongodb"
+	mongoofferrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/offerRepository"
+	mongosalerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/saleRepository"
+	mongovehiclemediarepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/vehicleMediaRepository"
+	mongovehiclerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/vehicleRepository"
+	apikeyrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/apiKeyRepository"
+	couponrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/couponRepository"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/database"
+	dealerrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/dealerRepository"
+	financingplanrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/financingPlanRepository"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/migrator"
+	offerrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/offerRepository"
+	ratelimitrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/rateLimitRepository"
+	salerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/saleRepository"
+	vehiclemediarepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/vehicleMediaRepository"
+	vehiclerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/vehicleRepository"
+)
+
+// postgresDSNVariable points the suite at a scratch database. Every table the
+// suite touches is truncated before each test.
+const postgresDSNVariable = "TEST_POSTGRES_DSN"
+
+// mongoURIVariable points the suite at a mongod. Each test runs in its own
+// database, dropped at the end.
+const mongoURIVariable = "TEST_MONGO_URI"
+
+const truncateTables = "TRUNCATE sale_installments, sales, financing_plans, coupons, vehicle_offers, vehicle_media, vehicles, api_keys, dealers, rate_limit_buckets RESTART IDENTITY CASCADE;"
+
+func TestMemory(t *testing.T) {
+	contract.Run(t, func(t *testing.T) contract.Repositories {
+		memoryStore := store.NewStore(func() time.Time { return time.Now().UTC() })
+
+		return contract.Repositories{
+			Dealers:        memorydealerrepository.NewDealerRepository(memoryStore),
+			Vehicles:       memoryvehiclerepository.NewVehicleRepository(memoryStore),
+			Media:          memoryvehiclemediarepository.NewVehicleMediaRepository(memoryStore),
+			Offers:         memoryofferrepository.NewOfferRepository(memoryStore),
+			Coupons:        memorycouponrepository.NewCouponRepository(memoryStore),
+			FinancingPlans: memoryfinancingplanrepository.NewFinancingPlanRepository(memoryStore),
+			Sales:          memorysalerepository.NewSaleRepository(memoryStore),
+			APIKeys:        memoryapikeyrepository.NewAPIKeyRepository(memoryStore),
+			TxManager:      store.NewTxManager(memoryStore),
+			RateLimits:     ratelimit.NewMemoryStore(),
+		}
+	})
+}
+
+// TestMemoryWithCatalogCache checks that the cache decorators keep the
+// behaviour of the repositories they wrap.
+func TestMemoryWithCatalogCache(t *testing.T) {
+	contract.Run(t, func(t *testing.T) contract.Repositories {
+		memoryStore := store.NewStore(func() time.Time { return time.Now().UTC() })
+		catalog := cache.NewCatalog(cache.NewLRU(100, func() time.Time { return time.Now().UTC() }), time.Minute)
+
+		return contract.Repositories{
+			Dealers:        memorydealerrepository.NewDealerRepository(memoryStore),
+			Vehicles:       catalog.Vehicles(memoryvehiclerepository.NewVehicleRepository(memoryStore)),
+			Media:          memoryvehiclemediarepository.NewVehicleMediaRepository(memoryStore),
+			Offers:         memoryofferrepository.NewOfferRepository(memoryStore),
+			Coupons:        memorycouponrepository.NewCouponRepository(memoryStore),
+			FinancingPlans: memoryfinancingplanrepository.NewFinancingPlanRepository(memoryStore),
+			Sales:          catalog.Sales(memorysalerepository.NewSaleRepository(memoryStore)),
+			APIKeys:        memoryapikeyrepository.NewAPIKeyRepository(memoryStore),
+			TxManager:      catalog.TxManager(store.NewTxManager(memoryStore)),
+		}
+	})
+}
+
+func TestPostgres(t *testing.T) {
+	dsn := os.Getenv(postgresDSNVariable)
+	if dsn == "" {
+		t.Skipf("%s is not set", postgresDSNVariable)
+	}
+
+	ctx := context.TODO()
+
+	conn, err := sql.Open("pgx", dsn)
+	require.Nil(t, err)
+	t.Cleanup(func() { conn.Close() })
+
+	migrations, err := fs.Sub(db.Migrations, "migrations")
+	require.Nil(t, err)
+
+	schemaMigrator, err := migrator.NewMigrator(conn, migrations)
+	require.Nil(t, err)
+
+	if err = schemaMigrator.Up(ctx); err != nil && !errors.Is(err, migrator.ErrNothingToDo) {
+		require.Nil(t, err)
+	}
+
+	contract.Run(t, func(t *testing.T) contract.Repositories {
+		_, err := conn.ExecContext(ctx, truncateTables)
+		require.Nil(t, err)
+
+		return contract.Repositories{
+			Dealers:        dealerrepository.NewDealerRepository(conn),
+			Vehicles:       vehiclerepository.NewVehicleRepository(conn),
+			Media:          vehiclemediarepository.NewVehicleMediaRepository(conn),
+			Offers:         offerrepository.NewOfferRepository(conn),
+			Coupons:        couponrepository.NewCouponRepository(conn),
+			FinancingPlans: financingplanrepository.NewFinancingPlanRepository(conn),
+			Sales:          salerepository.NewSaleRepository(conn),
+			APIKeys:        apikeyrepository.NewAPIKeyRepository(conn),
+			TxManager:      database.NewTxManager(conn),
+			RateLimits:     ratelimitrepository.NewRateLimitRepository(conn),
+		}
+	})
+}
+
+func TestMongo(t *testing.T) {
+	uri := os.Getenv(mongoURIVariable)
+	if uri == "" {
+		t.Skipf("%s is not set", mongoURIVariable)
+	}
+
+	ctx := context.TODO()
+
+	client, err := mongodb.Connect(ctx, uri)
+	require.Nil(t, err)
+	t.Cleanup(func() { client.Disconnect(ctx) })
+
+	transactional, err := mongodb.SupportsTransactions(ctx, client)
+	require.Nil(t, err)
+
+	contract.Run(t, func(t *testing.T) contract.Repositories {
+		database := client.Database("contract_" + strings.ReplaceAll(uuid.NewString(), "-", ""))
+		t.Cleanup(func() { database.Drop(ctx) })
+
+		require.Nil(t, mongodb.EnsureIndexes(ctx, database))
+
+		repositories := contract.Repositories{
+			Dealers:        mongodealerrepository.NewDealerRepository(database),
+			Vehicles:       mongovehiclerepository.NewVehicleRepository(database),
+			Media:          mongovehiclemediarepository.NewVehicleMediaRepository(database),
+			Offers:         mongoofferrepository.NewOfferRepository(database),
+			Coupons:        mongocouponrepository.NewCouponRepository(database),
+			FinancingPlans: mongofinancingplanrepository.NewFinancingPlanRepository(database),
+			Sales:          mongosalerepository.NewSaleRepository(database),
+			APIKeys:        mongoapikeyrepository.NewAPIKeyRepository(database),
+		}
+
+		if transactional {
+			repositories.TxManager = mongodb.NewTxManager(client, true)
+		}
+
+		return repositories
+	})
+}
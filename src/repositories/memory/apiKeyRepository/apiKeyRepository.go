@@ -0,0 +1,138 @@
+package apikeyrepository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/store"
+)
+
+type apiKeyRepository struct {
+	store *store.Store
+}
+
+func NewAPIKeyRepository(store *store.Store) interfaces.APIKeyRepository {
+	return &apiKeyRepository{
+		store: store,
+	}
+}
+
+// Create enforces the unique key_hash and the dealer reference of the
+// api_keys table.
+func (ref *apiKeyRepository) Create(ctx context.Context, apiKey entity.APIKey) (*entity.APIKey, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(apiKey.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created entity.APIKey
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		if dealerID != 0 && !tables.HasDealer(dealerID) {
+			return fmt.Errorf("%w: dealer %d", domainerrors.ErrReferenceNotFound, dealerID)
+		}
+
+		if findAPIKey(tables, func(row entity.APIKey) bool { return row.KeyHash == apiKey.KeyHash }) >= 0 {
+			return fmt.Errorf("%w: api key %q", domainerrors.ErrAlreadyExists, apiKey.Prefix)
+		}
+
+		created = entity.APIKey{
+			ID:        tables.APIKeys.NextID(),
+			DealerID:  dealerID,
+			Name:      apiKey.Name,
+			Prefix:    apiKey.Prefix,
+			KeyHash:   apiKey.KeyHash,
+			Roles:     slices.Clone(apiKey.Roles),
+			CreatedAt: ref.store.Now(),
+		}
+
+		tables.APIKeys.Rows = append(tables.APIKeys.Rows, created)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+// GetByHash is the one query not restricted to a tenant scope, since it runs
+// to authenticate the caller the scope is resolved from.
+func (ref *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	var apiKey *entity.APIKey
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		if i := findAPIKey(tables, func(row entity.APIKey) bool { return row.KeyHash == keyHash }); i >= 0 {
+			found := tables.APIKeys.Rows[i]
+			apiKey = &found
+		}
+	})
+
+	return apiKey, nil
+}
+
+func (ref *apiKeyRepository) Search(ctx context.Context) ([]entity.APIKey, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	apiKeys := make([]entity.APIKey, 0)
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		for _, apiKey := range tables.APIKeys.Rows {
+			if scope.Allows(apiKey.DealerID) {
+				apiKeys = append(apiKeys, apiKey)
+			}
+		}
+	})
+
+	return apiKeys, nil
+}
+
+// Revoke keeps the first revocation time when a key is revoked twice.
+func (ref *apiKeyRepository) Revoke(ctx context.Context, id int, revokedAt time.Time) (*entity.APIKey, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var revoked *entity.APIKey
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findAPIKey(tables, func(row entity.APIKey) bool { return row.ID == id && scope.Allows(row.DealerID) })
+		if i < 0 {
+			return nil
+		}
+
+		apiKey := tables.APIKeys.Rows[i]
+		if apiKey.RevokedAt == nil {
+			truncated := revokedAt.Truncate(time.Microsecond)
+			apiKey.RevokedAt = &truncated
+			tables.APIKeys.Rows[i] = apiKey
+		}
+
+		revoked = &apiKey
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return revoked, nil
+}
+
+func findAPIKey(tables *store.Tables, match func(entity.APIKey) bool) int {
+	return slices.IndexFunc(tables.APIKeys.Rows, match)
+}
@@ -0,0 +1,223 @@
+package couponrepository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/store"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+)
+
+type couponRepository struct {
+	store *store.Store
+}
+
+func NewCouponRepository(store *store.Store) interfaces.CouponRepository {
+	return &couponRepository{
+		store: store,
+	}
+}
+
+// Create enforces the same constraints as the coupons table: a dealer that
+// exists and codes unique within the dealer.
+func (ref *couponRepository) Create(ctx context.Context, coupon entity.Coupon) (*entity.Coupon, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(coupon.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created entity.Coupon
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		if !tables.HasDealer(dealerID) {
+			return fmt.Errorf("%w: dealer %d", domainerrors.ErrReferenceNotFound, dealerID)
+		}
+
+		if findCoupon(tables, func(row entity.Coupon) bool { return row.DealerID == dealerID && row.Code == coupon.Code }) >= 0 {
+			return fmt.Errorf("%w: coupon %q", domainerrors.ErrAlreadyExists, coupon.Code)
+		}
+
+		now := ref.store.Now()
+
+		created = coupon
+		created.ID = tables.Coupons.NextID()
+		created.DealerID = dealerID
+		created.DiscountValue = model.RoundPrice(coupon.DiscountValue)
+		created.Uses = 0
+		created.ValidFrom = truncate(coupon.ValidFrom)
+		created.ValidUntil = truncate(coupon.ValidUntil)
+		created.CreatedAt = now
+		created.UpdatedAt = now
+
+		tables.Coupons.Rows = append(tables.Coupons.Rows, created)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (ref *couponRepository) GetByID(ctx context.Context, id int) (*entity.Coupon, error) {
+	return ref.get(ctx, func(row entity.Coupon) bool { return row.ID == id })
+}
+
+func (ref *couponRepository) GetByCode(ctx context.Context, dealerID int, code valueobjects.CouponCode) (*entity.Coupon, error) {
+	return ref.get(ctx, func(row entity.Coupon) bool { return row.DealerID == dealerID && row.Code == code })
+}
+
+func (ref *couponRepository) Search(ctx context.Context) ([]entity.Coupon, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	coupons := make([]entity.Coupon, 0)
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		for _, coupon := range tables.Coupons.Rows {
+			if scope.Allows(coupon.DealerID) {
+				coupons = append(coupons, coupon)
+			}
+		}
+	})
+
+	return coupons, nil
+}
+
+func (ref *couponRepository) Redeem(ctx context.Context, id int) (*entity.Coupon, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var redeemed *entity.Coupon
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findCoupon(tables, func(row entity.Coupon) bool { return row.ID == id && scope.Allows(row.DealerID) })
+		if i < 0 {
+			return nil
+		}
+
+		coupon := tables.Coupons.Rows[i]
+		if coupon.MaxUses > 0 && coupon.Uses >= coupon.MaxUses {
+			return nil
+		}
+
+		coupon.Uses++
+		coupon.UpdatedAt = ref.store.Now()
+		tables.Coupons.Rows[i] = coupon
+
+		redeemed = &coupon
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return redeemed, nil
+}
+
+func (ref *couponRepository) Release(ctx context.Context, id int) (*entity.Coupon, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var released *entity.Coupon
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findCoupon(tables, func(row entity.Coupon) bool { return row.ID == id && scope.Allows(row.DealerID) })
+		if i < 0 {
+			return nil
+		}
+
+		coupon := tables.Coupons.Rows[i]
+		if coupon.Uses == 0 {
+			return nil
+		}
+
+		coupon.Uses--
+		coupon.UpdatedAt = ref.store.Now()
+		tables.Coupons.Rows[i] = coupon
+
+		released = &coupon
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return released, nil
+}
+
+func (ref *couponRepository) Delete(ctx context.Context, id int) (*entity.Coupon, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted *entity.Coupon
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findCoupon(tables, func(row entity.Coupon) bool { return row.ID == id && scope.Allows(row.DealerID) })
+		if i < 0 {
+			return nil
+		}
+
+		coupon := tables.Coupons.Rows[i]
+		tables.Coupons.Rows = slices.Delete(tables.Coupons.Rows, i, i+1)
+
+		deleted = &coupon
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+func (ref *couponRepository) get(ctx context.Context, match func(entity.Coupon) bool) (*entity.Coupon, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var coupon *entity.Coupon
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		if i := findCoupon(tables, func(row entity.Coupon) bool { return match(row) && scope.Allows(row.DealerID) }); i >= 0 {
+			found := tables.Coupons.Rows[i]
+			coupon = &found
+		}
+	})
+
+	return coupon, nil
+}
+
+func findCoupon(tables *store.Tables, match func(entity.Coupon) bool) int {
+	return slices.IndexFunc(tables.Coupons.Rows, match)
+}
+
+func truncate(date *time.Time) *time.Time {
+	if date == nil {
+		return nil
+	}
+
+	truncated := date.Truncate(time.Microsecond)
+	return &truncated
+}
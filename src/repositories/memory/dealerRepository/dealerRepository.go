@@ -0,0 +1,84 @@
+package dealerrepository
+
+import (
+	"context"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/store"
+)
+
+type dealerRepository struct {
+	store *store.Store
+}
+
+func NewDealerRepository(store *store.Store) interfaces.DealerRepository {
+	return &dealerRepository{
+		store: store,
+	}
+}
+
+func (ref *dealerRepository) Create(ctx context.Context, dealer entity.Dealer) (*entity.Dealer, error) {
+	if err := tenant.RequireAllDealers(ctx); err != nil {
+		return nil, err
+	}
+
+	var created entity.Dealer
+
+	err := ref.store.Write(ctx, func(tables *store.Tables) error {
+		created = entity.Dealer{
+			ID:        tables.Dealers.NextID(),
+			Name:      dealer.Name,
+			CreatedAt: ref.store.Now(),
+		}
+
+		tables.Dealers.Rows = append(tables.Dealers.Rows, created)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (ref *dealerRepository) GetByID(ctx context.Context, id int) (*entity.Dealer, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var dealer *entity.Dealer
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		for _, row := range tables.Dealers.Rows {
+			if row.ID == id && scope.Allows(row.ID) {
+				found := row
+				dealer = &found
+				return
+			}
+		}
+	})
+
+	return dealer, nil
+}
+
+func (ref *dealerRepository) Search(ctx context.Context) ([]entity.Dealer, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealers := make([]entity.Dealer, 0)
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		for _, dealer := range tables.Dealers.Rows {
+			if scope.Allows(dealer.ID) {
+				dealers = append(dealers, dealer)
+			}
+		}
+	})
+
+	return dealers, nil
+}
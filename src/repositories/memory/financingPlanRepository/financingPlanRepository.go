@@ -0,0 +1,134 @@
+package financingplanrepository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/store"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+)
+
+type financingPlanRepository struct {
+	store *store.Store
+}
+
+func NewFinancingPlanRepository(store *store.Store) interfaces.FinancingPlanRepository {
+	return &financingPlanRepository{
+		store: store,
+	}
+}
+
+// Create enforces the same constraint as the financing_plans table: a dealer
+// that exists.
+func (ref *financingPlanRepository) Create(ctx context.Context, plan entity.FinancingPlan) (*entity.FinancingPlan, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(plan.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created entity.FinancingPlan
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		if !tables.HasDealer(dealerID) {
+			return fmt.Errorf("%w: dealer %d", domainerrors.ErrReferenceNotFound, dealerID)
+		}
+
+		now := ref.store.Now()
+
+		created = plan
+		created.ID = tables.FinancingPlans.NextID()
+		created.DealerID = dealerID
+		created.MonthlyInterestRate = model.RoundRate(plan.MonthlyInterestRate)
+		created.MinDownPaymentPercentage = model.RoundPrice(plan.MinDownPaymentPercentage)
+		created.CreatedAt = now
+		created.UpdatedAt = now
+
+		tables.FinancingPlans.Rows = append(tables.FinancingPlans.Rows, created)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (ref *financingPlanRepository) GetByID(ctx context.Context, id int) (*entity.FinancingPlan, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var plan *entity.FinancingPlan
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		if i := findFinancingPlan(tables, scope, id); i >= 0 {
+			found := tables.FinancingPlans.Rows[i]
+			plan = &found
+		}
+	})
+
+	return plan, nil
+}
+
+func (ref *financingPlanRepository) Search(ctx context.Context) ([]entity.FinancingPlan, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	plans := make([]entity.FinancingPlan, 0)
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		for _, plan := range tables.FinancingPlans.Rows {
+			if scope.Allows(plan.DealerID) {
+				plans = append(plans, plan)
+			}
+		}
+	})
+
+	return plans, nil
+}
+
+func (ref *financingPlanRepository) Delete(ctx context.Context, id int) (*entity.FinancingPlan, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted *entity.FinancingPlan
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findFinancingPlan(tables, scope, id)
+		if i < 0 {
+			return nil
+		}
+
+		plan := tables.FinancingPlans.Rows[i]
+		tables.FinancingPlans.Rows = slices.Delete(tables.FinancingPlans.Rows, i, i+1)
+
+		deleted = &plan
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+func findFinancingPlan(tables *store.Tables, scope tenant.Scope, id int) int {
+	return slices.IndexFunc(tables.FinancingPlans.Rows, func(plan entity.FinancingPlan) bool {
+		return plan.ID == id && scope.Allows(plan.DealerID)
+	})
+}
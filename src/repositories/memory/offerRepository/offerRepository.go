@@ -0,0 +1,158 @@
+package offerrepository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/store"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+)
+
+type offerRepository struct {
+	store *store.Store
+}
+
+func NewOfferRepository(store *store.Store) interfaces.OfferRepository {
+	return &offerRepository{
+		store: store,
+	}
+}
+
+// Create enforces the same constraints as the vehicle_offers table: unique
+// entity ids and a vehicle that exists in the dealer of the offer.
+func (ref *offerRepository) Create(ctx context.Context, offer entity.Offer) (*entity.Offer, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(offer.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created entity.Offer
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		if findOffer(tables, tenant.Scope{}, offer.EntityID) >= 0 {
+			return fmt.Errorf("%w: offer %q", domainerrors.ErrAlreadyExists, offer.EntityID)
+		}
+
+		exists := slices.ContainsFunc(tables.Vehicles.Rows, func(vehicle entity.Vehicle) bool {
+			return vehicle.EntityID == offer.VehicleID && vehicle.DealerID == dealerID
+		})
+		if !exists {
+			return fmt.Errorf("%w: vehicle %q", domainerrors.ErrReferenceNotFound, offer.VehicleID)
+		}
+
+		now := ref.store.Now()
+
+		created = offer
+		created.ID = tables.Offers.NextID()
+		created.DealerID = dealerID
+		created.Amount = model.RoundPrice(offer.Amount)
+		created.CounterAmount = roundPrice(offer.CounterAmount)
+		created.ExpiresAt = offer.ExpiresAt.Truncate(time.Microsecond)
+		created.CreatedAt = now
+		created.UpdatedAt = now
+
+		tables.Offers.Rows = append(tables.Offers.Rows, created)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (ref *offerRepository) GetByID(ctx context.Context, id string) (*entity.Offer, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var offer *entity.Offer
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		if i := findOffer(tables, scope, id); i >= 0 {
+			found := tables.Offers.Rows[i]
+			offer = &found
+		}
+	})
+
+	return offer, nil
+}
+
+func (ref *offerRepository) SearchByVehicleID(ctx context.Context, vehicleID string) ([]entity.Offer, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	offers := make([]entity.Offer, 0)
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		for _, offer := range tables.Offers.Rows {
+			if offer.VehicleID == vehicleID && scope.Allows(offer.DealerID) {
+				offers = append(offers, offer)
+			}
+		}
+	})
+
+	return offers, nil
+}
+
+func (ref *offerRepository) Update(ctx context.Context, offer entity.Offer) (*entity.Offer, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *entity.Offer
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findOffer(tables, scope, offer.EntityID)
+		if i < 0 {
+			return nil
+		}
+
+		current := tables.Offers.Rows[i]
+		current.CounterAmount = roundPrice(offer.CounterAmount)
+		current.Status = offer.Status
+		current.PaymentID = offer.PaymentID
+		current.ExpiresAt = offer.ExpiresAt.Truncate(time.Microsecond)
+		current.UpdatedAt = ref.store.Now()
+
+		tables.Offers.Rows[i] = current
+
+		updated = &current
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func findOffer(tables *store.Tables, scope tenant.Scope, entityID string) int {
+	return slices.IndexFunc(tables.Offers.Rows, func(offer entity.Offer) bool {
+		return offer.EntityID == entityID && scope.Allows(offer.DealerID)
+	})
+}
+
+func roundPrice(price *float64) *float64 {
+	if price == nil {
+		return nil
+	}
+
+	rounded := model.RoundPrice(*price)
+	return &rounded
+}
@@ -0,0 +1,476 @@
+package salerepository
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"time"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/store"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+)
+
+type saleRepository struct {
+	store *store.Store
+}
+
+func NewSaleRepository(store *store.Store) interfaces.SaleRepository {
+	return &saleRepository{
+		store: store,
+	}
+}
+
+// Create enforces the same constraints as the sales table: one sale per
+// vehicle and a vehicle that exists in the dealer of the sale.
+func (ref *saleRepository) Create(ctx context.Context, sale entity.Sale) (*entity.Sale, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(sale.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created entity.Sale
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		if findSale(tables, tenant.Scope{}, sale.EntityID) >= 0 {
+			return fmt.Errorf("%w: sale for vehicle %q", domainerrors.ErrAlreadyExists, sale.EntityID)
+		}
+
+		exists := slices.ContainsFunc(tables.Vehicles.Rows, func(vehicle entity.Vehicle) bool {
+			return vehicle.EntityID == sale.EntityID && vehicle.DealerID == dealerID
+		})
+		if !exists {
+			return fmt.Errorf("%w: vehicle %q", domainerrors.ErrReferenceNotFound, sale.EntityID)
+		}
+
+		now := ref.store.Now()
+
+		created = entity.Sale{
+			ID:                  tables.Sales.NextID(),
+			EntityID:            sale.EntityID,
+			DealerID:            dealerID,
+			PaymentID:           sale.PaymentID,
+			BuyerDocumentNumber: sale.BuyerDocumentNumber,
+			ListPrice:           model.RoundPrice(sale.ListPrice),
+			Discount:            model.RoundPrice(sale.Discount),
+			Price:               model.RoundPrice(sale.Price),
+			CouponCode:          sale.CouponCode,
+			TradeIn:             roundTradeIn(sale.TradeIn),
+			Financing:           roundFinancing(sale.Financing),
+			Status:              sale.Status,
+			SoldAt:              truncate(sale.SoldAt),
+			CreatedAt:           now,
+			UpdatedAt:           now,
+		}
+
+		tables.Sales.Rows = append(tables.Sales.Rows, created)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (ref *saleRepository) GetByID(ctx context.Context, id int) (*entity.Sale, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sale *entity.Sale
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		if i := findSaleByID(tables, scope, id); i >= 0 {
+			found := tables.Sales.Rows[i]
+			sale = &found
+		}
+	})
+
+	return sale, nil
+}
+
+func (ref *saleRepository) GetByEntityID(ctx context.Context, entityID string) (*entity.Sale, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var sale *entity.Sale
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		if i := findSale(tables, scope, entityID); i >= 0 {
+			found := tables.Sales.Rows[i]
+			sale = &found
+		}
+	})
+
+	return sale, nil
+}
+
+func (ref *saleRepository) Search(ctx context.Context) ([]entity.Sale, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sales := make([]entity.Sale, 0)
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		for _, sale := range tables.Sales.Rows {
+			if scope.Allows(sale.DealerID) {
+				sales = append(sales, sale)
+			}
+		}
+	})
+
+	return sales, nil
+}
+
+func (ref *saleRepository) CountByStatus(ctx context.Context, status string) (int, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		for _, sale := range tables.Sales.Rows {
+			if sale.Status == valueobjects.SaleStatusType(status) && scope.Allows(sale.DealerID) {
+				count++
+			}
+		}
+	})
+
+	return count, nil
+}
+
+// UpdateStatusByPaymentID updates every sale with the payment and returns the
+// first one, as the UPDATE ... RETURNING read through QueryRow does. The write
+// lock of the store stands in for the row lock.
+func (ref *saleRepository) UpdateStatusByPaymentID(ctx context.Context, paymentID, status string, soldDate time.Time) (*entity.Sale, valueobjects.SaleStatusType, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	var updated *entity.Sale
+	var previous valueobjects.SaleStatusType
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		now := ref.store.Now()
+
+		for i, sale := range tables.Sales.Rows {
+			if sale.PaymentID != paymentID || !scope.Allows(sale.DealerID) {
+				continue
+			}
+
+			if updated == nil {
+				previous = sale.Status
+			}
+
+			sale.Status = valueobjects.SaleStatusType(status)
+			sale.SoldAt = truncate(&soldDate)
+			sale.UpdatedAt = now
+			tables.Sales.Rows[i] = sale
+
+			if updated == nil {
+				updated = &sale
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, "", err
+	}
+
+	if updated == nil {
+		logger.FromContext(ctx).DebugContext(ctx, "no sale to update", "payment_id", paymentID)
+	}
+
+	return updated, previous, nil
+}
+
+// LinkTradeInVehicle replaces the trade-in instead of changing it, as the
+// copies of the tables taken by transactions share it.
+func (ref *saleRepository) LinkTradeInVehicle(ctx context.Context, id int, vehicleID string) (*entity.Sale, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var linked *entity.Sale
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findSaleByID(tables, scope, id)
+		if i < 0 {
+			return nil
+		}
+
+		sale := tables.Sales.Rows[i]
+		if sale.TradeIn == nil || sale.TradeIn.VehicleID != "" {
+			return nil
+		}
+
+		tradeIn := *sale.TradeIn
+		tradeIn.VehicleID = vehicleID
+
+		sale.TradeIn = &tradeIn
+		sale.UpdatedAt = ref.store.Now()
+		tables.Sales.Rows[i] = sale
+
+		linked = &sale
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return linked, nil
+}
+
+func (ref *saleRepository) AttachPayment(ctx context.Context, id int, paymentID string) (*entity.Sale, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var attached *entity.Sale
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findSaleByID(tables, scope, id)
+		if i < 0 || tables.Sales.Rows[i].PaymentID != "" {
+			return nil
+		}
+
+		sale := tables.Sales.Rows[i]
+		sale.PaymentID = paymentID
+		sale.UpdatedAt = ref.store.Now()
+		tables.Sales.Rows[i] = sale
+
+		attached = &sale
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return attached, nil
+}
+
+func (ref *saleRepository) DeleteReservation(ctx context.Context, id int) (*entity.Sale, error) {
+	return ref.delete(ctx, id, func(sale entity.Sale) bool {
+		return sale.PaymentID == ""
+	})
+}
+
+func (ref *saleRepository) DeleteRejected(ctx context.Context, id int) (*entity.Sale, error) {
+	return ref.delete(ctx, id, func(sale entity.Sale) bool {
+		return sale.Status == valueobjects.SaleStatusTypeRejected
+	})
+}
+
+// delete takes the installments of the sale with it, as the cascade of the
+// sale_installments table does, when the sale matches.
+func (ref *saleRepository) delete(ctx context.Context, id int, matches func(sale entity.Sale) bool) (*entity.Sale, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted *entity.Sale
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findSaleByID(tables, scope, id)
+		if i < 0 || !matches(tables.Sales.Rows[i]) {
+			return nil
+		}
+
+		sale := tables.Sales.Rows[i]
+		tables.Sales.Rows = slices.Delete(tables.Sales.Rows, i, i+1)
+		tables.Installments.Rows = slices.DeleteFunc(tables.Installments.Rows, func(installment entity.Installment) bool {
+			return installment.SaleID == id
+		})
+
+		deleted = &sale
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+// CreateInstallments enforces the same constraints as the sale_installments
+// table: a sale that exists and one installment per number. Due dates keep
+// only their day, as in a DATE column.
+func (ref *saleRepository) CreateInstallments(ctx context.Context, id int, installments []entity.Installment) ([]entity.Installment, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	created := make([]entity.Installment, 0, len(installments))
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		if findSaleByID(tables, scope, id) < 0 {
+			return fmt.Errorf("%w: sale %d", domainerrors.ErrReferenceNotFound, id)
+		}
+
+		for _, installment := range installments {
+			if findInstallment(tables, id, installment.Number) >= 0 {
+				return fmt.Errorf("%w: installment %d of sale %d", domainerrors.ErrAlreadyExists, installment.Number, id)
+			}
+
+			year, month, day := installment.DueDate.Date()
+
+			row := entity.Installment{
+				SaleID:       id,
+				Number:       installment.Number,
+				DueDate:      time.Date(year, month, day, 0, 0, 0, 0, time.UTC),
+				Amount:       model.RoundPrice(installment.Amount),
+				Interest:     model.RoundPrice(installment.Interest),
+				Amortization: model.RoundPrice(installment.Amortization),
+				Balance:      model.RoundPrice(installment.Balance),
+			}
+
+			tables.Installments.Rows = append(tables.Installments.Rows, row)
+			created = append(created, row)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (ref *saleRepository) SearchInstallments(ctx context.Context, id int) ([]entity.Installment, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	installments := make([]entity.Installment, 0)
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		if findSaleByID(tables, scope, id) < 0 {
+			return
+		}
+
+		for _, installment := range tables.Installments.Rows {
+			if installment.SaleID == id {
+				installments = append(installments, installment)
+			}
+		}
+	})
+
+	slices.SortFunc(installments, func(a, b entity.Installment) int {
+		return a.Number - b.Number
+	})
+
+	return installments, nil
+}
+
+func (ref *saleRepository) PayInstallment(ctx context.Context, paymentID string, number int, paidAt time.Time) (*entity.Installment, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var paid *entity.Installment
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		for _, sale := range tables.Sales.Rows {
+			if sale.PaymentID != paymentID || !scope.Allows(sale.DealerID) {
+				continue
+			}
+
+			i := findInstallment(tables, sale.ID, number)
+			if i < 0 {
+				continue
+			}
+
+			installment := tables.Installments.Rows[i]
+			if installment.PaidAt == nil {
+				installment.PaidAt = truncate(&paidAt)
+				tables.Installments.Rows[i] = installment
+			}
+
+			paid = &installment
+			return nil
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return paid, nil
+}
+
+func findSaleByID(tables *store.Tables, scope tenant.Scope, id int) int {
+	return slices.IndexFunc(tables.Sales.Rows, func(sale entity.Sale) bool {
+		return sale.ID == id && scope.Allows(sale.DealerID)
+	})
+}
+
+func findInstallment(tables *store.Tables, saleID, number int) int {
+	return slices.IndexFunc(tables.Installments.Rows, func(installment entity.Installment) bool {
+		return installment.SaleID == saleID && installment.Number == number
+	})
+}
+
+func findSale(tables *store.Tables, scope tenant.Scope, entityID string) int {
+	return slices.IndexFunc(tables.Sales.Rows, func(sale entity.Sale) bool {
+		return sale.EntityID == entityID && scope.Allows(sale.DealerID)
+	})
+}
+
+func roundTradeIn(tradeIn *entity.TradeIn) *entity.TradeIn {
+	if tradeIn == nil {
+		return nil
+	}
+
+	rounded := *tradeIn
+	rounded.AppraisedValue = model.RoundPrice(tradeIn.AppraisedValue)
+	return &rounded
+}
+
+func roundFinancing(financing *entity.Financing) *entity.Financing {
+	if financing == nil {
+		return nil
+	}
+
+	rounded := *financing
+	rounded.MonthlyInterestRate = model.RoundRate(financing.MonthlyInterestRate)
+	rounded.DownPayment = model.RoundPrice(financing.DownPayment)
+	rounded.Principal = model.RoundPrice(financing.Principal)
+	return &rounded
+}
+
+func truncate(date *time.Time) *time.Time {
+	if date == nil {
+		return nil
+	}
+
+	truncated := date.Truncate(time.Microsecond)
+	return &truncated
+}
@@ -0,0 +1,138 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"sync"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+// Table holds the rows of one collection ordered by ID, mirroring a SERIAL
+// primary key.
+type Table[T any] struct {
+	Rows   []T
+	LastID int
+}
+
+func (ref *Table[T]) NextID() int {
+	ref.LastID++
+	return ref.LastID
+}
+
+func (ref Table[T]) clone() Table[T] {
+	return Table[T]{
+		Rows:   slices.Clone(ref.Rows),
+		LastID: ref.LastID,
+	}
+}
+
+// Tables is every collection kept by the store. Rows are stored by value, so a
+// shallow copy of the slices is enough to isolate a transaction.
+type Tables struct {
+	Dealers        Table[entity.Dealer]
+	Vehicles       Table[entity.Vehicle]
+	VehicleMedia   Table[entity.VehicleMedia]
+	Offers         Table[entity.Offer]
+	Coupons        Table[entity.Coupon]
+	FinancingPlans Table[entity.FinancingPlan]
+	Sales          Table[entity.Sale]
+	Installments   Table[entity.Installment]
+	APIKeys        Table[entity.APIKey]
+}
+
+func (ref Tables) clone() Tables {
+	return Tables{
+		Dealers:        ref.Dealers.clone(),
+		Vehicles:       ref.Vehicles.clone(),
+		VehicleMedia:   ref.VehicleMedia.clone(),
+		Offers:         ref.Offers.clone(),
+		Coupons:        ref.Coupons.clone(),
+		FinancingPlans: ref.FinancingPlans.clone(),
+		Sales:          ref.Sales.clone(),
+		Installments:   ref.Installments.clone(),
+		APIKeys:        ref.APIKeys.clone(),
+	}
+}
+
+// HasDealer stands in for the foreign keys to the dealers table.
+func (ref *Tables) HasDealer(id int) bool {
+	return slices.ContainsFunc(ref.Dealers.Rows, func(dealer entity.Dealer) bool {
+		return dealer.ID == id
+	})
+}
+
+// Store is an in-memory database shared by the memory repositories. Writes
+// are applied to a copy of the tables and only published when they succeed,
+// so a failed write leaves nothing behind, like a failed statement.
+type Store struct {
+	// unitOfWork is held by a transaction for its whole life and by each write
+	// outside of one, serializing writers like row locks would.
+	unitOfWork sync.Mutex
+
+	mu     sync.RWMutex
+	tables Tables
+
+	now func() time.Time
+}
+
+func NewStore(now func() time.Time) *Store {
+	return &Store{
+		now: now,
+	}
+}
+
+// Now returns the time used for created_at and updated_at, truncated to the
+// microsecond precision of a Postgres TIMESTAMP.
+func (ref *Store) Now() time.Time {
+	return ref.now().Truncate(time.Microsecond)
+}
+
+// Read runs fn against the tables seen by ctx: the ones of its transaction, or
+// the committed ones. fn must not modify them.
+func (ref *Store) Read(ctx context.Context, fn func(tables *Tables)) {
+	if tx := ref.txFromContext(ctx); tx != nil {
+		ref.mu.RLock()
+		defer ref.mu.RUnlock()
+
+		fn(&tx.tables)
+		return
+	}
+
+	ref.mu.RLock()
+	defer ref.mu.RUnlock()
+
+	fn(&ref.tables)
+}
+
+// Write runs fn against a copy of the tables seen by ctx and keeps the copy
+// only when fn succeeds.
+func (ref *Store) Write(ctx context.Context, fn func(tables *Tables) error) error {
+	if tx := ref.txFromContext(ctx); tx != nil {
+		ref.mu.Lock()
+		defer ref.mu.Unlock()
+
+		working := tx.tables.clone()
+		if err := fn(&working); err != nil {
+			return err
+		}
+
+		tx.tables = working
+		return nil
+	}
+
+	ref.unitOfWork.Lock()
+	defer ref.unitOfWork.Unlock()
+
+	ref.mu.Lock()
+	defer ref.mu.Unlock()
+
+	working := ref.tables.clone()
+	if err := fn(&working); err != nil {
+		return err
+	}
+
+	ref.tables = working
+	return nil
+}
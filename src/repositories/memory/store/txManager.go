@@ -0,0 +1,123 @@
+package store
+
+import (
+	"context"
+	"errors"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+)
+
+var (
+	ErrNoTransaction        = errors.New("no transaction in context")
+	ErrTransactionDone      = errors.New("transaction has already been committed or rolled back")
+	ErrTransactionCancelled = errors.New("transaction was rolled back by a nested unit of work")
+)
+
+type txKey struct{}
+
+type txState struct {
+	store        *Store
+	tables       Tables
+	rollbackOnly bool
+}
+
+type txHandle struct {
+	state  *txState
+	nested bool
+	done   bool
+}
+
+type txManager struct {
+	store *Store
+}
+
+// NewTxManager gives the memory store the same unit of work semantics as the
+// Postgres one: a transaction works on its own copy of the tables, published
+// on Commit, and nested units of work join the outer one.
+func NewTxManager(store *Store) interfaces.TxManager {
+	return &txManager{
+		store: store,
+	}
+}
+
+func (ref *txManager) Begin(ctx context.Context) (context.Context, error) {
+	if state := ref.store.txFromContext(ctx); state != nil {
+		return context.WithValue(ctx, txKey{}, &txHandle{state: state, nested: true}), nil
+	}
+
+	ref.store.unitOfWork.Lock()
+
+	ref.store.mu.RLock()
+	tables := ref.store.tables.clone()
+	ref.store.mu.RUnlock()
+
+	return context.WithValue(ctx, txKey{}, &txHandle{state: &txState{store: ref.store, tables: tables}}), nil
+}
+
+func (ref *txManager) Commit(ctx context.Context) error {
+	handle, err := ref.handle(ctx)
+	if err != nil {
+		return err
+	}
+
+	if handle.done {
+		return ErrTransactionDone
+	}
+	handle.done = true
+
+	if handle.nested {
+		return nil
+	}
+
+	defer ref.store.unitOfWork.Unlock()
+
+	if handle.state.rollbackOnly {
+		return ErrTransactionCancelled
+	}
+
+	ref.store.mu.Lock()
+	ref.store.tables = handle.state.tables
+	ref.store.mu.Unlock()
+
+	return nil
+}
+
+// Rollback is safe to call after Commit, which makes it usable in a defer.
+func (ref *txManager) Rollback(ctx context.Context) error {
+	handle, err := ref.handle(ctx)
+	if err != nil {
+		return err
+	}
+
+	if handle.done {
+		return nil
+	}
+	handle.done = true
+
+	if handle.nested {
+		handle.state.rollbackOnly = true
+		return nil
+	}
+
+	ref.store.unitOfWork.Unlock()
+
+	return nil
+}
+
+func (ref *txManager) handle(ctx context.Context) (*txHandle, error) {
+	handle, ok := ctx.Value(txKey{}).(*txHandle)
+	if !ok || handle.state.store != ref.store {
+		return nil, ErrNoTransaction
+	}
+
+	return handle, nil
+}
+
+func (ref *Store) txFromContext(ctx context.Context) *txState {
+	handle, ok := ctx.Value(txKey{}).(*txHandle)
+	if !ok || handle.state.store != ref || handle.done && !handle.nested {
+		return nil
+	}
+
+	return handle.state
+}
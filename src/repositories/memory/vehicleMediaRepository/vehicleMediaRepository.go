@@ -0,0 +1,200 @@
+package vehiclemediarepository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/store"
+)
+
+type vehicleMediaRepository struct {
+	store *store.Store
+}
+
+func NewVehicleMediaRepository(store *store.Store) interfaces.VehicleMediaRepository {
+	return &vehicleMediaRepository{
+		store: store,
+	}
+}
+
+// Create enforces the same constraints as the vehicle_media table: unique
+// entity ids and a vehicle that exists in the dealer of the media.
+func (ref *vehicleMediaRepository) Create(ctx context.Context, media entity.VehicleMedia) (*entity.VehicleMedia, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(media.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created entity.VehicleMedia
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		if findMedia(tables, tenant.Scope{}, media.EntityID) >= 0 {
+			return fmt.Errorf("%w: media %q", domainerrors.ErrAlreadyExists, media.EntityID)
+		}
+
+		exists := slices.ContainsFunc(tables.Vehicles.Rows, func(vehicle entity.Vehicle) bool {
+			return vehicle.EntityID == media.VehicleID && vehicle.DealerID == dealerID
+		})
+		if !exists {
+			return fmt.Errorf("%w: vehicle %q", domainerrors.ErrReferenceNotFound, media.VehicleID)
+		}
+
+		created = media
+		created.ID = tables.VehicleMedia.NextID()
+		created.DealerID = dealerID
+		created.CreatedAt = ref.store.Now()
+
+		tables.VehicleMedia.Rows = append(tables.VehicleMedia.Rows, created)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (ref *vehicleMediaRepository) GetByID(ctx context.Context, id string) (*entity.VehicleMedia, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var media *entity.VehicleMedia
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		if i := findMedia(tables, scope, id); i >= 0 {
+			found := tables.VehicleMedia.Rows[i]
+			media = &found
+		}
+	})
+
+	return media, nil
+}
+
+func (ref *vehicleMediaRepository) SearchByVehicleIDs(ctx context.Context, vehicleIDs []string) ([]entity.VehicleMedia, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	media := make([]entity.VehicleMedia, 0)
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		for _, row := range tables.VehicleMedia.Rows {
+			if scope.Allows(row.DealerID) && slices.Contains(vehicleIDs, row.VehicleID) {
+				media = append(media, row)
+			}
+		}
+	})
+
+	slices.SortFunc(media, func(a, b entity.VehicleMedia) int {
+		return cmp.Or(
+			cmp.Compare(a.VehicleID, b.VehicleID),
+			cmp.Compare(a.Position, b.Position),
+			cmp.Compare(a.ID, b.ID),
+		)
+	})
+
+	return media, nil
+}
+
+func (ref *vehicleMediaRepository) Update(ctx context.Context, media entity.VehicleMedia) (*entity.VehicleMedia, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *entity.VehicleMedia
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findMedia(tables, scope, media.EntityID)
+		if i < 0 {
+			return nil
+		}
+
+		current := tables.VehicleMedia.Rows[i]
+		current.Position = media.Position
+		current.IsCover = media.IsCover
+
+		tables.VehicleMedia.Rows[i] = current
+
+		updated = &current
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+func (ref *vehicleMediaRepository) Delete(ctx context.Context, id string) (*entity.VehicleMedia, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted *entity.VehicleMedia
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findMedia(tables, scope, id)
+		if i < 0 {
+			return nil
+		}
+
+		media := tables.VehicleMedia.Rows[i]
+		tables.VehicleMedia.Rows = slices.Delete(tables.VehicleMedia.Rows, i, i+1)
+
+		deleted = &media
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+func (ref *vehicleMediaRepository) DeleteByVehicleID(ctx context.Context, vehicleID string) ([]entity.VehicleMedia, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	deleted := make([]entity.VehicleMedia, 0)
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		tables.VehicleMedia.Rows = slices.DeleteFunc(tables.VehicleMedia.Rows, func(media entity.VehicleMedia) bool {
+			if media.VehicleID != vehicleID || !scope.Allows(media.DealerID) {
+				return false
+			}
+
+			deleted = append(deleted, media)
+			return true
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+func findMedia(tables *store.Tables, scope tenant.Scope, entityID string) int {
+	return slices.IndexFunc(tables.VehicleMedia.Rows, func(media entity.VehicleMedia) bool {
+		return media.EntityID == entityID && scope.Allows(media.DealerID)
+	})
+}
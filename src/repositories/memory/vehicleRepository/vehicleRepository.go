@@ -0,0 +1,336 @@
+package vehiclerepository
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/store"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+)
+
+type vehicleRepository struct {
+	store *store.Store
+}
+
+func NewVehicleRepository(store *store.Store) interfaces.VehicleRepository {
+	return &vehicleRepository{
+		store: store,
+	}
+}
+
+// Create keeps entity_id, informed VINs and slugs unique across dealers, like
+// the vehicles table.
+func (ref *vehicleRepository) Create(ctx context.Context, vehicle entity.Vehicle) (*entity.Vehicle, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(vehicle.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	var created entity.Vehicle
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		if !tables.HasDealer(dealerID) {
+			return fmt.Errorf("%w: dealer %d", domainerrors.ErrReferenceNotFound, dealerID)
+		}
+
+		if findVehicle(tables, tenant.Scope{}, vehicle.EntityID) >= 0 {
+			return fmt.Errorf("%w: vehicle %q", domainerrors.ErrAlreadyExists, vehicle.EntityID)
+		}
+
+		if err := checkVIN(tables, vehicle.EntityID, vehicle.VIN); err != nil {
+			return err
+		}
+
+		if err := checkSlug(tables, vehicle.EntityID, vehicle.Slug); err != nil {
+			return err
+		}
+
+		now := ref.store.Now()
+
+		created = vehicle
+		created.ID = tables.Vehicles.NextID()
+		created.DealerID = dealerID
+		created.Price = model.RoundPrice(vehicle.Price)
+		created.Version = 1
+		created.CreatedAt = now
+		created.UpdatedAt = now
+
+		tables.Vehicles.Rows = append(tables.Vehicles.Rows, created)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &created, nil
+}
+
+func (ref *vehicleRepository) GetByID(ctx context.Context, id string) (*entity.Vehicle, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var vehicle *entity.Vehicle
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		if i := findVehicle(tables, scope, id); i >= 0 {
+			found := tables.Vehicles.Rows[i]
+			vehicle = &found
+		}
+	})
+
+	return vehicle, nil
+}
+
+func (ref *vehicleRepository) GetBySlug(ctx context.Context, slug string) (*entity.Vehicle, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var vehicle *entity.Vehicle
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		i := slices.IndexFunc(tables.Vehicles.Rows, func(vehicle entity.Vehicle) bool {
+			return vehicle.Slug.String() == slug && scope.Allows(vehicle.DealerID)
+		})
+		if i >= 0 {
+			found := tables.Vehicles.Rows[i]
+			vehicle = &found
+		}
+	})
+
+	return vehicle, nil
+}
+
+// ExistsByVIN is not scoped, like checkVIN.
+func (ref *vehicleRepository) ExistsByVIN(ctx context.Context, vin valueobjects.VIN) (bool, error) {
+	var exists bool
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		exists = checkVIN(tables, "", vin) != nil
+	})
+
+	return exists, nil
+}
+
+// Search reproduces the sold and not sold joins of the Postgres repository,
+// where a vehicle is sold once its sale is approved and has a sold date.
+func (ref *vehicleRepository) Search(ctx context.Context, filter entity.VehicleFilter) ([]entity.Vehicle, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	vehicles := make([]entity.Vehicle, 0)
+	now := ref.store.Now()
+
+	ref.store.Read(ctx, func(tables *store.Tables) {
+		sales := make(map[string]entity.Sale, len(tables.Sales.Rows))
+		for _, sale := range tables.Sales.Rows {
+			sales[sale.EntityID] = sale
+		}
+
+		for _, vehicle := range tables.Vehicles.Rows {
+			if !scope.Allows(vehicle.DealerID) || !matchesAttributes(vehicle, filter) {
+				continue
+			}
+
+			if filter.PublishedOnly && !vehicle.IsPublishedAt(now) {
+				continue
+			}
+
+			if isSold := filter.IsSold; isSold != nil {
+				sale, hasSale := sales[vehicle.EntityID]
+				approved := sale.Status == valueobjects.SaleStatusTypeApproved
+
+				sold := hasSale && approved && sale.SoldAt != nil
+				notSold := !hasSale || (!approved && sale.SoldAt == nil)
+
+				if *isSold && !sold || !*isSold && !notSold {
+					continue
+				}
+			}
+
+			vehicles = append(vehicles, vehicle)
+		}
+	})
+
+	slices.SortStableFunc(vehicles, func(a, b entity.Vehicle) int {
+		return cmp.Compare(a.Price, b.Price)
+	})
+
+	return vehicles, nil
+}
+
+// Update writes the fields of vehicle only when the stored vehicle is still at
+// vehicle.Version, as the version condition of the Postgres update.
+func (ref *vehicleRepository) Update(ctx context.Context, id string, vehicle entity.Vehicle) (*entity.Vehicle, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var updated *entity.Vehicle
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findVehicle(tables, scope, id)
+		if i < 0 {
+			return nil
+		}
+
+		current := tables.Vehicles.Rows[i]
+
+		if current.Version != vehicle.Version {
+			return fmt.Errorf("%w: vehicle %q is at version %d", domainerrors.ErrVersionMismatch, id, current.Version)
+		}
+
+		if err := checkVIN(tables, current.EntityID, vehicle.VIN); err != nil {
+			return err
+		}
+
+		if err := checkSlug(tables, current.EntityID, vehicle.Slug); err != nil {
+			return err
+		}
+
+		current.Brand = vehicle.Brand
+		current.Model = vehicle.Model
+		current.Year = vehicle.Year
+		current.Color = vehicle.Color
+		current.Price = model.RoundPrice(vehicle.Price)
+		current.Mileage = vehicle.Mileage
+		current.FuelType = vehicle.FuelType
+		current.Transmission = vehicle.Transmission
+		current.BodyType = vehicle.BodyType
+		current.Condition = vehicle.Condition
+		current.Doors = vehicle.Doors
+		current.EngineDisplacement = vehicle.EngineDisplacement
+		current.LicensePlate = vehicle.LicensePlate
+		current.VIN = vehicle.VIN
+		current.Slug = vehicle.Slug
+		current.Status = vehicle.Status
+		current.PublishedAt = vehicle.PublishedAt
+		current.Version++
+		current.UpdatedAt = ref.store.Now()
+
+		tables.Vehicles.Rows[i] = current
+
+		updated = &current
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return updated, nil
+}
+
+// Delete refuses to delete a vehicle with a sale and takes its media along,
+// like the foreign keys of the sales and vehicle_media tables.
+func (ref *vehicleRepository) Delete(ctx context.Context, id string) (*entity.Vehicle, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var deleted *entity.Vehicle
+
+	err = ref.store.Write(ctx, func(tables *store.Tables) error {
+		i := findVehicle(tables, scope, id)
+		if i < 0 {
+			return nil
+		}
+
+		hasSale := slices.ContainsFunc(tables.Sales.Rows, func(sale entity.Sale) bool {
+			return sale.EntityID == id
+		})
+		if hasSale {
+			return fmt.Errorf("%w: vehicle %q has a sale", domainerrors.ErrInUse, id)
+		}
+
+		vehicle := tables.Vehicles.Rows[i]
+
+		tables.Vehicles.Rows = slices.Delete(tables.Vehicles.Rows, i, i+1)
+		tables.VehicleMedia.Rows = slices.DeleteFunc(tables.VehicleMedia.Rows, func(media entity.VehicleMedia) bool {
+			return media.VehicleID == id
+		})
+		tables.Offers.Rows = slices.DeleteFunc(tables.Offers.Rows, func(offer entity.Offer) bool {
+			return offer.VehicleID == id
+		})
+
+		deleted = &vehicle
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return deleted, nil
+}
+
+func findVehicle(tables *store.Tables, scope tenant.Scope, entityID string) int {
+	return slices.IndexFunc(tables.Vehicles.Rows, func(vehicle entity.Vehicle) bool {
+		return vehicle.EntityID == entityID && scope.Allows(vehicle.DealerID)
+	})
+}
+
+// checkVIN stands in for the unique index on informed VINs, ignoring the
+// vehicle being written.
+func checkVIN(tables *store.Tables, entityID string, vin valueobjects.VIN) error {
+	if vin == "" {
+		return nil
+	}
+
+	taken := slices.ContainsFunc(tables.Vehicles.Rows, func(vehicle entity.Vehicle) bool {
+		return vehicle.VIN == vin && vehicle.EntityID != entityID
+	})
+	if taken {
+		return fmt.Errorf("%w: vin %q", domainerrors.ErrAlreadyExists, vin)
+	}
+
+	return nil
+}
+
+// checkSlug stands in for the unique index on slugs, like checkVIN.
+func checkSlug(tables *store.Tables, entityID string, slug valueobjects.Slug) error {
+	if slug == "" {
+		return nil
+	}
+
+	taken := slices.ContainsFunc(tables.Vehicles.Rows, func(vehicle entity.Vehicle) bool {
+		return vehicle.Slug == slug && vehicle.EntityID != entityID
+	})
+	if taken {
+		return fmt.Errorf("%w: slug %q", domainerrors.ErrAlreadyExists, slug)
+	}
+
+	return nil
+}
+
+func matchesAttributes(vehicle entity.Vehicle, filter entity.VehicleFilter) bool {
+	switch {
+	case filter.Status != "" && vehicle.Status != filter.Status,
+		filter.FuelType != "" && vehicle.FuelType != filter.FuelType,
+		filter.Transmission != "" && vehicle.Transmission != filter.Transmission,
+		filter.BodyType != "" && vehicle.BodyType != filter.BodyType,
+		filter.Condition != "" && vehicle.Condition != filter.Condition,
+		filter.Doors != 0 && vehicle.Doors != filter.Doors,
+		filter.MaxMileage != nil && vehicle.Mileage > *filter.MaxMileage:
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,76 @@
+package model
+
+import (
+	"strings"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type APIKey struct {
+	ID        int        `db:"id"`
+	DealerID  *int       `db:"dealer_id"`
+	Name      string     `db:"name"`
+	Prefix    string     `db:"prefix"`
+	KeyHash   string     `db:"key_hash"`
+	Roles     string     `db:"roles"`
+	CreatedAt time.Time  `db:"created_at"`
+	RevokedAt *time.Time `db:"revoked_at"`
+}
+
+func APIKeyFromDomain(apiKey entity.APIKey) APIKey {
+	record := APIKey{
+		Name:    apiKey.Name,
+		Prefix:  apiKey.Prefix,
+		KeyHash: apiKey.KeyHash,
+		Roles:   JoinRoles(apiKey.Roles),
+	}
+
+	if apiKey.DealerID != 0 {
+		record.DealerID = &apiKey.DealerID
+	}
+
+	return record
+}
+
+func (ref *APIKey) ToDomain() *entity.APIKey {
+	var dealerID int
+	if ref.DealerID != nil {
+		dealerID = *ref.DealerID
+	}
+
+	return &entity.APIKey{
+		ID:        ref.ID,
+		DealerID:  dealerID,
+		Name:      ref.Name,
+		Prefix:    ref.Prefix,
+		KeyHash:   ref.KeyHash,
+		Roles:     SplitRoles(ref.Roles),
+		CreatedAt: ref.CreatedAt,
+		RevokedAt: ref.RevokedAt,
+	}
+}
+
+// JoinRoles stores roles as a comma separated list, which is enough for the
+// handful of roles a key carries and keeps the column readable.
+func JoinRoles(roles []valueobjects.Role) string {
+	values := make([]string, 0, len(roles))
+	for _, role := range roles {
+		values = append(values, role.String())
+	}
+
+	return strings.Join(values, ",")
+}
+
+func SplitRoles(value string) []valueobjects.Role {
+	roles := make([]valueobjects.Role, 0)
+
+	for _, role := range strings.Split(value, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			roles = append(roles, valueobjects.Role(role))
+		}
+	}
+
+	return roles
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAPIKeyFromDomain(t *testing.T) {
+	dealerID := 3
+
+	apiKey := entity.APIKey{
+		DealerID: dealerID,
+		Name:     "storefront",
+		Prefix:   "vps_abcdefgh",
+		KeyHash:  "hash",
+		Roles:    []valueobjects.Role{valueobjects.RoleAdmin, valueobjects.RoleBuyer},
+	}
+
+	expected := APIKey{
+		DealerID: &dealerID,
+		Name:     "storefront",
+		Prefix:   "vps_abcdefgh",
+		KeyHash:  "hash",
+		Roles:    "ADMIN,BUYER",
+	}
+
+	actual := APIKeyFromDomain(apiKey)
+
+	assert.Equal(t, expected, actual)
+}
+
+func TestAPIKeyToDomain(t *testing.T) {
+	now := time.Now()
+
+	apiKey := APIKey{
+		ID:        1,
+		Name:      "payments",
+		Prefix:    "vps_abcdefgh",
+		KeyHash:   "hash",
+		Roles:     "PAYMENTS_SYSTEM",
+		CreatedAt: now,
+		RevokedAt: &now,
+	}
+
+	expected := &entity.APIKey{
+		ID:        1,
+		Name:      "payments",
+		Prefix:    "vps_abcdefgh",
+		KeyHash:   "hash",
+		Roles:     []valueobjects.Role{valueobjects.RolePaymentsSystem},
+		CreatedAt: now,
+		RevokedAt: &now,
+	}
+
+	actual := apiKey.ToDomain()
+
+	assert.Equal(t, expected, actual)
+}
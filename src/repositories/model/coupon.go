@@ -0,0 +1,66 @@
+package model
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type Coupon struct {
+	ID            int        `db:"id"`
+	DealerID      int        `db:"dealer_id"`
+	Code          string     `db:"code"`
+	DiscountType  string     `db:"discount_type"`
+	DiscountValue float64    `db:"discount_value"`
+	MaxUses       int        `db:"max_uses"`
+	Uses          int        `db:"uses"`
+	ValidFrom     *time.Time `db:"valid_from"`
+	ValidUntil    *time.Time `db:"valid_until"`
+	Brand         string     `db:"brand"`
+	Model         string     `db:"model"`
+	MinYear       int        `db:"min_year"`
+	MaxYear       int        `db:"max_year"`
+	CreatedAt     time.Time  `db:"created_at"`
+	UpdatedAt     time.Time  `db:"updated_at"`
+}
+
+func CouponFromDomain(coupon entity.Coupon) Coupon {
+	return Coupon{
+		ID:            coupon.ID,
+		DealerID:      coupon.DealerID,
+		Code:          coupon.Code.String(),
+		DiscountType:  coupon.DiscountType.String(),
+		DiscountValue: coupon.DiscountValue,
+		MaxUses:       coupon.MaxUses,
+		Uses:          coupon.Uses,
+		ValidFrom:     coupon.ValidFrom,
+		ValidUntil:    coupon.ValidUntil,
+		Brand:         coupon.Brand,
+		Model:         coupon.Model,
+		MinYear:       coupon.MinYear,
+		MaxYear:       coupon.MaxYear,
+		CreatedAt:     coupon.CreatedAt,
+		UpdatedAt:     coupon.UpdatedAt,
+	}
+}
+
+func (ref *Coupon) ToDomain() *entity.Coupon {
+	return &entity.Coupon{
+		ID:            ref.ID,
+		DealerID:      ref.DealerID,
+		Code:          valueobjects.CouponCode(ref.Code),
+		DiscountType:  valueobjects.DiscountType(ref.DiscountType),
+		DiscountValue: ref.DiscountValue,
+		MaxUses:       ref.MaxUses,
+		Uses:          ref.Uses,
+		ValidFrom:     ref.ValidFrom,
+		ValidUntil:    ref.ValidUntil,
+		Brand:         ref.Brand,
+		Model:         ref.Model,
+		MinYear:       ref.MinYear,
+		MaxYear:       ref.MaxYear,
+		CreatedAt:     ref.CreatedAt,
+		UpdatedAt:     ref.UpdatedAt,
+	}
+}
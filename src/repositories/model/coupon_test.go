@@ -0,0 +1,40 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestCoupon(t *testing.T) {
+	now := time.Now()
+	validUntil := now.Add(24 * time.Hour)
+
+	coupon := entity.Coupon{
+		ID:            1,
+		DealerID:      3,
+		Code:          "BLACKFRIDAY",
+		DiscountType:  valueobjects.DiscountTypePercentage,
+		DiscountValue: 10,
+		MaxUses:       100,
+		Uses:          7,
+		ValidFrom:     &now,
+		ValidUntil:    &validUntil,
+		Brand:         "Toyota",
+		Model:         "Corolla",
+		MinYear:       2018,
+		MaxYear:       2022,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	record := CouponFromDomain(coupon)
+
+	assert.Equal(t, "BLACKFRIDAY", record.Code)
+	assert.Equal(t, "PERCENTAGE", record.DiscountType)
+	assert.Equal(t, &coupon, record.ToDomain())
+}
@@ -0,0 +1,27 @@
+package model
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type Dealer struct {
+	ID        int       `db:"id"`
+	Name      string    `db:"name"`
+	CreatedAt time.Time `db:"created_at"`
+}
+
+func DealerFromDomain(dealer entity.Dealer) Dealer {
+	return Dealer{
+		Name: dealer.Name,
+	}
+}
+
+func (ref Dealer) ToDomain() *entity.Dealer {
+	return &entity.Dealer{
+		ID:        ref.ID,
+		Name:      ref.Name,
+		CreatedAt: ref.CreatedAt,
+	}
+}
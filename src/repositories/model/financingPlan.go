@@ -0,0 +1,48 @@
+package model
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type FinancingPlan struct {
+	ID                       int       `db:"id"`
+	DealerID                 int       `db:"dealer_id"`
+	Name                     string    `db:"name"`
+	AmortizationSystem       string    `db:"amortization_system"`
+	MonthlyInterestRate      float64   `db:"monthly_interest_rate"`
+	MaxMonths                int       `db:"max_months"`
+	MinDownPaymentPercentage float64   `db:"min_down_payment_percentage"`
+	CreatedAt                time.Time `db:"created_at"`
+	UpdatedAt                time.Time `db:"updated_at"`
+}
+
+func FinancingPlanFromDomain(plan entity.FinancingPlan) FinancingPlan {
+	return FinancingPlan{
+		ID:                       plan.ID,
+		DealerID:                 plan.DealerID,
+		Name:                     plan.Name,
+		AmortizationSystem:       plan.AmortizationSystem.String(),
+		MonthlyInterestRate:      plan.MonthlyInterestRate,
+		MaxMonths:                plan.MaxMonths,
+		MinDownPaymentPercentage: plan.MinDownPaymentPercentage,
+		CreatedAt:                plan.CreatedAt,
+		UpdatedAt:                plan.UpdatedAt,
+	}
+}
+
+func (ref *FinancingPlan) ToDomain() *entity.FinancingPlan {
+	return &entity.FinancingPlan{
+		ID:                       ref.ID,
+		DealerID:                 ref.DealerID,
+		Name:                     ref.Name,
+		AmortizationSystem:       valueobjects.AmortizationSystem(ref.AmortizationSystem),
+		MonthlyInterestRate:      ref.MonthlyInterestRate,
+		MaxMonths:                ref.MaxMonths,
+		MinDownPaymentPercentage: ref.MinDownPaymentPercentage,
+		CreatedAt:                ref.CreatedAt,
+		UpdatedAt:                ref.UpdatedAt,
+	}
+}
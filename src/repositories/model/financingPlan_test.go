@@ -0,0 +1,32 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestFinancingPlan(t *testing.T) {
+	now := time.Now()
+
+	plan := entity.FinancingPlan{
+		ID:                       1,
+		DealerID:                 3,
+		Name:                     "24x",
+		AmortizationSystem:       valueobjects.AmortizationSystemPrice,
+		MonthlyInterestRate:      1.49,
+		MaxMonths:                24,
+		MinDownPaymentPercentage: 20,
+		CreatedAt:                now,
+		UpdatedAt:                now,
+	}
+
+	record := FinancingPlanFromDomain(plan)
+
+	assert.Equal(t, "PRICE", record.AmortizationSystem)
+	assert.Equal(t, &plan, record.ToDomain())
+}
@@ -0,0 +1,44 @@
+package model
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type Installment struct {
+	SaleID       int        `db:"sale_id"`
+	Number       int        `db:"number"`
+	DueDate      time.Time  `db:"due_date"`
+	Amount       float64    `db:"amount"`
+	Interest     float64    `db:"interest"`
+	Amortization float64    `db:"amortization"`
+	Balance      float64    `db:"balance"`
+	PaidAt       *time.Time `db:"paid_at"`
+}
+
+func InstallmentFromDomain(installment entity.Installment) Installment {
+	return Installment{
+		SaleID:       installment.SaleID,
+		Number:       installment.Number,
+		DueDate:      installment.DueDate,
+		Amount:       installment.Amount,
+		Interest:     installment.Interest,
+		Amortization: installment.Amortization,
+		Balance:      installment.Balance,
+		PaidAt:       installment.PaidAt,
+	}
+}
+
+func (ref *Installment) ToDomain() *entity.Installment {
+	return &entity.Installment{
+		SaleID:       ref.SaleID,
+		Number:       ref.Number,
+		DueDate:      ref.DueDate,
+		Amount:       ref.Amount,
+		Interest:     ref.Interest,
+		Amortization: ref.Amortization,
+		Balance:      ref.Balance,
+		PaidAt:       ref.PaidAt,
+	}
+}
@@ -0,0 +1,60 @@
+package model
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type Offer struct {
+	ID                  int       `db:"id"`
+	EntityID            string    `db:"entity_id"`
+	VehicleID           string    `db:"vehicle_id"`
+	DealerID            int       `db:"dealer_id"`
+	BuyerSubject        string    `db:"buyer_subject"`
+	BuyerDocumentNumber string    `db:"buyer_document_number"`
+	Amount              float64   `db:"amount"`
+	CounterAmount       *float64  `db:"counter_amount"`
+	Status              string    `db:"status"`
+	PaymentID           string    `db:"payment_id"`
+	ExpiresAt           time.Time `db:"expires_at"`
+	CreatedAt           time.Time `db:"created_at"`
+	UpdatedAt           time.Time `db:"updated_at"`
+}
+
+func OfferFromDomain(offer entity.Offer) Offer {
+	return Offer{
+		ID:                  offer.ID,
+		EntityID:            offer.EntityID,
+		VehicleID:           offer.VehicleID,
+		DealerID:            offer.DealerID,
+		BuyerSubject:        offer.BuyerSubject,
+		BuyerDocumentNumber: offer.BuyerDocumentNumber,
+		Amount:              offer.Amount,
+		CounterAmount:       offer.CounterAmount,
+		Status:              offer.Status.String(),
+		PaymentID:           offer.PaymentID,
+		ExpiresAt:           offer.ExpiresAt,
+		CreatedAt:           offer.CreatedAt,
+		UpdatedAt:           offer.UpdatedAt,
+	}
+}
+
+func (ref *Offer) ToDomain() *entity.Offer {
+	return &entity.Offer{
+		ID:                  ref.ID,
+		EntityID:            ref.EntityID,
+		VehicleID:           ref.VehicleID,
+		DealerID:            ref.DealerID,
+		BuyerSubject:        ref.BuyerSubject,
+		BuyerDocumentNumber: ref.BuyerDocumentNumber,
+		Amount:              ref.Amount,
+		CounterAmount:       ref.CounterAmount,
+		Status:              valueobjects.OfferStatus(ref.Status),
+		PaymentID:           ref.PaymentID,
+		ExpiresAt:           ref.ExpiresAt,
+		CreatedAt:           ref.CreatedAt,
+		UpdatedAt:           ref.UpdatedAt,
+	}
+}
@@ -0,0 +1,37 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+func TestOffer(t *testing.T) {
+	now := time.Now()
+	counterAmount := float64(88000)
+
+	offer := entity.Offer{
+		ID:                  1,
+		EntityID:            uuid.NewString(),
+		VehicleID:           uuid.NewString(),
+		DealerID:            3,
+		BuyerSubject:        "api_key:1",
+		BuyerDocumentNumber: "12345678900",
+		Amount:              85000,
+		CounterAmount:       &counterAmount,
+		Status:              valueobjects.OfferStatusCountered,
+		ExpiresAt:           now.Add(time.Hour),
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+
+	record := OfferFromDomain(offer)
+
+	assert.Equal(t, "COUNTERED", record.Status)
+	assert.Equal(t, &offer, record.ToDomain())
+}
@@ -0,0 +1,14 @@
+package model
+
+import "math"
+
+// RoundPrice mimics the DECIMAL(12,2) price columns for the stores that keep
+// prices as floating point numbers.
+func RoundPrice(price float64) float64 {
+	return math.Round(price*100) / 100
+}
+
+// RoundRate mimics the DECIMAL(7,4) interest rate columns the same way.
+func RoundRate(rate float64) float64 {
+	return math.Round(rate*10000) / 10000
+}
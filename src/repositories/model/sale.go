@@ -7,39 +7,121 @@ import (
 	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 )
 
+// Sale flattens the trade-in and the financing into their columns, a zero
+// TradeInValue or FinancingMonths meaning the sale has none.
 type Sale struct {
-	ID                  int        `db:"id"`
-	EntityID            string     `db:"entity_id"`
-	PaymentID           string     `db:"payment_id"`
-	BuyerDocumentNumber string     `db:"buyer_document_number"`
-	Price               float64    `db:"price"`
-	Status              string     `db:"status"`
-	SoldAt              *time.Time `db:"sold_at"`
-	CreatedAt           time.Time  `db:"created_at"`
-	UpdatedAt           time.Time  `db:"created_at"`
+	ID                           int        `db:"id"`
+	EntityID                     string     `db:"entity_id"`
+	DealerID                     int        `db:"dealer_id"`
+	PaymentID                    string     `db:"payment_id"`
+	BuyerDocumentNumber          string     `db:"buyer_document_number"`
+	ListPrice                    float64    `db:"list_price"`
+	Discount                     float64    `db:"discount"`
+	Price                        float64    `db:"price"`
+	CouponCode                   string     `db:"coupon_code"`
+	TradeInBrand                 string     `db:"trade_in_brand"`
+	TradeInModel                 string     `db:"trade_in_model"`
+	TradeInYear                  int        `db:"trade_in_year"`
+	TradeInColor                 string     `db:"trade_in_color"`
+	TradeInMileage               int        `db:"trade_in_mileage"`
+	TradeInLicensePlate          string     `db:"trade_in_license_plate"`
+	TradeInVIN                   string     `db:"trade_in_vin"`
+	TradeInValue                 float64    `db:"trade_in_value"`
+	TradeInAppraisedBy           string     `db:"trade_in_appraised_by"`
+	TradeInVehicleID             string     `db:"trade_in_vehicle_id"`
+	FinancingPlanID              int        `db:"financing_plan_id"`
+	FinancingAmortizationSystem  string     `db:"financing_amortization_system"`
+	FinancingMonthlyInterestRate float64    `db:"financing_monthly_interest_rate"`
+	FinancingDownPayment         float64    `db:"financing_down_payment"`
+	FinancingPrincipal           float64    `db:"financing_principal"`
+	FinancingMonths              int        `db:"financing_months"`
+	Status                       string     `db:"status"`
+	SoldAt                       *time.Time `db:"sold_at"`
+	CreatedAt                    time.Time  `db:"created_at"`
+	UpdatedAt                    time.Time  `db:"created_at"`
 }
 
 func SaleFromDomain(sale entity.Sale) Sale {
-	return Sale{
+	record := Sale{
 		EntityID:            sale.EntityID,
+		DealerID:            sale.DealerID,
 		PaymentID:           sale.PaymentID,
 		BuyerDocumentNumber: sale.BuyerDocumentNumber,
+		ListPrice:           sale.ListPrice,
+		Discount:            sale.Discount,
 		Price:               sale.Price,
+		CouponCode:          sale.CouponCode,
 		Status:              sale.Status.String(),
 		SoldAt:              sale.SoldAt,
 	}
+
+	if tradeIn := sale.TradeIn; tradeIn != nil {
+		record.TradeInBrand = tradeIn.Brand
+		record.TradeInModel = tradeIn.Model
+		record.TradeInYear = tradeIn.Year
+		record.TradeInColor = tradeIn.Color
+		record.TradeInMileage = tradeIn.Mileage
+		record.TradeInLicensePlate = tradeIn.LicensePlate.String()
+		record.TradeInVIN = tradeIn.VIN.String()
+		record.TradeInValue = tradeIn.AppraisedValue
+		record.TradeInAppraisedBy = tradeIn.AppraisedBy
+		record.TradeInVehicleID = tradeIn.VehicleID
+	}
+
+	if financing := sale.Financing; financing != nil {
+		record.FinancingPlanID = financing.PlanID
+		record.FinancingAmortizationSystem = financing.AmortizationSystem.String()
+		record.FinancingMonthlyInterestRate = financing.MonthlyInterestRate
+		record.FinancingDownPayment = financing.DownPayment
+		record.FinancingPrincipal = financing.Principal
+		record.FinancingMonths = financing.Months
+	}
+
+	return record
 }
 
 func (ref *Sale) ToDomain() *entity.Sale {
-	return &entity.Sale{
+	sale := &entity.Sale{
 		ID:                  ref.ID,
 		EntityID:            ref.EntityID,
+		DealerID:            ref.DealerID,
 		PaymentID:           ref.PaymentID,
 		BuyerDocumentNumber: ref.BuyerDocumentNumber,
+		ListPrice:           ref.ListPrice,
+		Discount:            ref.Discount,
 		Price:               ref.Price,
+		CouponCode:          ref.CouponCode,
 		Status:              valueobjects.SaleStatusType(ref.Status),
 		SoldAt:              ref.SoldAt,
 		CreatedAt:           ref.CreatedAt,
 		UpdatedAt:           ref.UpdatedAt,
 	}
+
+	if ref.TradeInValue > 0 {
+		sale.TradeIn = &entity.TradeIn{
+			Brand:          ref.TradeInBrand,
+			Model:          ref.TradeInModel,
+			Year:           ref.TradeInYear,
+			Color:          ref.TradeInColor,
+			Mileage:        ref.TradeInMileage,
+			LicensePlate:   valueobjects.LicensePlate(ref.TradeInLicensePlate),
+			VIN:            valueobjects.VIN(ref.TradeInVIN),
+			AppraisedValue: ref.TradeInValue,
+			AppraisedBy:    ref.TradeInAppraisedBy,
+			VehicleID:      ref.TradeInVehicleID,
+		}
+	}
+
+	if ref.FinancingMonths > 0 {
+		sale.Financing = &entity.Financing{
+			PlanID:              ref.FinancingPlanID,
+			AmortizationSystem:  valueobjects.AmortizationSystem(ref.FinancingAmortizationSystem),
+			MonthlyInterestRate: ref.FinancingMonthlyInterestRate,
+			DownPayment:         ref.FinancingDownPayment,
+			Principal:           ref.FinancingPrincipal,
+			Months:              ref.FinancingMonths,
+		}
+	}
+
+	return sale
 }
@@ -12,26 +12,38 @@ import (
 
 func TestSaleFromDomain(t *testing.T) {
 	entityID := uuid.NewString()
+	dealerID := 3
 	paymentID := uuid.NewString()
 	buyerDocumentNumber := uuid.NewString()
+	listPrice := float64(100000)
+	discount := float64(5000)
 	price := float64(95000)
+	couponCode := "BLACKFRIDAY"
 	status := "APPROVED"
 	now := time.Now()
 
 	sale := entity.Sale{
 		EntityID:            entityID,
+		DealerID:            dealerID,
 		PaymentID:           paymentID,
 		BuyerDocumentNumber: buyerDocumentNumber,
+		ListPrice:           listPrice,
+		Discount:            discount,
 		Price:               price,
+		CouponCode:          couponCode,
 		Status:              valueobjects.SaleStatusType(status),
 		SoldAt:              &now,
 	}
 
 	expected := Sale{
 		EntityID:            entityID,
+		DealerID:            dealerID,
 		PaymentID:           paymentID,
 		BuyerDocumentNumber: buyerDocumentNumber,
+		ListPrice:           listPrice,
+		Discount:            discount,
 		Price:               price,
+		CouponCode:          couponCode,
 		Status:              status,
 		SoldAt:              &now,
 	}
@@ -44,9 +56,13 @@ func TestSaleFromDomain(t *testing.T) {
 func TestSaleToDomain(t *testing.T) {
 	id := 1
 	entityID := uuid.NewString()
+	dealerID := 3
 	paymentID := uuid.NewString()
 	buyerDocumentNumber := uuid.NewString()
+	listPrice := float64(100000)
+	discount := float64(5000)
 	price := float64(95000)
+	couponCode := "BLACKFRIDAY"
 	status := valueobjects.SaleStatusTypeApproved
 	now := time.Now()
 	yesterday := time.Now().Add(time.Hour * -24)
@@ -54,9 +70,13 @@ func TestSaleToDomain(t *testing.T) {
 	sale := Sale{
 		ID:                  id,
 		EntityID:            entityID,
+		DealerID:            dealerID,
 		PaymentID:           paymentID,
 		BuyerDocumentNumber: buyerDocumentNumber,
+		ListPrice:           listPrice,
+		Discount:            discount,
 		Price:               price,
+		CouponCode:          couponCode,
 		Status:              status.String(),
 		SoldAt:              &now,
 		CreatedAt:           yesterday,
@@ -66,9 +86,13 @@ func TestSaleToDomain(t *testing.T) {
 	expected := &entity.Sale{
 		ID:                  id,
 		EntityID:            entityID,
+		DealerID:            dealerID,
 		PaymentID:           paymentID,
 		BuyerDocumentNumber: buyerDocumentNumber,
+		ListPrice:           listPrice,
+		Discount:            discount,
 		Price:               price,
+		CouponCode:          couponCode,
 		Status:              status,
 		SoldAt:              &now,
 		CreatedAt:           yesterday,
@@ -79,3 +103,64 @@ func TestSaleToDomain(t *testing.T) {
 
 	assert.Equal(t, expected, actual)
 }
+
+func TestSaleTradeIn(t *testing.T) {
+	vehicleID := uuid.NewString()
+
+	tradeIn := &entity.TradeIn{
+		Brand:          "Fiat",
+		Model:          "Uno",
+		Year:           2015,
+		Color:          "Red",
+		Mileage:        90000,
+		LicensePlate:   "ABC1D23",
+		VIN:            "9BWZZZ377VT004251",
+		AppraisedValue: 20000,
+		AppraisedBy:    "api_key:1",
+		VehicleID:      vehicleID,
+	}
+
+	t.Run("should flatten trade-in into its columns and back", func(t *testing.T) {
+		record := SaleFromDomain(entity.Sale{Price: 50000, TradeIn: tradeIn})
+
+		assert.Equal(t, "Fiat", record.TradeInBrand)
+		assert.Equal(t, "ABC1D23", record.TradeInLicensePlate)
+		assert.Equal(t, float64(20000), record.TradeInValue)
+		assert.Equal(t, vehicleID, record.TradeInVehicleID)
+
+		assert.Equal(t, tradeIn, record.ToDomain().TradeIn)
+	})
+
+	t.Run("should not have trade-in without appraised value", func(t *testing.T) {
+		record := SaleFromDomain(entity.Sale{Price: 50000})
+
+		assert.Nil(t, record.ToDomain().TradeIn)
+	})
+}
+
+func TestSaleFinancing(t *testing.T) {
+	financing := &entity.Financing{
+		PlanID:              2,
+		AmortizationSystem:  valueobjects.AmortizationSystemSAC,
+		MonthlyInterestRate: 1.49,
+		DownPayment:         10000,
+		Principal:           40000,
+		Months:              24,
+	}
+
+	t.Run("should flatten financing into its columns and back", func(t *testing.T) {
+		record := SaleFromDomain(entity.Sale{Price: 50000, Financing: financing})
+
+		assert.Equal(t, 2, record.FinancingPlanID)
+		assert.Equal(t, "SAC", record.FinancingAmortizationSystem)
+		assert.Equal(t, 24, record.FinancingMonths)
+
+		assert.Equal(t, financing, record.ToDomain().Financing)
+	})
+
+	t.Run("should not have financing without months", func(t *testing.T) {
+		record := SaleFromDomain(entity.Sale{Price: 50000})
+
+		assert.Nil(t, record.ToDomain().Financing)
+	})
+}
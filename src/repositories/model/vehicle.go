@@ -4,42 +4,85 @@ import (
 	"time"
 
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 )
 
 type Vehicle struct {
-	ID        int       `db:"id"`
-	EntityID  string    `db:"entity_id"`
-	Brand     string    `db:"brand"`
-	Model     string    `db:"model"`
-	Year      int       `db:"year"`
-	Color     string    `db:"color"`
-	Price     float64   `db:"price"`
-	CreatedAt time.Time `db:"created_at"`
-	UpdatedAt time.Time `db:"updated_at"`
+	ID                 int        `db:"id"`
+	EntityID           string     `db:"entity_id"`
+	Slug               string     `db:"slug"`
+	DealerID           int        `db:"dealer_id"`
+	Brand              string     `db:"brand"`
+	Model              string     `db:"model"`
+	Year               int        `db:"year"`
+	Color              string     `db:"color"`
+	Price              float64    `db:"price"`
+	Mileage            int        `db:"mileage"`
+	FuelType           string     `db:"fuel_type"`
+	Transmission       string     `db:"transmission"`
+	BodyType           string     `db:"body_type"`
+	Condition          string     `db:"condition"`
+	Doors              int        `db:"doors"`
+	EngineDisplacement int        `db:"engine_displacement"`
+	LicensePlate       string     `db:"license_plate"`
+	VIN                string     `db:"vin"`
+	Status             string     `db:"status"`
+	PublishedAt        *time.Time `db:"published_at"`
+	Version            int        `db:"version"`
+	CreatedAt          time.Time  `db:"created_at"`
+	UpdatedAt          time.Time  `db:"updated_at"`
 }
 
 func VehicleFromDomain(vehicle entity.Vehicle) Vehicle {
 	return Vehicle{
-		ID:       vehicle.ID,
-		EntityID: vehicle.EntityID,
-		Brand:    vehicle.Brand,
-		Model:    vehicle.Model,
-		Year:     vehicle.Year,
-		Color:    vehicle.Color,
-		Price:    vehicle.Price,
+		ID:                 vehicle.ID,
+		EntityID:           vehicle.EntityID,
+		Slug:               vehicle.Slug.String(),
+		DealerID:           vehicle.DealerID,
+		Brand:              vehicle.Brand,
+		Model:              vehicle.Model,
+		Year:               vehicle.Year,
+		Color:              vehicle.Color,
+		Price:              vehicle.Price,
+		Mileage:            vehicle.Mileage,
+		FuelType:           vehicle.FuelType.String(),
+		Transmission:       vehicle.Transmission.String(),
+		BodyType:           vehicle.BodyType.String(),
+		Condition:          vehicle.Condition.String(),
+		Doors:              vehicle.Doors,
+		EngineDisplacement: vehicle.EngineDisplacement,
+		LicensePlate:       vehicle.LicensePlate.String(),
+		VIN:                vehicle.VIN.String(),
+		Status:             vehicle.Status.String(),
+		PublishedAt:        vehicle.PublishedAt,
+		Version:            vehicle.Version,
 	}
 }
 
 func (ref Vehicle) ToDomain() *entity.Vehicle {
 	return &entity.Vehicle{
-		ID:        ref.ID,
-		EntityID:  ref.EntityID,
-		Brand:     ref.Brand,
-		Model:     ref.Model,
-		Year:      ref.Year,
-		Color:     ref.Color,
-		Price:     ref.Price,
-		CreatedAt: ref.CreatedAt,
-		UpdatedAt: ref.UpdatedAt,
+		ID:                 ref.ID,
+		EntityID:           ref.EntityID,
+		Slug:               valueobjects.Slug(ref.Slug),
+		DealerID:           ref.DealerID,
+		Brand:              ref.Brand,
+		Model:              ref.Model,
+		Year:               ref.Year,
+		Color:              ref.Color,
+		Price:              ref.Price,
+		Mileage:            ref.Mileage,
+		FuelType:           valueobjects.FuelType(ref.FuelType),
+		Transmission:       valueobjects.Transmission(ref.Transmission),
+		BodyType:           valueobjects.BodyType(ref.BodyType),
+		Condition:          valueobjects.VehicleCondition(ref.Condition),
+		Doors:              ref.Doors,
+		EngineDisplacement: ref.EngineDisplacement,
+		LicensePlate:       valueobjects.LicensePlate(ref.LicensePlate),
+		VIN:                valueobjects.VIN(ref.VIN),
+		Status:             valueobjects.ListingStatus(ref.Status),
+		PublishedAt:        ref.PublishedAt,
+		Version:            ref.Version,
+		CreatedAt:          ref.CreatedAt,
+		UpdatedAt:          ref.UpdatedAt,
 	}
 }
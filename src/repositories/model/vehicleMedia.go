@@ -0,0 +1,59 @@
+package model
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type VehicleMedia struct {
+	ID           int       `db:"id"`
+	EntityID     string    `db:"entity_id"`
+	VehicleID    string    `db:"vehicle_id"`
+	DealerID     int       `db:"dealer_id"`
+	ContentType  string    `db:"content_type"`
+	Size         int64     `db:"size"`
+	Width        int       `db:"width"`
+	Height       int       `db:"height"`
+	Position     int       `db:"position"`
+	IsCover      bool      `db:"is_cover"`
+	StorageKey   string    `db:"storage_key"`
+	ThumbnailKey string    `db:"thumbnail_key"`
+	CreatedAt    time.Time `db:"created_at"`
+}
+
+func VehicleMediaFromDomain(media entity.VehicleMedia) VehicleMedia {
+	return VehicleMedia{
+		ID:           media.ID,
+		EntityID:     media.EntityID,
+		VehicleID:    media.VehicleID,
+		DealerID:     media.DealerID,
+		ContentType:  media.ContentType,
+		Size:         media.Size,
+		Width:        media.Width,
+		Height:       media.Height,
+		Position:     media.Position,
+		IsCover:      media.IsCover,
+		StorageKey:   media.StorageKey,
+		ThumbnailKey: media.ThumbnailKey,
+		CreatedAt:    media.CreatedAt,
+	}
+}
+
+func (ref *VehicleMedia) ToDomain() *entity.VehicleMedia {
+	return &entity.VehicleMedia{
+		ID:           ref.ID,
+		EntityID:     ref.EntityID,
+		VehicleID:    ref.VehicleID,
+		DealerID:     ref.DealerID,
+		ContentType:  ref.ContentType,
+		Size:         ref.Size,
+		Width:        ref.Width,
+		Height:       ref.Height,
+		Position:     ref.Position,
+		IsCover:      ref.IsCover,
+		StorageKey:   ref.StorageKey,
+		ThumbnailKey: ref.ThumbnailKey,
+		CreatedAt:    ref.CreatedAt,
+	}
+}
@@ -0,0 +1,36 @@
+package model
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+func TestVehicleMedia(t *testing.T) {
+	now := time.Now()
+
+	media := entity.VehicleMedia{
+		ID:           1,
+		EntityID:     uuid.NewString(),
+		VehicleID:    uuid.NewString(),
+		DealerID:     3,
+		ContentType:  "image/jpeg",
+		Size:         2048,
+		Width:        1280,
+		Height:       960,
+		Position:     2,
+		IsCover:      true,
+		StorageKey:   "vehicles/1/photo.jpg",
+		ThumbnailKey: "vehicles/1/photo_thumbnail.jpg",
+		CreatedAt:    now,
+	}
+
+	record := VehicleMediaFromDomain(media)
+
+	assert.Equal(t, media.StorageKey, record.StorageKey)
+	assert.Equal(t, &media, record.ToDomain())
+}
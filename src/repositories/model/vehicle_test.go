@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
 )
@@ -12,30 +13,61 @@ import (
 func TestVehicleFromDomain(t *testing.T) {
 	id := 1
 	entityID := uuid.NewString()
+	dealerID := 3
 	brand := uuid.NewString()
 	model := uuid.NewString()
 	year := 2025
 	color := "Black"
 	price := float64(95000)
+	version := 2
+	publishedAt := time.Now()
 
 	vehicle := entity.Vehicle{
-		ID:       id,
-		EntityID: entityID,
-		Brand:    brand,
-		Model:    model,
-		Year:     year,
-		Color:    color,
-		Price:    price,
+		ID:                 id,
+		EntityID:           entityID,
+		Slug:               "2025-some-brand-some-model-black-3f9a1c",
+		DealerID:           dealerID,
+		Brand:              brand,
+		Model:              model,
+		Year:               year,
+		Color:              color,
+		Price:              price,
+		Mileage:            42000,
+		FuelType:           valueobjects.FuelTypeFlex,
+		Transmission:       valueobjects.TransmissionManual,
+		BodyType:           valueobjects.BodyTypeHatch,
+		Condition:          valueobjects.VehicleConditionUsed,
+		Doors:              4,
+		EngineDisplacement: 1000,
+		LicensePlate:       "ABC1D23",
+		VIN:                "9BWZZZ377VT004251",
+		Status:             valueobjects.ListingStatusPublished,
+		PublishedAt:        &publishedAt,
+		Version:            version,
 	}
 
 	expected := Vehicle{
-		ID:       id,
-		EntityID: entityID,
-		Brand:    brand,
-		Model:    model,
-		Year:     year,
-		Color:    color,
-		Price:    price,
+		ID:                 id,
+		EntityID:           entityID,
+		Slug:               "2025-some-brand-some-model-black-3f9a1c",
+		DealerID:           dealerID,
+		Brand:              brand,
+		Model:              model,
+		Year:               year,
+		Color:              color,
+		Price:              price,
+		Mileage:            42000,
+		FuelType:           "FLEX",
+		Transmission:       "MANUAL",
+		BodyType:           "HATCH",
+		Condition:          "USED",
+		Doors:              4,
+		EngineDisplacement: 1000,
+		LicensePlate:       "ABC1D23",
+		VIN:                "9BWZZZ377VT004251",
+		Status:             "PUBLISHED",
+		PublishedAt:        &publishedAt,
+		Version:            version,
 	}
 
 	actual := VehicleFromDomain(vehicle)
@@ -46,35 +78,66 @@ func TestVehicleFromDomain(t *testing.T) {
 func TestVehicleToDomain(t *testing.T) {
 	id := 1
 	entityID := uuid.NewString()
+	dealerID := 3
 	brand := uuid.NewString()
 	model := uuid.NewString()
 	year := 2025
 	color := "Black"
 	price := float64(95000)
+	version := 2
+	publishedAt := time.Now()
 	now := time.Now()
 
 	vehicle := Vehicle{
-		ID:        id,
-		EntityID:  entityID,
-		Brand:     brand,
-		Model:     model,
-		Year:      year,
-		Color:     color,
-		Price:     price,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:                 id,
+		EntityID:           entityID,
+		Slug:               "2025-some-brand-some-model-black-3f9a1c",
+		DealerID:           dealerID,
+		Brand:              brand,
+		Model:              model,
+		Year:               year,
+		Color:              color,
+		Price:              price,
+		Mileage:            42000,
+		FuelType:           "FLEX",
+		Transmission:       "MANUAL",
+		BodyType:           "HATCH",
+		Condition:          "USED",
+		Doors:              4,
+		EngineDisplacement: 1000,
+		LicensePlate:       "ABC1D23",
+		VIN:                "9BWZZZ377VT004251",
+		Status:             "PUBLISHED",
+		PublishedAt:        &publishedAt,
+		Version:            version,
+		CreatedAt:          now,
+		UpdatedAt:          now,
 	}
 
 	expected := &entity.Vehicle{
-		ID:        id,
-		EntityID:  entityID,
-		Brand:     brand,
-		Model:     model,
-		Year:      year,
-		Color:     color,
-		Price:     price,
-		CreatedAt: now,
-		UpdatedAt: now,
+		ID:                 id,
+		EntityID:           entityID,
+		Slug:               "2025-some-brand-some-model-black-3f9a1c",
+		DealerID:           dealerID,
+		Brand:              brand,
+		Model:              model,
+		Year:               year,
+		Color:              color,
+		Price:              price,
+		Mileage:            42000,
+		FuelType:           valueobjects.FuelTypeFlex,
+		Transmission:       valueobjects.TransmissionManual,
+		BodyType:           valueobjects.BodyTypeHatch,
+		Condition:          valueobjects.VehicleConditionUsed,
+		Doors:              4,
+		EngineDisplacement: 1000,
+		LicensePlate:       "ABC1D23",
+		VIN:                "9BWZZZ377VT004251",
+		Status:             valueobjects.ListingStatusPublished,
+		PublishedAt:        &publishedAt,
+		Version:            version,
+		CreatedAt:          now,
+		UpdatedAt:          now,
 	}
 
 	actual := vehicle.ToDomain()
@@ -0,0 +1,158 @@
+package apikeyrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"maps"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/mongodb"
+)
+
+type apiKeyRepository struct {
+	database *mongo.Database
+	apiKeys  *mongo.Collection
+}
+
+func NewAPIKeyRepository(database *mongo.Database) interfaces.APIKeyRepository {
+	return &apiKeyRepository{
+		database: database,
+		apiKeys:  database.Collection(mongodb.APIKeysCollection),
+	}
+}
+
+// Create relies on the unique index on key_hash and checks the dealer of a
+// dealer key exists.
+func (ref *apiKeyRepository) Create(ctx context.Context, apiKey entity.APIKey) (*entity.APIKey, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(apiKey.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if dealerID != 0 {
+		exists, err := mongodb.HasDealer(ctx, ref.database, dealerID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !exists {
+			return nil, fmt.Errorf("%w: dealer %d", domainerrors.ErrReferenceNotFound, dealerID)
+		}
+	}
+
+	id, err := mongodb.NextID(ctx, ref.database, mongodb.APIKeysCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	roles := make([]string, 0, len(apiKey.Roles))
+	for _, role := range apiKey.Roles {
+		roles = append(roles, role.String())
+	}
+
+	document := apiKeyDocument{
+		ID:        id,
+		DealerID:  dealerID,
+		Name:      apiKey.Name,
+		Prefix:    apiKey.Prefix,
+		KeyHash:   apiKey.KeyHash,
+		Roles:     roles,
+		CreatedAt: mongodb.Now(),
+	}
+
+	if _, err = ref.apiKeys.InsertOne(ctx, document); err != nil {
+		return nil, mongodb.MapError(err)
+	}
+
+	return document.toDomain(), nil
+}
+
+// GetByHash is the one query not restricted to a tenant scope, since it runs
+// to authenticate the caller the scope is resolved from.
+func (ref *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (*entity.APIKey, error) {
+	var document apiKeyDocument
+
+	err := ref.apiKeys.FindOne(ctx, bson.M{"key_hash": keyHash}).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *apiKeyRepository) Search(ctx context.Context) ([]entity.APIKey, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := ref.apiKeys.Find(ctx, filter, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	apiKeys := make([]entity.APIKey, 0)
+
+	for cursor.Next(ctx) {
+		var document apiKeyDocument
+		if err = cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+
+		apiKeys = append(apiKeys, *document.toDomain())
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return apiKeys, nil
+}
+
+// Revoke keeps the first revocation time when a key is revoked twice.
+func (ref *apiKeyRepository) Revoke(ctx context.Context, id int, revokedAt time.Time) (*entity.APIKey, error) {
+	revokedAt = revokedAt.UTC().Truncate(time.Millisecond)
+
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"_id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	notRevoked := maps.Clone(filter)
+	notRevoked["revoked_at"] = nil
+
+	_, err = ref.apiKeys.UpdateOne(ctx, notRevoked, bson.M{"$set": bson.M{"revoked_at": revokedAt}})
+	if err != nil {
+		return nil, err
+	}
+
+	var document apiKeyDocument
+
+	err = ref.apiKeys.FindOne(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
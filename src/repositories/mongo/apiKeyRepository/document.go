@@ -0,0 +1,37 @@
+package apikeyrepository
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type apiKeyDocument struct {
+	ID        int        `bson:"_id"`
+	DealerID  int        `bson:"dealer_id"`
+	Name      string     `bson:"name"`
+	Prefix    string     `bson:"prefix"`
+	KeyHash   string     `bson:"key_hash"`
+	Roles     []string   `bson:"roles"`
+	CreatedAt time.Time  `bson:"created_at"`
+	RevokedAt *time.Time `bson:"revoked_at"`
+}
+
+func (ref apiKeyDocument) toDomain() *entity.APIKey {
+	roles := make([]valueobjects.Role, 0, len(ref.Roles))
+	for _, role := range ref.Roles {
+		roles = append(roles, valueobjects.Role(role))
+	}
+
+	return &entity.APIKey{
+		ID:        ref.ID,
+		DealerID:  ref.DealerID,
+		Name:      ref.Name,
+		Prefix:    ref.Prefix,
+		KeyHash:   ref.KeyHash,
+		Roles:     roles,
+		CreatedAt: ref.CreatedAt,
+		RevokedAt: ref.RevokedAt,
+	}
+}
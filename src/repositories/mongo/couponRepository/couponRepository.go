@@ -0,0 +1,236 @@
+package couponrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/mongodb"
+)
+
+type couponRepository struct {
+	database *mongo.Database
+	coupons  *mongo.Collection
+}
+
+func NewCouponRepository(database *mongo.Database) interfaces.CouponRepository {
+	return &couponRepository{
+		database: database,
+		coupons:  database.Collection(mongodb.CouponsCollection),
+	}
+}
+
+// Create checks the dealer exists, standing in for the foreign key of the
+// coupons table, and relies on the unique index for codes unique within the
+// dealer.
+func (ref *couponRepository) Create(ctx context.Context, coupon entity.Coupon) (*entity.Coupon, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(coupon.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := mongodb.HasDealer(ctx, ref.database, dealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("%w: dealer %d", domainerrors.ErrReferenceNotFound, dealerID)
+	}
+
+	id, err := mongodb.NextID(ctx, ref.database, mongodb.CouponsCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	now := mongodb.Now()
+
+	document := couponDocument{
+		ID:            id,
+		DealerID:      dealerID,
+		Code:          coupon.Code.String(),
+		DiscountType:  coupon.DiscountType.String(),
+		DiscountValue: model.RoundPrice(coupon.DiscountValue),
+		MaxUses:       coupon.MaxUses,
+		ValidFrom:     truncate(coupon.ValidFrom),
+		ValidUntil:    truncate(coupon.ValidUntil),
+		Brand:         coupon.Brand,
+		Model:         coupon.Model,
+		MinYear:       coupon.MinYear,
+		MaxYear:       coupon.MaxYear,
+		CreatedAt:     now,
+		UpdatedAt:     now,
+	}
+
+	if _, err = ref.coupons.InsertOne(ctx, document); err != nil {
+		return nil, mongodb.MapError(err)
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *couponRepository) GetByID(ctx context.Context, id int) (*entity.Coupon, error) {
+	return ref.get(ctx, bson.M{"_id": id})
+}
+
+func (ref *couponRepository) GetByCode(ctx context.Context, dealerID int, code valueobjects.CouponCode) (*entity.Coupon, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !scope.Allows(dealerID) {
+		return nil, nil
+	}
+
+	return ref.get(ctx, bson.M{"dealer_id": dealerID, "code": code.String()})
+}
+
+func (ref *couponRepository) Search(ctx context.Context) ([]entity.Coupon, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := ref.coupons.Find(ctx, filter, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	coupons := make([]entity.Coupon, 0)
+
+	for cursor.Next(ctx) {
+		var document couponDocument
+		if err = cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+
+		coupons = append(coupons, *document.toDomain())
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return coupons, nil
+}
+
+// Redeem increments the uses in the same update that checks them, which the
+// server applies atomically to the document.
+func (ref *couponRepository) Redeem(ctx context.Context, id int) (*entity.Coupon, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{
+		"_id": id,
+		"$or": bson.A{
+			bson.M{"max_uses": 0},
+			bson.M{"$expr": bson.M{"$lt": bson.A{"$uses", "$max_uses"}}},
+		},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{
+		"$inc": bson.M{"uses": 1},
+		"$set": bson.M{"updated_at": mongodb.Now()},
+	}
+
+	var document couponDocument
+
+	err = ref.coupons.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *couponRepository) Release(ctx context.Context, id int) (*entity.Coupon, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"_id": id, "uses": bson.M{"$gt": 0}})
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{
+		"$inc": bson.M{"uses": -1},
+		"$set": bson.M{"updated_at": mongodb.Now()},
+	}
+
+	var document couponDocument
+
+	err = ref.coupons.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *couponRepository) Delete(ctx context.Context, id int) (*entity.Coupon, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"_id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	var document couponDocument
+
+	err = ref.coupons.FindOneAndDelete(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *couponRepository) get(ctx context.Context, filter bson.M) (*entity.Coupon, error) {
+	filter, err := mongodb.ScopeFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var document couponDocument
+
+	err = ref.coupons.FindOne(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func truncate(date *time.Time) *time.Time {
+	if date == nil {
+		return nil
+	}
+
+	truncated := date.UTC().Truncate(time.Millisecond)
+	return &truncated
+}
@@ -0,0 +1,46 @@
+package couponrepository
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type couponDocument struct {
+	ID            int        `bson:"_id"`
+	DealerID      int        `bson:"dealer_id"`
+	Code          string     `bson:"code"`
+	DiscountType  string     `bson:"discount_type"`
+	DiscountValue float64    `bson:"discount_value"`
+	MaxUses       int        `bson:"max_uses"`
+	Uses          int        `bson:"uses"`
+	ValidFrom     *time.Time `bson:"valid_from"`
+	ValidUntil    *time.Time `bson:"valid_until"`
+	Brand         string     `bson:"brand"`
+	Model         string     `bson:"model"`
+	MinYear       int        `bson:"min_year"`
+	MaxYear       int        `bson:"max_year"`
+	CreatedAt     time.Time  `bson:"created_at"`
+	UpdatedAt     time.Time  `bson:"updated_at"`
+}
+
+func (ref couponDocument) toDomain() *entity.Coupon {
+	return &entity.Coupon{
+		ID:            ref.ID,
+		DealerID:      ref.DealerID,
+		Code:          valueobjects.CouponCode(ref.Code),
+		DiscountType:  valueobjects.DiscountType(ref.DiscountType),
+		DiscountValue: ref.DiscountValue,
+		MaxUses:       ref.MaxUses,
+		Uses:          ref.Uses,
+		ValidFrom:     ref.ValidFrom,
+		ValidUntil:    ref.ValidUntil,
+		Brand:         ref.Brand,
+		Model:         ref.Model,
+		MinYear:       ref.MinYear,
+		MaxYear:       ref.MaxYear,
+		CreatedAt:     ref.CreatedAt,
+		UpdatedAt:     ref.UpdatedAt,
+	}
+}
@@ -0,0 +1,108 @@
+package dealerrepository
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/mongodb"
+)
+
+type dealerRepository struct {
+	database *mongo.Database
+	dealers  *mongo.Collection
+}
+
+func NewDealerRepository(database *mongo.Database) interfaces.DealerRepository {
+	return &dealerRepository{
+		database: database,
+		dealers:  database.Collection(mongodb.DealersCollection),
+	}
+}
+
+func (ref *dealerRepository) Create(ctx context.Context, dealer entity.Dealer) (*entity.Dealer, error) {
+	if err := tenant.RequireAllDealers(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := mongodb.NextID(ctx, ref.database, mongodb.DealersCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	document := dealerDocument{
+		ID:        id,
+		Name:      dealer.Name,
+		CreatedAt: mongodb.Now(),
+	}
+
+	if _, err = ref.dealers.InsertOne(ctx, document); err != nil {
+		return nil, mongodb.MapError(err)
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *dealerRepository) GetByID(ctx context.Context, id int) (*entity.Dealer, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !scope.Allows(id) {
+		return nil, nil
+	}
+
+	var document dealerDocument
+
+	err = ref.dealers.FindOne(ctx, bson.M{"_id": id}).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *dealerRepository) Search(ctx context.Context) ([]entity.Dealer, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	filter := bson.M{}
+	if !scope.IsAll() {
+		filter["_id"] = scope.DealerID
+	}
+
+	cursor, err := ref.dealers.Find(ctx, filter, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	dealers := make([]entity.Dealer, 0)
+
+	for cursor.Next(ctx) {
+		var document dealerDocument
+		if err = cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+
+		dealers = append(dealers, *document.toDomain())
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return dealers, nil
+}
@@ -0,0 +1,21 @@
+package dealerrepository
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type dealerDocument struct {
+	ID        int       `bson:"_id"`
+	Name      string    `bson:"name"`
+	CreatedAt time.Time `bson:"created_at"`
+}
+
+func (ref dealerDocument) toDomain() *entity.Dealer {
+	return &entity.Dealer{
+		ID:        ref.ID,
+		Name:      ref.Name,
+		CreatedAt: ref.CreatedAt,
+	}
+}
@@ -0,0 +1,34 @@
+package financingplanrepository
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type financingPlanDocument struct {
+	ID                       int       `bson:"_id"`
+	DealerID                 int       `bson:"dealer_id"`
+	Name                     string    `bson:"name"`
+	AmortizationSystem       string    `bson:"amortization_system"`
+	MonthlyInterestRate      float64   `bson:"monthly_interest_rate"`
+	MaxMonths                int       `bson:"max_months"`
+	MinDownPaymentPercentage float64   `bson:"min_down_payment_percentage"`
+	CreatedAt                time.Time `bson:"created_at"`
+	UpdatedAt                time.Time `bson:"updated_at"`
+}
+
+func (ref financingPlanDocument) toDomain() *entity.FinancingPlan {
+	return &entity.FinancingPlan{
+		ID:                       ref.ID,
+		DealerID:                 ref.DealerID,
+		Name:                     ref.Name,
+		AmortizationSystem:       valueobjects.AmortizationSystem(ref.AmortizationSystem),
+		MonthlyInterestRate:      ref.MonthlyInterestRate,
+		MaxMonths:                ref.MaxMonths,
+		MinDownPaymentPercentage: ref.MinDownPaymentPercentage,
+		CreatedAt:                ref.CreatedAt,
+		UpdatedAt:                ref.UpdatedAt,
+	}
+}
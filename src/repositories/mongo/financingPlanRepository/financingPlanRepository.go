@@ -0,0 +1,146 @@
+package financingplanrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/mongodb"
+)
+
+type financingPlanRepository struct {
+	database *mongo.Database
+	plans    *mongo.Collection
+}
+
+func NewFinancingPlanRepository(database *mongo.Database) interfaces.FinancingPlanRepository {
+	return &financingPlanRepository{
+		database: database,
+		plans:    database.Collection(mongodb.FinancingPlansCollection),
+	}
+}
+
+// Create checks the dealer exists, standing in for the foreign key of the
+// financing_plans table.
+func (ref *financingPlanRepository) Create(ctx context.Context, plan entity.FinancingPlan) (*entity.FinancingPlan, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(plan.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := mongodb.HasDealer(ctx, ref.database, dealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("%w: dealer %d", domainerrors.ErrReferenceNotFound, dealerID)
+	}
+
+	id, err := mongodb.NextID(ctx, ref.database, mongodb.FinancingPlansCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	now := mongodb.Now()
+
+	document := financingPlanDocument{
+		ID:                       id,
+		DealerID:                 dealerID,
+		Name:                     plan.Name,
+		AmortizationSystem:       plan.AmortizationSystem.String(),
+		MonthlyInterestRate:      model.RoundRate(plan.MonthlyInterestRate),
+		MaxMonths:                plan.MaxMonths,
+		MinDownPaymentPercentage: model.RoundPrice(plan.MinDownPaymentPercentage),
+		CreatedAt:                now,
+		UpdatedAt:                now,
+	}
+
+	if _, err = ref.plans.InsertOne(ctx, document); err != nil {
+		return nil, mongodb.MapError(err)
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *financingPlanRepository) GetByID(ctx context.Context, id int) (*entity.FinancingPlan, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"_id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	var document financingPlanDocument
+
+	err = ref.plans.FindOne(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *financingPlanRepository) Search(ctx context.Context) ([]entity.FinancingPlan, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := ref.plans.Find(ctx, filter, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	plans := make([]entity.FinancingPlan, 0)
+
+	for cursor.Next(ctx) {
+		var document financingPlanDocument
+		if err = cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+
+		plans = append(plans, *document.toDomain())
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return plans, nil
+}
+
+func (ref *financingPlanRepository) Delete(ctx context.Context, id int) (*entity.FinancingPlan, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"_id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	var document financingPlanDocument
+
+	err = ref.plans.FindOneAndDelete(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
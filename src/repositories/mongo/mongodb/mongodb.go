@@ -0,0 +1,172 @@
+package mongodb
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/contrib/instrumentation/go.mongodb.org/mongo-driver/mongo/otelmongo"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+)
+
+const (
+	DealersCollection        = "dealers"
+	VehiclesCollection       = "vehicles"
+	VehicleMediaCollection   = "vehicle_media"
+	OffersCollection         = "vehicle_offers"
+	CouponsCollection        = "coupons"
+	FinancingPlansCollection = "financing_plans"
+	SalesCollection          = "sales"
+	APIKeysCollection        = "api_keys"
+	countersCollection       = "counters"
+)
+
+// Connect opens a client that traces every command, the same way the
+// Postgres repositories trace their queries.
+func Connect(ctx context.Context, uri string) (*mongo.Client, error) {
+	clientOptions := options.Client().
+		ApplyURI(uri).
+		SetMonitor(otelmongo.NewMonitor())
+
+	return mongo.Connect(ctx, clientOptions)
+}
+
+// SupportsTransactions reports whether the server is a replica set member or a
+// mongos, the only deployments where multi-document transactions exist.
+func SupportsTransactions(ctx context.Context, client *mongo.Client) (bool, error) {
+	var hello struct {
+		SetName string `bson:"setName"`
+		Msg     string `bson:"msg"`
+	}
+
+	err := client.Database("admin").RunCommand(ctx, bson.D{{Key: "hello", Value: 1}}).Decode(&hello)
+	if err != nil {
+		return false, err
+	}
+
+	return hello.SetName != "" || hello.Msg == "isdbgrid", nil
+}
+
+// EnsureIndexes creates the indexes backing the constraints of the Postgres
+// schema: unique entity ids for vehicles, media and sales, unique VINs and
+// slugs once informed, unique api key hashes, coupon codes unique within a
+// dealer, plus the lookups used by the repositories.
+func EnsureIndexes(ctx context.Context, database *mongo.Database) error {
+	indexes := map[string][]mongo.IndexModel{
+		VehiclesCollection: {
+			{Keys: bson.D{{Key: "entity_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "price", Value: 1}, {Key: "_id", Value: 1}}},
+			{Keys: bson.D{{Key: "dealer_id", Value: 1}}},
+			{Keys: bson.D{{Key: "status", Value: 1}, {Key: "published_at", Value: 1}}},
+			{
+				Keys:    bson.D{{Key: "vin", Value: 1}},
+				Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"vin": bson.M{"$gt": ""}}),
+			},
+			{
+				Keys:    bson.D{{Key: "slug", Value: 1}},
+				Options: options.Index().SetUnique(true).SetPartialFilterExpression(bson.M{"slug": bson.M{"$gt": ""}}),
+			},
+		},
+		VehicleMediaCollection: {
+			{Keys: bson.D{{Key: "entity_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "vehicle_id", Value: 1}, {Key: "position", Value: 1}}},
+			{Keys: bson.D{{Key: "dealer_id", Value: 1}}},
+		},
+		OffersCollection: {
+			{Keys: bson.D{{Key: "entity_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "vehicle_id", Value: 1}, {Key: "_id", Value: 1}}},
+			{Keys: bson.D{{Key: "dealer_id", Value: 1}}},
+		},
+		CouponsCollection: {
+			{Keys: bson.D{{Key: "dealer_id", Value: 1}, {Key: "code", Value: 1}}, Options: options.Index().SetUnique(true)},
+		},
+		FinancingPlansCollection: {
+			{Keys: bson.D{{Key: "dealer_id", Value: 1}}},
+		},
+		SalesCollection: {
+			{Keys: bson.D{{Key: "entity_id", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "payment_id", Value: 1}}},
+			{Keys: bson.D{{Key: "status", Value: 1}}},
+			{Keys: bson.D{{Key: "dealer_id", Value: 1}}},
+		},
+		APIKeysCollection: {
+			{Keys: bson.D{{Key: "key_hash", Value: 1}}, Options: options.Index().SetUnique(true)},
+			{Keys: bson.D{{Key: "dealer_id", Value: 1}}},
+		},
+	}
+
+	for collection, models := range indexes {
+		if _, err := database.Collection(collection).Indexes().CreateMany(ctx, models); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NextID hands out sequential ids like a SERIAL column. As with a Postgres
+// sequence, the counter is incremented outside of the caller's transaction so
+// concurrent units of work do not conflict on it.
+func NextID(ctx context.Context, database *mongo.Database, collection string) (int, error) {
+	var counter struct {
+		Value int `bson:"value"`
+	}
+
+	err := database.Collection(countersCollection).FindOneAndUpdate(
+		withoutTransaction(ctx),
+		bson.M{"_id": collection},
+		bson.M{"$inc": bson.M{"value": 1}},
+		options.FindOneAndUpdate().SetUpsert(true).SetReturnDocument(options.After),
+	).Decode(&counter)
+	if err != nil {
+		return 0, err
+	}
+
+	return counter.Value, nil
+}
+
+// ScopeFilter restricts filter to the dealer of the tenant scope in ctx and
+// leaves it as is for a scope reaching every dealer.
+func ScopeFilter(ctx context.Context, filter bson.M) (bson.M, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if !scope.IsAll() {
+		filter["dealer_id"] = scope.DealerID
+	}
+
+	return filter, nil
+}
+
+// HasDealer stands in for the foreign keys to the dealers table.
+func HasDealer(ctx context.Context, database *mongo.Database, id int) (bool, error) {
+	count, err := database.Collection(DealersCollection).CountDocuments(ctx, bson.M{"_id": id}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Now returns the time used for created_at and updated_at, truncated to the
+// millisecond precision of a BSON date.
+func Now() time.Time {
+	return time.Now().UTC().Truncate(time.Millisecond)
+}
+
+// MapError turns duplicate key errors into domain errors and returns any other
+// error untouched.
+func MapError(err error) error {
+	if mongo.IsDuplicateKeyError(err) {
+		return fmt.Errorf("%w: %s", domainerrors.ErrAlreadyExists, err)
+	}
+
+	return err
+}
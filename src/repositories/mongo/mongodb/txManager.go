@@ -0,0 +1,140 @@
+package mongodb
+
+import (
+	"context"
+	"errors"
+
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.opentelemetry.io/otel/trace"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+)
+
+var (
+	ErrNoTransaction        = errors.New("no transaction in context")
+	ErrTransactionDone      = errors.New("transaction has already been committed or rolled back")
+	ErrTransactionCancelled = errors.New("transaction was rolled back by a nested unit of work")
+)
+
+type txKey struct{}
+
+type txState struct {
+	session      mongo.Session
+	rollbackOnly bool
+}
+
+type txHandle struct {
+	state  *txState
+	nested bool
+	done   bool
+}
+
+type txManager struct {
+	client        *mongo.Client
+	transactional bool
+}
+
+// NewTxManager runs units of work in a session transaction, which the driver
+// picks up from the context. On a standalone server, where transactions do not
+// exist, transactional must be false: units of work then run without one and
+// Rollback can not undo their writes.
+func NewTxManager(client *mongo.Client, transactional bool) interfaces.TxManager {
+	return &txManager{
+		client:        client,
+		transactional: transactional,
+	}
+}
+
+func (ref *txManager) Begin(ctx context.Context) (context.Context, error) {
+	if handle, ok := ctx.Value(txKey{}).(*txHandle); ok && !(handle.done && !handle.nested) {
+		return context.WithValue(ctx, txKey{}, &txHandle{state: handle.state, nested: true}), nil
+	}
+
+	state := &txState{}
+
+	if ref.transactional {
+		session, err := ref.client.StartSession()
+		if err != nil {
+			return nil, err
+		}
+
+		if err = session.StartTransaction(); err != nil {
+			session.EndSession(ctx)
+			return nil, err
+		}
+
+		state.session = session
+		ctx = mongo.NewSessionContext(ctx, session)
+	}
+
+	return context.WithValue(ctx, txKey{}, &txHandle{state: state}), nil
+}
+
+func (ref *txManager) Commit(ctx context.Context) error {
+	handle, ok := ctx.Value(txKey{}).(*txHandle)
+	if !ok {
+		return ErrNoTransaction
+	}
+
+	if handle.done {
+		return ErrTransactionDone
+	}
+	handle.done = true
+
+	if handle.nested || handle.state.session == nil {
+		return nil
+	}
+
+	session := handle.state.session
+	defer session.EndSession(context.WithoutCancel(ctx))
+
+	if handle.state.rollbackOnly {
+		session.AbortTransaction(context.WithoutCancel(ctx))
+		return ErrTransactionCancelled
+	}
+
+	return session.CommitTransaction(ctx)
+}
+
+// Rollback is safe to call after Commit, which makes it usable in a defer.
+func (ref *txManager) Rollback(ctx context.Context) error {
+	handle, ok := ctx.Value(txKey{}).(*txHandle)
+	if !ok {
+		return ErrNoTransaction
+	}
+
+	if handle.done {
+		return nil
+	}
+	handle.done = true
+
+	if handle.nested {
+		handle.state.rollbackOnly = true
+		return nil
+	}
+
+	if handle.state.session == nil {
+		return nil
+	}
+
+	session := handle.state.session
+	defer session.EndSession(context.WithoutCancel(ctx))
+
+	return session.AbortTransaction(context.WithoutCancel(ctx))
+}
+
+// detachedContext keeps the cancellation of its parent but none of its values,
+// which drops the session the driver would otherwise use.
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Value(any) any {
+	return nil
+}
+
+// withoutTransaction runs an operation outside of the transaction in ctx while
+// keeping it in the same trace.
+func withoutTransaction(ctx context.Context) context.Context {
+	return trace.ContextWithSpan(detachedContext{ctx}, trace.SpanFromContext(ctx))
+}
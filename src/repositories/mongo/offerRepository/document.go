@@ -0,0 +1,42 @@
+package offerrepository
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type offerDocument struct {
+	ID                  int       `bson:"_id"`
+	EntityID            string    `bson:"entity_id"`
+	VehicleID           string    `bson:"vehicle_id"`
+	DealerID            int       `bson:"dealer_id"`
+	BuyerSubject        string    `bson:"buyer_subject"`
+	BuyerDocumentNumber string    `bson:"buyer_document_number"`
+	Amount              float64   `bson:"amount"`
+	CounterAmount       *float64  `bson:"counter_amount"`
+	Status              string    `bson:"status"`
+	PaymentID           string    `bson:"payment_id"`
+	ExpiresAt           time.Time `bson:"expires_at"`
+	CreatedAt           time.Time `bson:"created_at"`
+	UpdatedAt           time.Time `bson:"updated_at"`
+}
+
+func (ref offerDocument) toDomain() *entity.Offer {
+	return &entity.Offer{
+		ID:                  ref.ID,
+		EntityID:            ref.EntityID,
+		VehicleID:           ref.VehicleID,
+		DealerID:            ref.DealerID,
+		BuyerSubject:        ref.BuyerSubject,
+		BuyerDocumentNumber: ref.BuyerDocumentNumber,
+		Amount:              ref.Amount,
+		CounterAmount:       ref.CounterAmount,
+		Status:              valueobjects.OfferStatus(ref.Status),
+		PaymentID:           ref.PaymentID,
+		ExpiresAt:           ref.ExpiresAt,
+		CreatedAt:           ref.CreatedAt,
+		UpdatedAt:           ref.UpdatedAt,
+	}
+}
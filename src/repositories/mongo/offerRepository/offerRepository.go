@@ -0,0 +1,175 @@
+package offerrepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/mongodb"
+)
+
+type offerRepository struct {
+	database *mongo.Database
+	offers   *mongo.Collection
+}
+
+func NewOfferRepository(database *mongo.Database) interfaces.OfferRepository {
+	return &offerRepository{
+		database: database,
+		offers:   database.Collection(mongodb.OffersCollection),
+	}
+}
+
+// Create checks the vehicle exists in the dealer of the offer, standing in for
+// the foreign key of the vehicle_offers table.
+func (ref *offerRepository) Create(ctx context.Context, offer entity.Offer) (*entity.Offer, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(offer.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	vehicles, err := ref.database.Collection(mongodb.VehiclesCollection).CountDocuments(ctx,
+		bson.M{"entity_id": offer.VehicleID, "dealer_id": dealerID},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if vehicles == 0 {
+		return nil, fmt.Errorf("%w: vehicle %q", domainerrors.ErrReferenceNotFound, offer.VehicleID)
+	}
+
+	id, err := mongodb.NextID(ctx, ref.database, mongodb.OffersCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	now := mongodb.Now()
+
+	document := offerDocument{
+		ID:                  id,
+		EntityID:            offer.EntityID,
+		VehicleID:           offer.VehicleID,
+		DealerID:            dealerID,
+		BuyerSubject:        offer.BuyerSubject,
+		BuyerDocumentNumber: offer.BuyerDocumentNumber,
+		Amount:              model.RoundPrice(offer.Amount),
+		CounterAmount:       roundPrice(offer.CounterAmount),
+		Status:              offer.Status.String(),
+		PaymentID:           offer.PaymentID,
+		ExpiresAt:           truncate(offer.ExpiresAt),
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+
+	if _, err = ref.offers.InsertOne(ctx, document); err != nil {
+		return nil, mongodb.MapError(err)
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *offerRepository) GetByID(ctx context.Context, id string) (*entity.Offer, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"entity_id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	var document offerDocument
+
+	err = ref.offers.FindOne(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *offerRepository) SearchByVehicleID(ctx context.Context, vehicleID string) ([]entity.Offer, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"vehicle_id": vehicleID})
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := ref.offers.Find(ctx, filter, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	offers := make([]entity.Offer, 0)
+
+	for cursor.Next(ctx) {
+		var document offerDocument
+		if err = cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+
+		offers = append(offers, *document.toDomain())
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return offers, nil
+}
+
+func (ref *offerRepository) Update(ctx context.Context, offer entity.Offer) (*entity.Offer, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"entity_id": offer.EntityID})
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"counter_amount": roundPrice(offer.CounterAmount),
+		"status":         offer.Status.String(),
+		"payment_id":     offer.PaymentID,
+		"expires_at":     truncate(offer.ExpiresAt),
+		"updated_at":     mongodb.Now(),
+	}}
+
+	var document offerDocument
+
+	err = ref.offers.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func truncate(date time.Time) time.Time {
+	return date.UTC().Truncate(time.Millisecond)
+}
+
+func roundPrice(price *float64) *float64 {
+	if price == nil {
+		return nil
+	}
+
+	rounded := model.RoundPrice(*price)
+	return &rounded
+}
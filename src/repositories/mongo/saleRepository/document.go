@@ -0,0 +1,198 @@
+package salerepository
+
+import (
+	"slices"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+)
+
+type saleDocument struct {
+	ID                  int                `bson:"_id"`
+	EntityID            string             `bson:"entity_id"`
+	DealerID            int                `bson:"dealer_id"`
+	PaymentID           string             `bson:"payment_id"`
+	BuyerDocumentNumber string             `bson:"buyer_document_number"`
+	ListPrice           float64            `bson:"list_price"`
+	Discount            float64            `bson:"discount"`
+	Price               float64            `bson:"price"`
+	CouponCode          string             `bson:"coupon_code"`
+	TradeIn             *tradeInDocument   `bson:"trade_in,omitempty"`
+	Financing           *financingDocument `bson:"financing,omitempty"`
+	Status              string             `bson:"status"`
+	SoldAt              *time.Time         `bson:"sold_at"`
+	CreatedAt           time.Time          `bson:"created_at"`
+	UpdatedAt           time.Time          `bson:"updated_at"`
+}
+
+func (ref saleDocument) toDomain() *entity.Sale {
+	return &entity.Sale{
+		ID:                  ref.ID,
+		EntityID:            ref.EntityID,
+		DealerID:            ref.DealerID,
+		PaymentID:           ref.PaymentID,
+		BuyerDocumentNumber: ref.BuyerDocumentNumber,
+		ListPrice:           ref.ListPrice,
+		Discount:            ref.Discount,
+		Price:               ref.Price,
+		CouponCode:          ref.CouponCode,
+		TradeIn:             ref.TradeIn.toDomain(),
+		Financing:           ref.Financing.toDomain(),
+		Status:              valueobjects.SaleStatusType(ref.Status),
+		SoldAt:              ref.SoldAt,
+		CreatedAt:           ref.CreatedAt,
+		UpdatedAt:           ref.UpdatedAt,
+	}
+}
+
+// installments returns the amortization table of the sale ordered by number.
+func (ref saleDocument) installments() []entity.Installment {
+	installments := make([]entity.Installment, 0)
+
+	if ref.Financing != nil {
+		for _, installment := range ref.Financing.Installments {
+			installments = append(installments, installment.toDomain(ref.ID))
+		}
+	}
+
+	slices.SortFunc(installments, func(a, b entity.Installment) int {
+		return a.Number - b.Number
+	})
+
+	return installments
+}
+
+// tradeInDocument is embedded in the sale, which only has one when the buyer
+// traded in a vehicle.
+type tradeInDocument struct {
+	Brand          string  `bson:"brand"`
+	Model          string  `bson:"model"`
+	Year           int     `bson:"year"`
+	Color          string  `bson:"color"`
+	Mileage        int     `bson:"mileage"`
+	LicensePlate   string  `bson:"license_plate"`
+	VIN            string  `bson:"vin"`
+	AppraisedValue float64 `bson:"appraised_value"`
+	AppraisedBy    string  `bson:"appraised_by"`
+	VehicleID      string  `bson:"vehicle_id"`
+}
+
+func tradeInFromDomain(tradeIn *entity.TradeIn) *tradeInDocument {
+	if tradeIn == nil {
+		return nil
+	}
+
+	return &tradeInDocument{
+		Brand:          tradeIn.Brand,
+		Model:          tradeIn.Model,
+		Year:           tradeIn.Year,
+		Color:          tradeIn.Color,
+		Mileage:        tradeIn.Mileage,
+		LicensePlate:   tradeIn.LicensePlate.String(),
+		VIN:            tradeIn.VIN.String(),
+		AppraisedValue: model.RoundPrice(tradeIn.AppraisedValue),
+		AppraisedBy:    tradeIn.AppraisedBy,
+		VehicleID:      tradeIn.VehicleID,
+	}
+}
+
+func (ref *tradeInDocument) toDomain() *entity.TradeIn {
+	if ref == nil {
+		return nil
+	}
+
+	return &entity.TradeIn{
+		Brand:          ref.Brand,
+		Model:          ref.Model,
+		Year:           ref.Year,
+		Color:          ref.Color,
+		Mileage:        ref.Mileage,
+		LicensePlate:   valueobjects.LicensePlate(ref.LicensePlate),
+		VIN:            valueobjects.VIN(ref.VIN),
+		AppraisedValue: ref.AppraisedValue,
+		AppraisedBy:    ref.AppraisedBy,
+		VehicleID:      ref.VehicleID,
+	}
+}
+
+// financingDocument is embedded in the sale along with its amortization table,
+// which is only read on its own.
+type financingDocument struct {
+	PlanID              int                   `bson:"plan_id"`
+	AmortizationSystem  string                `bson:"amortization_system"`
+	MonthlyInterestRate float64               `bson:"monthly_interest_rate"`
+	DownPayment         float64               `bson:"down_payment"`
+	Principal           float64               `bson:"principal"`
+	Months              int                   `bson:"months"`
+	Installments        []installmentDocument `bson:"installments,omitempty"`
+}
+
+func financingFromDomain(financing *entity.Financing) *financingDocument {
+	if financing == nil {
+		return nil
+	}
+
+	return &financingDocument{
+		PlanID:              financing.PlanID,
+		AmortizationSystem:  financing.AmortizationSystem.String(),
+		MonthlyInterestRate: model.RoundRate(financing.MonthlyInterestRate),
+		DownPayment:         model.RoundPrice(financing.DownPayment),
+		Principal:           model.RoundPrice(financing.Principal),
+		Months:              financing.Months,
+	}
+}
+
+func (ref *financingDocument) toDomain() *entity.Financing {
+	if ref == nil || ref.Months == 0 {
+		return nil
+	}
+
+	return &entity.Financing{
+		PlanID:              ref.PlanID,
+		AmortizationSystem:  valueobjects.AmortizationSystem(ref.AmortizationSystem),
+		MonthlyInterestRate: ref.MonthlyInterestRate,
+		DownPayment:         ref.DownPayment,
+		Principal:           ref.Principal,
+		Months:              ref.Months,
+	}
+}
+
+type installmentDocument struct {
+	Number       int        `bson:"number"`
+	DueDate      time.Time  `bson:"due_date"`
+	Amount       float64    `bson:"amount"`
+	Interest     float64    `bson:"interest"`
+	Amortization float64    `bson:"amortization"`
+	Balance      float64    `bson:"balance"`
+	PaidAt       *time.Time `bson:"paid_at"`
+}
+
+// installmentFromDomain keeps only the day of the due date, as the DATE column
+// of the Postgres table does.
+func installmentFromDomain(installment entity.Installment) installmentDocument {
+	year, month, day := installment.DueDate.Date()
+
+	return installmentDocument{
+		Number:       installment.Number,
+		DueDate:      time.Date(year, month, day, 0, 0, 0, 0, time.UTC),
+		Amount:       model.RoundPrice(installment.Amount),
+		Interest:     model.RoundPrice(installment.Interest),
+		Amortization: model.RoundPrice(installment.Amortization),
+		Balance:      model.RoundPrice(installment.Balance),
+	}
+}
+
+func (ref installmentDocument) toDomain(saleID int) entity.Installment {
+	return entity.Installment{
+		SaleID:       saleID,
+		Number:       ref.Number,
+		DueDate:      ref.DueDate,
+		Amount:       ref.Amount,
+		Interest:     ref.Interest,
+		Amortization: ref.Amortization,
+		Balance:      ref.Balance,
+		PaidAt:       ref.PaidAt,
+	}
+}
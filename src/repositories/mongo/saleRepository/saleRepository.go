@@ -0,0 +1,414 @@
+package salerepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/mongodb"
+)
+
+type saleRepository struct {
+	database *mongo.Database
+	sales    *mongo.Collection
+	vehicles *mongo.Collection
+}
+
+func NewSaleRepository(database *mongo.Database) interfaces.SaleRepository {
+	return &saleRepository{
+		database: database,
+		sales:    database.Collection(mongodb.SalesCollection),
+		vehicles: database.Collection(mongodb.VehiclesCollection),
+	}
+}
+
+// Create checks the vehicle exists in the dealer of the sale, standing in for
+// the foreign key of the sales table, and relies on the unique index for one
+// sale per vehicle.
+func (ref *saleRepository) Create(ctx context.Context, sale entity.Sale) (*entity.Sale, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(sale.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	vehicles, err := ref.vehicles.CountDocuments(ctx, bson.M{"entity_id": sale.EntityID, "dealer_id": dealerID}, options.Count().SetLimit(1))
+	if err != nil {
+		return nil, err
+	}
+
+	if vehicles == 0 {
+		return nil, fmt.Errorf("%w: vehicle %q", domainerrors.ErrReferenceNotFound, sale.EntityID)
+	}
+
+	id, err := mongodb.NextID(ctx, ref.database, mongodb.SalesCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	now := mongodb.Now()
+
+	document := saleDocument{
+		ID:                  id,
+		EntityID:            sale.EntityID,
+		DealerID:            dealerID,
+		PaymentID:           sale.PaymentID,
+		BuyerDocumentNumber: sale.BuyerDocumentNumber,
+		ListPrice:           model.RoundPrice(sale.ListPrice),
+		Discount:            model.RoundPrice(sale.Discount),
+		Price:               model.RoundPrice(sale.Price),
+		CouponCode:          sale.CouponCode,
+		TradeIn:             tradeInFromDomain(sale.TradeIn),
+		Financing:           financingFromDomain(sale.Financing),
+		Status:              sale.Status.String(),
+		SoldAt:              truncate(sale.SoldAt),
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+
+	if _, err = ref.sales.InsertOne(ctx, document); err != nil {
+		return nil, mongodb.MapError(err)
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *saleRepository) GetByID(ctx context.Context, id int) (*entity.Sale, error) {
+	return ref.get(ctx, bson.M{"_id": id})
+}
+
+func (ref *saleRepository) GetByEntityID(ctx context.Context, entityID string) (*entity.Sale, error) {
+	return ref.get(ctx, bson.M{"entity_id": entityID})
+}
+
+func (ref *saleRepository) Search(ctx context.Context) ([]entity.Sale, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{})
+	if err != nil {
+		return nil, err
+	}
+
+	cursor, err := ref.sales.Find(ctx, filter, options.Find().SetSort(bson.M{"_id": 1}))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	sales := make([]entity.Sale, 0)
+
+	for cursor.Next(ctx) {
+		var document saleDocument
+		if err = cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+
+		sales = append(sales, *document.toDomain())
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return sales, nil
+}
+
+func (ref *saleRepository) CountByStatus(ctx context.Context, status string) (int, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"status": status})
+	if err != nil {
+		return 0, err
+	}
+
+	count, err := ref.sales.CountDocuments(ctx, filter)
+	if err != nil {
+		return 0, err
+	}
+
+	return int(count), nil
+}
+
+// UpdateStatusByPaymentID updates every sale with the payment and returns the
+// first one, as the Postgres repository does. The first sale is updated on its
+// own, atomically reading the status it had, and then the others.
+func (ref *saleRepository) UpdateStatusByPaymentID(ctx context.Context, paymentID, status string, soldDate time.Time) (*entity.Sale, valueobjects.SaleStatusType, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"payment_id": paymentID})
+	if err != nil {
+		return nil, "", err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"status":     status,
+		"sold_at":    truncate(&soldDate),
+		"updated_at": mongodb.Now(),
+	}}
+
+	var previous saleDocument
+
+	err = ref.sales.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetSort(bson.M{"_id": 1})).Decode(&previous)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			logger.FromContext(ctx).DebugContext(ctx, "no sale to update", "payment_id", paymentID)
+			return nil, "", nil
+		}
+		return nil, "", err
+	}
+
+	if _, err = ref.sales.UpdateMany(ctx, filter, update); err != nil {
+		return nil, "", err
+	}
+
+	var document saleDocument
+
+	err = ref.sales.FindOne(ctx, filter, options.FindOne().SetSort(bson.M{"_id": 1})).Decode(&document)
+	if err != nil {
+		return nil, "", err
+	}
+
+	return document.toDomain(), valueobjects.SaleStatusType(previous.Status), nil
+}
+
+func (ref *saleRepository) LinkTradeInVehicle(ctx context.Context, id int, vehicleID string) (*entity.Sale, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"_id": id, "trade_in.vehicle_id": ""})
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"trade_in.vehicle_id": vehicleID,
+		"updated_at":          mongodb.Now(),
+	}}
+
+	var document saleDocument
+
+	err = ref.sales.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *saleRepository) AttachPayment(ctx context.Context, id int, paymentID string) (*entity.Sale, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"_id": id, "payment_id": ""})
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"payment_id": paymentID,
+		"updated_at": mongodb.Now(),
+	}}
+
+	var document saleDocument
+
+	err = ref.sales.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *saleRepository) DeleteReservation(ctx context.Context, id int) (*entity.Sale, error) {
+	return ref.delete(ctx, bson.M{"_id": id, "payment_id": ""})
+}
+
+func (ref *saleRepository) DeleteRejected(ctx context.Context, id int) (*entity.Sale, error) {
+	return ref.delete(ctx, bson.M{"_id": id, "status": string(valueobjects.SaleStatusTypeRejected)})
+}
+
+// delete takes the installments with the sale, as they are embedded in its
+// financing.
+func (ref *saleRepository) delete(ctx context.Context, filter bson.M) (*entity.Sale, error) {
+	filter, err := mongodb.ScopeFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var document saleDocument
+
+	err = ref.sales.FindOneAndDelete(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+// CreateInstallments pushes the installments into the financing of the sale,
+// in the same update that checks none of their numbers is there yet.
+func (ref *saleRepository) CreateInstallments(ctx context.Context, id int, installments []entity.Installment) ([]entity.Installment, error) {
+	numbers := make(bson.A, 0, len(installments))
+	documents := make(bson.A, 0, len(installments))
+
+	for _, installment := range installments {
+		numbers = append(numbers, installment.Number)
+		documents = append(documents, installmentFromDomain(installment))
+	}
+
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"_id": id, "financing.installments.number": bson.M{"$nin": numbers}})
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{
+		"$push": bson.M{"financing.installments": bson.M{"$each": documents}},
+		"$set":  bson.M{"updated_at": mongodb.Now()},
+	}
+
+	result, err := ref.sales.UpdateOne(ctx, filter, update)
+	if err != nil {
+		return nil, err
+	}
+
+	if result.MatchedCount == 0 {
+		sale, err := ref.GetByID(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+
+		if sale == nil {
+			return nil, fmt.Errorf("%w: sale %d", domainerrors.ErrReferenceNotFound, id)
+		}
+
+		return nil, fmt.Errorf("%w: installments of sale %d", domainerrors.ErrAlreadyExists, id)
+	}
+
+	created := make([]entity.Installment, 0, len(documents))
+	for _, document := range documents {
+		created = append(created, document.(installmentDocument).toDomain(id))
+	}
+
+	return created, nil
+}
+
+func (ref *saleRepository) SearchInstallments(ctx context.Context, id int) ([]entity.Installment, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"_id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	var document saleDocument
+
+	err = ref.sales.FindOne(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return make([]entity.Installment, 0), nil
+		}
+		return nil, err
+	}
+
+	return document.installments(), nil
+}
+
+// PayInstallment sets paid_at through the positional operator, on the first
+// installment with the number that is not paid yet, and reads the installment
+// back whether it was paid now or before.
+func (ref *saleRepository) PayInstallment(ctx context.Context, paymentID string, number int, paidAt time.Time) (*entity.Installment, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{
+		"payment_id":             paymentID,
+		"financing.installments": bson.M{"$elemMatch": bson.M{"number": number, "paid_at": nil}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"financing.installments.$.paid_at": truncate(&paidAt),
+		"updated_at":                       mongodb.Now(),
+	}}
+
+	if _, err = ref.sales.UpdateOne(ctx, filter, update); err != nil {
+		return nil, err
+	}
+
+	filter, err = mongodb.ScopeFilter(ctx, bson.M{"payment_id": paymentID, "financing.installments.number": number})
+	if err != nil {
+		return nil, err
+	}
+
+	var document saleDocument
+
+	err = ref.sales.FindOne(ctx, filter, options.FindOne().SetSort(bson.M{"_id": 1})).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	for _, installment := range document.installments() {
+		if installment.Number == number {
+			return &installment, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (ref *saleRepository) get(ctx context.Context, filter bson.M) (*entity.Sale, error) {
+	filter, err := mongodb.ScopeFilter(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	var document saleDocument
+
+	err = ref.sales.FindOne(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+// BackfillPriceBreakdown gives the sales stored before coupons their list
+// price, the price they were charged, as the migration adding the columns does
+// for Postgres.
+func BackfillPriceBreakdown(ctx context.Context, database *mongo.Database) error {
+	_, err := database.Collection(mongodb.SalesCollection).UpdateMany(ctx,
+		bson.M{"list_price": bson.M{"$exists": false}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"list_price":  "$price",
+			"discount":    0,
+			"coupon_code": "",
+		}}}},
+	)
+
+	return err
+}
+
+func truncate(date *time.Time) *time.Time {
+	if date == nil {
+		return nil
+	}
+
+	truncated := date.UTC().Truncate(time.Millisecond)
+	return &truncated
+}
@@ -0,0 +1,41 @@
+package vehiclemediarepository
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+)
+
+type vehicleMediaDocument struct {
+	ID           int       `bson:"_id"`
+	EntityID     string    `bson:"entity_id"`
+	VehicleID    string    `bson:"vehicle_id"`
+	DealerID     int       `bson:"dealer_id"`
+	ContentType  string    `bson:"content_type"`
+	Size         int64     `bson:"size"`
+	Width        int       `bson:"width"`
+	Height       int       `bson:"height"`
+	Position     int       `bson:"position"`
+	IsCover      bool      `bson:"is_cover"`
+	StorageKey   string    `bson:"storage_key"`
+	ThumbnailKey string    `bson:"thumbnail_key"`
+	CreatedAt    time.Time `bson:"created_at"`
+}
+
+func (ref vehicleMediaDocument) toDomain() *entity.VehicleMedia {
+	return &entity.VehicleMedia{
+		ID:           ref.ID,
+		EntityID:     ref.EntityID,
+		VehicleID:    ref.VehicleID,
+		DealerID:     ref.DealerID,
+		ContentType:  ref.ContentType,
+		Size:         ref.Size,
+		Width:        ref.Width,
+		Height:       ref.Height,
+		Position:     ref.Position,
+		IsCover:      ref.IsCover,
+		StorageKey:   ref.StorageKey,
+		ThumbnailKey: ref.ThumbnailKey,
+		CreatedAt:    ref.CreatedAt,
+	}
+}
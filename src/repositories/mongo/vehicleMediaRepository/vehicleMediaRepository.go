@@ -0,0 +1,198 @@
+package vehiclemediarepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/mongodb"
+)
+
+var sortByPosition = bson.D{{Key: "vehicle_id", Value: 1}, {Key: "position", Value: 1}, {Key: "_id", Value: 1}}
+
+type vehicleMediaRepository struct {
+	database *mongo.Database
+	media    *mongo.Collection
+}
+
+func NewVehicleMediaRepository(database *mongo.Database) interfaces.VehicleMediaRepository {
+	return &vehicleMediaRepository{
+		database: database,
+		media:    database.Collection(mongodb.VehicleMediaCollection),
+	}
+}
+
+// Create checks the vehicle exists in the dealer of the media, standing in for
+// the foreign key of the vehicle_media table.
+func (ref *vehicleMediaRepository) Create(ctx context.Context, media entity.VehicleMedia) (*entity.VehicleMedia, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(media.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	vehicles, err := ref.database.Collection(mongodb.VehiclesCollection).CountDocuments(ctx,
+		bson.M{"entity_id": media.VehicleID, "dealer_id": dealerID},
+		options.Count().SetLimit(1),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if vehicles == 0 {
+		return nil, fmt.Errorf("%w: vehicle %q", domainerrors.ErrReferenceNotFound, media.VehicleID)
+	}
+
+	id, err := mongodb.NextID(ctx, ref.database, mongodb.VehicleMediaCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	document := vehicleMediaDocument{
+		ID:           id,
+		EntityID:     media.EntityID,
+		VehicleID:    media.VehicleID,
+		DealerID:     dealerID,
+		ContentType:  media.ContentType,
+		Size:         media.Size,
+		Width:        media.Width,
+		Height:       media.Height,
+		Position:     media.Position,
+		IsCover:      media.IsCover,
+		StorageKey:   media.StorageKey,
+		ThumbnailKey: media.ThumbnailKey,
+		CreatedAt:    mongodb.Now(),
+	}
+
+	if _, err = ref.media.InsertOne(ctx, document); err != nil {
+		return nil, mongodb.MapError(err)
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *vehicleMediaRepository) GetByID(ctx context.Context, id string) (*entity.VehicleMedia, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"entity_id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	var document vehicleMediaDocument
+
+	err = ref.media.FindOne(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *vehicleMediaRepository) SearchByVehicleIDs(ctx context.Context, vehicleIDs []string) ([]entity.VehicleMedia, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"vehicle_id": bson.M{"$in": vehicleIDs}})
+	if err != nil {
+		return nil, err
+	}
+
+	return ref.find(ctx, filter)
+}
+
+func (ref *vehicleMediaRepository) Update(ctx context.Context, media entity.VehicleMedia) (*entity.VehicleMedia, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"entity_id": media.EntityID})
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.M{"$set": bson.M{
+		"position": media.Position,
+		"is_cover": media.IsCover,
+	}}
+
+	var document vehicleMediaDocument
+
+	err = ref.media.FindOneAndUpdate(ctx, filter, update, options.FindOneAndUpdate().SetReturnDocument(options.After)).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *vehicleMediaRepository) Delete(ctx context.Context, id string) (*entity.VehicleMedia, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"entity_id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	var document vehicleMediaDocument
+
+	err = ref.media.FindOneAndDelete(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *vehicleMediaRepository) DeleteByVehicleID(ctx context.Context, vehicleID string) ([]entity.VehicleMedia, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"vehicle_id": vehicleID})
+	if err != nil {
+		return nil, err
+	}
+
+	media, err := ref.find(ctx, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = ref.media.DeleteMany(ctx, filter); err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
+
+func (ref *vehicleMediaRepository) find(ctx context.Context, filter bson.M) ([]entity.VehicleMedia, error) {
+	cursor, err := ref.media.Find(ctx, filter, options.Find().SetSort(sortByPosition))
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	media := make([]entity.VehicleMedia, 0)
+
+	for cursor.Next(ctx) {
+		var document vehicleMediaDocument
+		if err = cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+
+		media = append(media, *document.toDomain())
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return media, nil
+}
@@ -0,0 +1,64 @@
+package vehiclerepository
+
+import (
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+)
+
+type vehicleDocument struct {
+	ID                 int        `bson:"_id"`
+	EntityID           string     `bson:"entity_id"`
+	Slug               string     `bson:"slug"`
+	DealerID           int        `bson:"dealer_id"`
+	Brand              string     `bson:"brand"`
+	Model              string     `bson:"model"`
+	Year               int        `bson:"year"`
+	Color              string     `bson:"color"`
+	Price              float64    `bson:"price"`
+	Mileage            int        `bson:"mileage"`
+	FuelType           string     `bson:"fuel_type"`
+	Transmission       string     `bson:"transmission"`
+	BodyType           string     `bson:"body_type"`
+	Condition          string     `bson:"condition"`
+	Doors              int        `bson:"doors"`
+	EngineDisplacement int        `bson:"engine_displacement"`
+	LicensePlate       string     `bson:"license_plate"`
+	VIN                string     `bson:"vin"`
+	Status             string     `bson:"status"`
+	PublishedAt        *time.Time `bson:"published_at"`
+	Version            int        `bson:"version"`
+	CreatedAt          time.Time  `bson:"created_at"`
+	UpdatedAt          time.Time  `bson:"updated_at"`
+}
+
+// toDomain reports documents stored before vehicles were versioned, which have
+// no version field, as version 1.
+func (ref vehicleDocument) toDomain() *entity.Vehicle {
+	return &entity.Vehicle{
+		ID:                 ref.ID,
+		EntityID:           ref.EntityID,
+		Slug:               valueobjects.Slug(ref.Slug),
+		DealerID:           ref.DealerID,
+		Brand:              ref.Brand,
+		Model:              ref.Model,
+		Year:               ref.Year,
+		Color:              ref.Color,
+		Price:              ref.Price,
+		Mileage:            ref.Mileage,
+		FuelType:           valueobjects.FuelType(ref.FuelType),
+		Transmission:       valueobjects.Transmission(ref.Transmission),
+		BodyType:           valueobjects.BodyType(ref.BodyType),
+		Condition:          valueobjects.VehicleCondition(ref.Condition),
+		Doors:              ref.Doors,
+		EngineDisplacement: ref.EngineDisplacement,
+		LicensePlate:       valueobjects.LicensePlate(ref.LicensePlate),
+		VIN:                valueobjects.VIN(ref.VIN),
+		Status:             valueobjects.ListingStatus(ref.Status),
+		PublishedAt:        ref.PublishedAt,
+		Version:            max(ref.Version, 1),
+		CreatedAt:          ref.CreatedAt,
+		UpdatedAt:          ref.UpdatedAt,
+	}
+}
@@ -0,0 +1,407 @@
+package vehiclerepository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/mongodb"
+)
+
+var sortByPrice = bson.D{{Key: "price", Value: 1}, {Key: "_id", Value: 1}}
+
+type vehicleRepository struct {
+	database *mongo.Database
+	vehicles *mongo.Collection
+}
+
+func NewVehicleRepository(database *mongo.Database) interfaces.VehicleRepository {
+	return &vehicleRepository{
+		database: database,
+		vehicles: database.Collection(mongodb.VehiclesCollection),
+	}
+}
+
+// Create checks the dealer exists, standing in for the foreign key of the
+// vehicles table.
+func (ref *vehicleRepository) Create(ctx context.Context, vehicle entity.Vehicle) (*entity.Vehicle, error) {
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	dealerID, err := scope.Resolve(vehicle.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	exists, err := mongodb.HasDealer(ctx, ref.database, dealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	if !exists {
+		return nil, fmt.Errorf("%w: dealer %d", domainerrors.ErrReferenceNotFound, dealerID)
+	}
+
+	id, err := mongodb.NextID(ctx, ref.database, mongodb.VehiclesCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	now := mongodb.Now()
+
+	document := vehicleDocument{
+		ID:                 id,
+		EntityID:           vehicle.EntityID,
+		Slug:               vehicle.Slug.String(),
+		DealerID:           dealerID,
+		Brand:              vehicle.Brand,
+		Model:              vehicle.Model,
+		Year:               vehicle.Year,
+		Color:              vehicle.Color,
+		Price:              model.RoundPrice(vehicle.Price),
+		Mileage:            vehicle.Mileage,
+		FuelType:           vehicle.FuelType.String(),
+		Transmission:       vehicle.Transmission.String(),
+		BodyType:           vehicle.BodyType.String(),
+		Condition:          vehicle.Condition.String(),
+		Doors:              vehicle.Doors,
+		EngineDisplacement: vehicle.EngineDisplacement,
+		LicensePlate:       vehicle.LicensePlate.String(),
+		VIN:                vehicle.VIN.String(),
+		Status:             vehicle.Status.String(),
+		PublishedAt:        truncate(vehicle.PublishedAt),
+		Version:            1,
+		CreatedAt:          now,
+		UpdatedAt:          now,
+	}
+
+	if _, err = ref.vehicles.InsertOne(ctx, document); err != nil {
+		return nil, mongodb.MapError(err)
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *vehicleRepository) GetByID(ctx context.Context, id string) (*entity.Vehicle, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"entity_id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	var document vehicleDocument
+
+	err = ref.vehicles.FindOne(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+func (ref *vehicleRepository) GetBySlug(ctx context.Context, slug string) (*entity.Vehicle, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"slug": slug})
+	if err != nil {
+		return nil, err
+	}
+
+	var document vehicleDocument
+
+	err = ref.vehicles.FindOne(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+// ExistsByVIN is not scoped, as the unique index on VINs is not either.
+func (ref *vehicleRepository) ExistsByVIN(ctx context.Context, vin valueobjects.VIN) (bool, error) {
+	count, err := ref.vehicles.CountDocuments(ctx, bson.M{"vin": vin.String()}, options.Count().SetLimit(1))
+	if err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// Search mirrors the joins of the Postgres repository: a vehicle is sold once
+// its sale is approved and has a sold date, and not sold while it has no sale
+// or a sale that is neither approved nor dated.
+func (ref *vehicleRepository) Search(ctx context.Context, vehicleFilter entity.VehicleFilter) ([]entity.Vehicle, error) {
+	filter, err := mongodb.ScopeFilter(ctx, attributesFilter(vehicleFilter))
+	if err != nil {
+		return nil, err
+	}
+
+	var cursor *mongo.Cursor
+
+	if vehicleFilter.IsSold == nil {
+		cursor, err = ref.vehicles.Find(ctx, filter, options.Find().SetSort(sortByPrice))
+	} else {
+		cursor, err = ref.vehicles.Aggregate(ctx, searchBySalePipeline(filter, *vehicleFilter.IsSold))
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer cursor.Close(ctx)
+
+	vehicles := make([]entity.Vehicle, 0)
+
+	for cursor.Next(ctx) {
+		var document vehicleDocument
+		if err = cursor.Decode(&document); err != nil {
+			return nil, err
+		}
+
+		vehicles = append(vehicles, *document.toDomain())
+	}
+
+	if err = cursor.Err(); err != nil {
+		return nil, err
+	}
+
+	return vehicles, nil
+}
+
+// attributesFilter matches the attributes of filter that are set. Documents
+// stored before the attributes existed have no such fields, and are only
+// matched while the attribute is not filtered.
+func attributesFilter(filter entity.VehicleFilter) bson.M {
+	match := bson.M{}
+
+	for field, value := range map[string]string{
+		"status":       filter.Status.String(),
+		"fuel_type":    filter.FuelType.String(),
+		"transmission": filter.Transmission.String(),
+		"body_type":    filter.BodyType.String(),
+		"condition":    filter.Condition.String(),
+	} {
+		if value != "" {
+			match[field] = value
+		}
+	}
+
+	if filter.Doors != 0 {
+		match["doors"] = filter.Doors
+	}
+
+	if filter.MaxMileage != nil {
+		match["mileage"] = bson.M{"$lte": *filter.MaxMileage}
+	}
+
+	if filter.PublishedOnly {
+		match["$and"] = bson.A{
+			bson.M{"status": valueobjects.ListingStatusPublished.String()},
+			bson.M{"published_at": bson.M{"$lte": mongodb.Now()}},
+		}
+	}
+
+	return match
+}
+
+func searchBySalePipeline(filter bson.M, isSold bool) mongo.Pipeline {
+	approved := valueobjects.SaleStatusTypeApproved.String()
+
+	match := bson.M{
+		"sales": bson.M{"$elemMatch": bson.M{"status": approved, "sold_at": bson.M{"$ne": nil}}},
+	}
+
+	if !isSold {
+		match = bson.M{
+			"$or": bson.A{
+				bson.M{"sales": bson.M{"$size": 0}},
+				bson.M{"sales": bson.M{"$elemMatch": bson.M{"status": bson.M{"$ne": approved}, "sold_at": nil}}},
+			},
+		}
+	}
+
+	return mongo.Pipeline{
+		{{Key: "$match", Value: filter}},
+		{{Key: "$lookup", Value: bson.M{
+			"from":         mongodb.SalesCollection,
+			"localField":   "entity_id",
+			"foreignField": "entity_id",
+			"as":           "sales",
+		}}},
+		{{Key: "$match", Value: match}},
+		{{Key: "$project", Value: bson.M{"sales": 0}}},
+		{{Key: "$sort", Value: sortByPrice}},
+	}
+}
+
+// Update writes the fields of vehicle in a single atomic update, matching only
+// the version the caller read.
+func (ref *vehicleRepository) Update(ctx context.Context, id string, vehicle entity.Vehicle) (*entity.Vehicle, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"entity_id": id, "version": versionFilter(vehicle.Version)})
+	if err != nil {
+		return nil, err
+	}
+
+	set := bson.M{
+		"brand":               vehicle.Brand,
+		"model":               vehicle.Model,
+		"year":                vehicle.Year,
+		"color":               vehicle.Color,
+		"price":               model.RoundPrice(vehicle.Price),
+		"mileage":             vehicle.Mileage,
+		"fuel_type":           vehicle.FuelType.String(),
+		"transmission":        vehicle.Transmission.String(),
+		"body_type":           vehicle.BodyType.String(),
+		"condition":           vehicle.Condition.String(),
+		"doors":               vehicle.Doors,
+		"engine_displacement": vehicle.EngineDisplacement,
+		"license_plate":       vehicle.LicensePlate.String(),
+		"vin":                 vehicle.VIN.String(),
+		"slug":                vehicle.Slug.String(),
+		"status":              vehicle.Status.String(),
+		"published_at":        truncate(vehicle.PublishedAt),
+		"version":             vehicle.Version + 1,
+		"updated_at":          mongodb.Now(),
+	}
+
+	var document vehicleDocument
+
+	err = ref.vehicles.FindOneAndUpdate(
+		ctx,
+		filter,
+		bson.M{"$set": set},
+		options.FindOneAndUpdate().SetReturnDocument(options.After),
+	).Decode(&document)
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, mongodb.MapError(err)
+		}
+
+		current, err := ref.GetByID(ctx, id)
+		if err != nil || current == nil {
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("%w: vehicle %q is at version %d", domainerrors.ErrVersionMismatch, id, current.Version)
+	}
+
+	return document.toDomain(), nil
+}
+
+// versionFilter matches documents stored before vehicles were versioned as
+// version 1, which is what toDomain reports for them.
+func versionFilter(version int) any {
+	if version == 1 {
+		return bson.M{"$in": bson.A{1, nil}}
+	}
+
+	return version
+}
+
+// Delete stands in for the foreign keys of the Postgres schema: a vehicle
+// with a sale is kept, and the media of a deleted vehicle go with it.
+func (ref *vehicleRepository) Delete(ctx context.Context, id string) (*entity.Vehicle, error) {
+	filter, err := mongodb.ScopeFilter(ctx, bson.M{"entity_id": id})
+	if err != nil {
+		return nil, err
+	}
+
+	count, err := ref.vehicles.CountDocuments(ctx, filter, options.Count().SetLimit(1))
+	if err != nil || count == 0 {
+		return nil, err
+	}
+
+	sales, err := ref.database.Collection(mongodb.SalesCollection).CountDocuments(ctx, bson.M{"entity_id": id}, options.Count().SetLimit(1))
+	if err != nil {
+		return nil, err
+	}
+
+	if sales > 0 {
+		return nil, fmt.Errorf("%w: vehicle %q has a sale", domainerrors.ErrInUse, id)
+	}
+
+	var document vehicleDocument
+
+	err = ref.vehicles.FindOneAndDelete(ctx, filter).Decode(&document)
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if _, err = ref.database.Collection(mongodb.VehicleMediaCollection).DeleteMany(ctx, bson.M{"vehicle_id": id}); err != nil {
+		return nil, err
+	}
+
+	if _, err = ref.database.Collection(mongodb.OffersCollection).DeleteMany(ctx, bson.M{"vehicle_id": id}); err != nil {
+		return nil, err
+	}
+
+	return document.toDomain(), nil
+}
+
+// BackfillListingStatus publishes the vehicles stored before the listing
+// workflow from their creation, as the migration adding the status column
+// does for Postgres.
+func BackfillListingStatus(ctx context.Context, database *mongo.Database) error {
+	_, err := database.Collection(mongodb.VehiclesCollection).UpdateMany(ctx,
+		bson.M{"status": bson.M{"$exists": false}},
+		mongo.Pipeline{{{Key: "$set", Value: bson.M{
+			"status":       valueobjects.ListingStatusPublished.String(),
+			"published_at": "$created_at",
+		}}}},
+	)
+
+	return err
+}
+
+func truncate(date *time.Time) *time.Time {
+	if date == nil {
+		return nil
+	}
+
+	truncated := date.UTC().Truncate(time.Millisecond)
+	return &truncated
+}
+
+// BackfillSlugs gives a slug to the vehicles stored before them, as the
+// migration adding the slug column does for Postgres.
+func BackfillSlugs(ctx context.Context, database *mongo.Database) error {
+	vehicles := database.Collection(mongodb.VehiclesCollection)
+
+	cursor, err := vehicles.Find(ctx, bson.M{"slug": bson.M{"$in": bson.A{nil, ""}}})
+	if err != nil {
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	for cursor.Next(ctx) {
+		var document vehicleDocument
+		if err = cursor.Decode(&document); err != nil {
+			return err
+		}
+
+		slug := valueobjects.NewVehicleSlug(document.Year, document.Brand, document.Model, document.Color, document.EntityID)
+
+		if _, err = vehicles.UpdateByID(ctx, document.ID, bson.M{"$set": bson.M{"slug": slug.String()}}); err != nil {
+			return mongodb.MapError(err)
+		}
+	}
+
+	return cursor.Err()
+}
@@ -0,0 +1,128 @@
+package apikeyrepository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/database"
+	"github.com/caiiomp/vehicle-platform-sales/src/tracing"
+)
+
+type apiKeyRepository struct {
+	db *sql.DB
+}
+
+func NewAPIKeyRepository(db *sql.DB) interfaces.APIKeyRepository {
+	return &apiKeyRepository{
+		db: db,
+	}
+}
+
+func (ref *apiKeyRepository) Create(ctx context.Context, apiKey entity.APIKey) (_ *entity.APIKey, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "apiKeyRepository.Create", insertAPIKey)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if apiKey.DealerID, err = scope.Resolve(apiKey.DealerID); err != nil {
+		return nil, err
+	}
+
+	record := model.APIKeyFromDomain(apiKey)
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, insertAPIKey, record.DealerID, record.Name, record.Prefix, record.KeyHash, record.Roles)
+
+	var created model.APIKey
+	if err = scanAPIKey(row, &created); err != nil {
+		return nil, database.MapError(err)
+	}
+
+	return created.ToDomain(), nil
+}
+
+// GetByHash is the one query not restricted to a tenant scope, since it runs
+// to authenticate the caller the scope is resolved from.
+func (ref *apiKeyRepository) GetByHash(ctx context.Context, keyHash string) (_ *entity.APIKey, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "apiKeyRepository.GetByHash", getAPIKeyByHash)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, getAPIKeyByHash, keyHash)
+
+	var apiKey model.APIKey
+	if err = scanAPIKey(row, &apiKey); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return apiKey.ToDomain(), nil
+}
+
+func (ref *apiKeyRepository) Search(ctx context.Context) (_ []entity.APIKey, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "apiKeyRepository.Search", searchAllAPIKeys)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.GetExecutor(ctx, ref.db).QueryContext(ctx, searchAllAPIKeys, scope.DealerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	apiKeys := make([]entity.APIKey, 0)
+
+	for rows.Next() {
+		var record model.APIKey
+		if err = scanAPIKey(rows, &record); err != nil {
+			return nil, err
+		}
+
+		apiKeys = append(apiKeys, *record.ToDomain())
+	}
+
+	return apiKeys, nil
+}
+
+// Revoke keeps the first revocation time when a key is revoked twice.
+func (ref *apiKeyRepository) Revoke(ctx context.Context, id int, revokedAt time.Time) (_ *entity.APIKey, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "apiKeyRepository.Revoke", revokeAPIKey)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, revokeAPIKey, id, revokedAt, scope.DealerID)
+
+	var apiKey model.APIKey
+	if err = scanAPIKey(row, &apiKey); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return apiKey.ToDomain(), nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanAPIKey(row scanner, apiKey *model.APIKey) error {
+	return row.Scan(&apiKey.ID, &apiKey.Name, &apiKey.Prefix, &apiKey.KeyHash, &apiKey.Roles, &apiKey.CreatedAt, &apiKey.RevokedAt, &apiKey.DealerID)
+}
@@ -0,0 +1,26 @@
+package apikeyrepository
+
+const (
+	insertAPIKey = `
+		INSERT INTO api_keys (
+			dealer_id,
+			name,
+			prefix,
+			key_hash,
+			roles
+		)
+		VALUES ($1, $2, $3, $4, $5)
+		RETURNING *;
+	`
+
+	getAPIKeyByHash = "SELECT * FROM api_keys WHERE key_hash = $1;"
+
+	searchAllAPIKeys = "SELECT * FROM api_keys WHERE ($1 = 0 OR dealer_id = $1) ORDER BY id;"
+
+	revokeAPIKey = `
+		UPDATE api_keys SET
+			revoked_at = COALESCE(revoked_at, $2)
+		WHERE id = $1 AND ($3 = 0 OR dealer_id = $3)
+		RETURNING *;
+	`
+)
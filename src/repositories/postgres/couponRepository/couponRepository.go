@@ -0,0 +1,176 @@
+package couponrepository
+
+import (
+	"context"
+	"database/sql"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/database"
+	"github.com/caiiomp/vehicle-platform-sales/src/tracing"
+)
+
+type couponRepository struct {
+	db *sql.DB
+}
+
+func NewCouponRepository(db *sql.DB) interfaces.CouponRepository {
+	return &couponRepository{
+		db: db,
+	}
+}
+
+func (ref *couponRepository) Create(ctx context.Context, coupon entity.Coupon) (_ *entity.Coupon, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "couponRepository.Create", insertCoupon)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record := model.CouponFromDomain(coupon)
+
+	if record.DealerID, err = scope.Resolve(record.DealerID); err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, insertCoupon,
+		record.DealerID, record.Code, record.DiscountType, record.DiscountValue, record.MaxUses,
+		record.ValidFrom, record.ValidUntil, record.Brand, record.Model, record.MinYear, record.MaxYear,
+	)
+
+	var created model.Coupon
+	if err = scanCoupon(row, &created); err != nil {
+		return nil, database.MapError(err)
+	}
+
+	return created.ToDomain(), nil
+}
+
+func (ref *couponRepository) GetByID(ctx context.Context, id int) (_ *entity.Coupon, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "couponRepository.GetByID", getCouponByID)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, getCouponByID, id, scope.DealerID)
+
+	return getCoupon(row)
+}
+
+func (ref *couponRepository) GetByCode(ctx context.Context, dealerID int, code valueobjects.CouponCode) (_ *entity.Coupon, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "couponRepository.GetByCode", getCouponByCode)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, getCouponByCode, dealerID, code.String(), scope.DealerID)
+
+	return getCoupon(row)
+}
+
+func (ref *couponRepository) Search(ctx context.Context) (_ []entity.Coupon, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "couponRepository.Search", searchCoupons)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.GetExecutor(ctx, ref.db).QueryContext(ctx, searchCoupons, scope.DealerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	coupons := make([]entity.Coupon, 0)
+
+	for rows.Next() {
+		var coupon model.Coupon
+		if err = scanCoupon(rows, &coupon); err != nil {
+			return nil, err
+		}
+
+		coupons = append(coupons, *coupon.ToDomain())
+	}
+
+	return coupons, rows.Err()
+}
+
+// Redeem increments the uses in the same statement that checks them, so the
+// row lock taken by the UPDATE serializes concurrent redemptions.
+func (ref *couponRepository) Redeem(ctx context.Context, id int) (_ *entity.Coupon, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "couponRepository.Redeem", redeemCoupon)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, redeemCoupon, id, scope.DealerID)
+
+	return getCoupon(row)
+}
+
+func (ref *couponRepository) Release(ctx context.Context, id int) (_ *entity.Coupon, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "couponRepository.Release", releaseCoupon)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, releaseCoupon, id, scope.DealerID)
+
+	return getCoupon(row)
+}
+
+func (ref *couponRepository) Delete(ctx context.Context, id int) (_ *entity.Coupon, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "couponRepository.Delete", deleteCoupon)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, deleteCoupon, id, scope.DealerID)
+
+	return getCoupon(row)
+}
+
+// getCoupon reads the row of a single coupon query, nil when there is none.
+func getCoupon(row *sql.Row) (*entity.Coupon, error) {
+	var coupon model.Coupon
+	if err := scanCoupon(row, &coupon); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return coupon.ToDomain(), nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanCoupon(row scanner, coupon *model.Coupon) error {
+	return row.Scan(&coupon.ID, &coupon.DealerID, &coupon.Code, &coupon.DiscountType, &coupon.DiscountValue, &coupon.MaxUses, &coupon.Uses,
+		&coupon.ValidFrom, &coupon.ValidUntil, &coupon.Brand, &coupon.Model, &coupon.MinYear, &coupon.MaxYear, &coupon.CreatedAt, &coupon.UpdatedAt,
+	)
+}
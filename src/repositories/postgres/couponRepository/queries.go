@@ -0,0 +1,36 @@
+package couponrepository
+
+// Every query takes the dealer of the tenant scope as its last parameter, zero
+// reaching every dealer.
+const (
+	insertCoupon = `
+		INSERT INTO coupons (
+			dealer_id, code, discount_type, discount_value, max_uses,
+			valid_from, valid_until, brand, model, min_year, max_year
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING *;
+	`
+
+	getCouponByID = "SELECT * FROM coupons WHERE id = $1 AND ($2 = 0 OR dealer_id = $2);"
+
+	getCouponByCode = "SELECT * FROM coupons WHERE dealer_id = $1 AND code = $2 AND ($3 = 0 OR dealer_id = $3);"
+
+	searchCoupons = "SELECT * FROM coupons WHERE ($1 = 0 OR dealer_id = $1) ORDER BY id;"
+
+	redeemCoupon = `
+		UPDATE coupons
+		SET uses = uses + 1
+		WHERE id = $1 AND (max_uses = 0 OR uses < max_uses) AND ($2 = 0 OR dealer_id = $2)
+		RETURNING *;
+	`
+
+	releaseCoupon = `
+		UPDATE coupons
+		SET uses = uses - 1
+		WHERE id = $1 AND uses > 0 AND ($2 = 0 OR dealer_id = $2)
+		RETURNING *;
+	`
+
+	deleteCoupon = "DELETE FROM coupons WHERE id = $1 AND ($2 = 0 OR dealer_id = $2) RETURNING *;"
+)
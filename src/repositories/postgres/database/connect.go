@@ -0,0 +1,93 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"net"
+	"strconv"
+
+	"cloud.google.com/go/cloudsqlconn"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/config"
+)
+
+// Open returns a database/sql pool backed by pgx for every environment. In
+// PROD connections are dialed through the Cloud SQL connector.
+func Open(ctx context.Context, environment string, databaseConfig config.Database) (*sql.DB, error) {
+	connConfig, err := ConnConfig(ctx, environment, databaseConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	db := stdlib.OpenDB(*connConfig)
+
+	db.SetMaxOpenConns(databaseConfig.MaxOpenConns)
+	db.SetMaxIdleConns(databaseConfig.MaxIdleConns)
+	db.SetConnMaxLifetime(databaseConfig.ConnMaxLifetime)
+	db.SetConnMaxIdleTime(databaseConfig.ConnMaxIdleTime)
+
+	return db, nil
+}
+
+// ConnConfig builds the pgx connection settings. Fields are set one by one
+// rather than through a DSN, so credentials need no escaping.
+func ConnConfig(ctx context.Context, environment string, databaseConfig config.Database) (*pgx.ConnConfig, error) {
+	var (
+		connConfig *pgx.ConnConfig
+		err        error
+	)
+
+	switch environment {
+	case config.EnvironmentProd:
+		connConfig, err = pgx.ParseConfig("")
+		if err != nil {
+			return nil, err
+		}
+
+		dialer, err := cloudsqlconn.NewDialer(ctx, cloudsqlconn.WithLazyRefresh())
+		if err != nil {
+			return nil, err
+		}
+
+		connConfig.DialFunc = func(ctx context.Context, network, instance string) (net.Conn, error) {
+			return dialer.Dial(ctx, databaseConfig.InstanceConnectionName)
+		}
+
+	default:
+		connConfig, err = pgx.ParseConfig("sslmode=disable")
+		if err != nil {
+			return nil, err
+		}
+
+		port, err := strconv.ParseUint(databaseConfig.Port, 10, 16)
+		if err != nil {
+			return nil, fmt.Errorf("invalid database port %q: %w", databaseConfig.Port, err)
+		}
+
+		connConfig.Host = databaseConfig.Host
+		connConfig.Port = uint16(port)
+	}
+
+	connConfig.User = databaseConfig.User
+	connConfig.Password = databaseConfig.Password
+	connConfig.Database = databaseConfig.Name
+	connConfig.ConnectTimeout = databaseConfig.ConnectTimeout
+
+	// Every query is prepared once per connection and reused from the cache.
+	// A zero capacity disables prepared statements, which poolers running in
+	// transaction mode require.
+	connConfig.StatementCacheCapacity = databaseConfig.StatementCacheCapacity
+	connConfig.DefaultQueryExecMode = pgx.QueryExecModeCacheStatement
+	if databaseConfig.StatementCacheCapacity == 0 {
+		connConfig.DefaultQueryExecMode = pgx.QueryExecModeExec
+	}
+
+	if databaseConfig.StatementTimeout > 0 {
+		connConfig.RuntimeParams["statement_timeout"] = strconv.FormatInt(databaseConfig.StatementTimeout.Milliseconds(), 10)
+	}
+
+	return connConfig, nil
+}
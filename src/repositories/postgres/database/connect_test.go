@@ -0,0 +1,71 @@
+package database
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/config"
+)
+
+func TestConnConfig(t *testing.T) {
+	ctx := context.TODO()
+
+	databaseConfig := config.Database{
+		Host:                   "postgres",
+		Port:                   "5433",
+		User:                   "docker",
+		Password:               "p@ss word'",
+		Name:                   "vehicle-platform-sales",
+		ConnectTimeout:         3 * time.Second,
+		StatementCacheCapacity: 128,
+	}
+
+	t.Run("should build local connection settings", func(t *testing.T) {
+		actual, err := ConnConfig(ctx, config.EnvironmentLocal, databaseConfig)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "postgres", actual.Host)
+		assert.Equal(t, uint16(5433), actual.Port)
+		assert.Equal(t, "p@ss word'", actual.Password)
+		assert.Equal(t, "vehicle-platform-sales", actual.Database)
+		assert.Nil(t, actual.TLSConfig)
+		assert.Equal(t, 3*time.Second, actual.ConnectTimeout)
+		assert.Equal(t, 128, actual.StatementCacheCapacity)
+		assert.Equal(t, pgx.QueryExecModeCacheStatement, actual.DefaultQueryExecMode)
+		assert.NotContains(t, actual.RuntimeParams, "statement_timeout")
+	})
+
+	t.Run("should set statement timeout in milliseconds", func(t *testing.T) {
+		withTimeout := databaseConfig
+		withTimeout.StatementTimeout = 2500 * time.Millisecond
+
+		actual, err := ConnConfig(ctx, config.EnvironmentLocal, withTimeout)
+
+		assert.Nil(t, err)
+		assert.Equal(t, "2500", actual.RuntimeParams["statement_timeout"])
+	})
+
+	t.Run("should not prepare statements when cache is disabled", func(t *testing.T) {
+		withoutCache := databaseConfig
+		withoutCache.StatementCacheCapacity = 0
+
+		actual, err := ConnConfig(ctx, config.EnvironmentLocal, withoutCache)
+
+		assert.Nil(t, err)
+		assert.Equal(t, pgx.QueryExecModeExec, actual.DefaultQueryExecMode)
+	})
+
+	t.Run("should not build connection settings with invalid port", func(t *testing.T) {
+		invalidPort := databaseConfig
+		invalidPort.Port = "postgres"
+
+		actual, err := ConnConfig(ctx, config.EnvironmentLocal, invalidPort)
+
+		assert.Nil(t, actual)
+		assert.ErrorContains(t, err, "invalid database port")
+	})
+}
@@ -0,0 +1,45 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/jackc/pgx/v5/pgconn"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+)
+
+// SQLSTATE codes from https://www.postgresql.org/docs/current/errcodes-appendix.html
+const (
+	foreignKeyViolation = "23503"
+	uniqueViolation     = "23505"
+)
+
+// MapError turns constraint violations into domain errors and returns any
+// other error untouched.
+func MapError(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) {
+		return err
+	}
+
+	switch pgErr.Code {
+	case uniqueViolation:
+		return fmt.Errorf("%w: %s", domainerrors.ErrAlreadyExists, pgErr.Detail)
+	case foreignKeyViolation:
+		return fmt.Errorf("%w: %s", domainerrors.ErrReferenceNotFound, pgErr.Detail)
+	default:
+		return err
+	}
+}
+
+// MapDeleteError is MapError for deletes, where a foreign key violation means
+// the row is still referenced by another one.
+func MapDeleteError(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == foreignKeyViolation {
+		return fmt.Errorf("%w: %s", domainerrors.ErrInUse, pgErr.Detail)
+	}
+
+	return MapError(err)
+}
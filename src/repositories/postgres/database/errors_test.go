@@ -0,0 +1,56 @@
+package database
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/stretchr/testify/assert"
+
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
+)
+
+func TestMapError(t *testing.T) {
+	t.Run("should map unique violation to already exists", func(t *testing.T) {
+		err := &pgconn.PgError{Code: "23505", Detail: "Key (entity_id)=(abc) already exists."}
+
+		actual := MapError(fmt.Errorf("insert: %w", err))
+
+		assert.ErrorIs(t, actual, domainerrors.ErrAlreadyExists)
+		assert.ErrorContains(t, actual, "Key (entity_id)=(abc) already exists.")
+	})
+
+	t.Run("should map foreign key violation to reference not found", func(t *testing.T) {
+		err := &pgconn.PgError{Code: "23503"}
+
+		assert.ErrorIs(t, MapError(err), domainerrors.ErrReferenceNotFound)
+	})
+
+	t.Run("should keep other errors untouched", func(t *testing.T) {
+		pgErr := &pgconn.PgError{Code: "57014"}
+		unexpectedError := errors.New("unexpected error")
+
+		assert.Equal(t, pgErr, MapError(pgErr))
+		assert.Equal(t, unexpectedError, MapError(unexpectedError))
+		assert.Nil(t, MapError(nil))
+	})
+}
+
+func TestMapDeleteError(t *testing.T) {
+	t.Run("should map foreign key violation to in use", func(t *testing.T) {
+		err := &pgconn.PgError{Code: "23503", Detail: `Key (entity_id, dealer_id)=(abc, 1) is still referenced from table "sales".`}
+
+		actual := MapDeleteError(err)
+
+		assert.ErrorIs(t, actual, domainerrors.ErrInUse)
+		assert.ErrorContains(t, actual, "is still referenced")
+	})
+
+	t.Run("should map other errors like MapError", func(t *testing.T) {
+		unexpectedError := errors.New("unexpected error")
+
+		assert.ErrorIs(t, MapDeleteError(&pgconn.PgError{Code: "23505"}), domainerrors.ErrAlreadyExists)
+		assert.Equal(t, unexpectedError, MapDeleteError(unexpectedError))
+	})
+}
@@ -0,0 +1,29 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+)
+
+// Executor is implemented by both *sql.DB and *sql.Tx, so the repositories can
+// run the same statements inside or outside of a transaction.
+type Executor interface {
+	ExecContext(ctx context.Context, query string, args ...any) (sql.Result, error)
+	QueryContext(ctx context.Context, query string, args ...any) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+// GetExecutor returns the transaction started by the TxManager for ctx, or db
+// when there is none.
+func GetExecutor(ctx context.Context, db *sql.DB) Executor {
+	if state := txFromContext(ctx); state != nil {
+		return state.tx
+	}
+
+	return db
+}
+
+// InTransaction reports whether ctx carries an active transaction.
+func InTransaction(ctx context.Context) bool {
+	return txFromContext(ctx) != nil
+}
@@ -0,0 +1,111 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+)
+
+var (
+	ErrNoTransaction        = errors.New("no transaction in context")
+	ErrTransactionCancelled = errors.New("transaction was rolled back by a nested unit of work")
+)
+
+type txKey struct{}
+
+type txState struct {
+	tx *sql.Tx
+
+	// rollbackOnly is set when a nested unit of work rolls back, so the
+	// outermost one can not commit a partial result.
+	rollbackOnly bool
+}
+
+// txHandle is what Begin stores in the context. Only the handle that opened
+// the transaction commits or rolls it back; nested ones join it.
+type txHandle struct {
+	state  *txState
+	nested bool
+	done   bool
+}
+
+type txManager struct {
+	db *sql.DB
+}
+
+func NewTxManager(db *sql.DB) interfaces.TxManager {
+	return &txManager{
+		db: db,
+	}
+}
+
+func (ref *txManager) Begin(ctx context.Context) (context.Context, error) {
+	if state := txFromContext(ctx); state != nil {
+		return context.WithValue(ctx, txKey{}, &txHandle{state: state, nested: true}), nil
+	}
+
+	tx, err := ref.db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return context.WithValue(ctx, txKey{}, &txHandle{state: &txState{tx: tx}}), nil
+}
+
+func (ref *txManager) Commit(ctx context.Context) error {
+	handle, ok := ctx.Value(txKey{}).(*txHandle)
+	if !ok {
+		return ErrNoTransaction
+	}
+
+	if handle.done {
+		return sql.ErrTxDone
+	}
+	handle.done = true
+
+	if handle.nested {
+		return nil
+	}
+
+	if handle.state.rollbackOnly {
+		handle.state.tx.Rollback()
+		return ErrTransactionCancelled
+	}
+
+	return handle.state.tx.Commit()
+}
+
+// Rollback is safe to call after Commit, which makes it usable in a defer.
+func (ref *txManager) Rollback(ctx context.Context) error {
+	handle, ok := ctx.Value(txKey{}).(*txHandle)
+	if !ok {
+		return ErrNoTransaction
+	}
+
+	if handle.done {
+		return nil
+	}
+	handle.done = true
+
+	if handle.nested {
+		handle.state.rollbackOnly = true
+		return nil
+	}
+
+	if err := handle.state.tx.Rollback(); err != nil && !errors.Is(err, sql.ErrTxDone) {
+		return err
+	}
+
+	return nil
+}
+
+func txFromContext(ctx context.Context) *txState {
+	handle, ok := ctx.Value(txKey{}).(*txHandle)
+	if !ok {
+		return nil
+	}
+
+	return handle.state
+}
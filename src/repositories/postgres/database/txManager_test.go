@@ -0,0 +1,125 @@
+package database
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"sync/atomic"
+	"testing"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/stretchr/testify/assert"
+)
+
+// fakeDriver only counts transactions, which is all the TxManager needs.
+type fakeDriver struct {
+	begun, committed, rolledBack atomic.Int32
+}
+
+func (ref *fakeDriver) Open(string) (driver.Conn, error) { return &fakeConn{driver: ref}, nil }
+
+type fakeConn struct{ driver *fakeDriver }
+
+func (ref *fakeConn) Prepare(string) (driver.Stmt, error) { return nil, errors.New("not supported") }
+func (ref *fakeConn) Close() error                        { return nil }
+func (ref *fakeConn) Begin() (driver.Tx, error) {
+	ref.driver.begun.Add(1)
+	return &fakeTx{driver: ref.driver}, nil
+}
+
+type fakeTx struct{ driver *fakeDriver }
+
+func (ref *fakeTx) Commit() error   { ref.driver.committed.Add(1); return nil }
+func (ref *fakeTx) Rollback() error { ref.driver.rolledBack.Add(1); return nil }
+
+func newFakeDB(t *testing.T) (*sql.DB, *fakeDriver) {
+	fake := &fakeDriver{}
+	db := sql.OpenDB(connector{fake})
+	t.Cleanup(func() { db.Close() })
+	return db, fake
+}
+
+type connector struct{ driver *fakeDriver }
+
+func (ref connector) Connect(context.Context) (driver.Conn, error) { return ref.driver.Open("") }
+func (ref connector) Driver() driver.Driver                        { return ref.driver }
+
+func TestTxManager(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("should use the database when there is no transaction", func(t *testing.T) {
+		db, _ := newFakeDB(t)
+
+		assert.False(t, InTransaction(ctx))
+		assert.Equal(t, db, GetExecutor(ctx, db))
+	})
+
+	t.Run("should commit transaction and ignore the rollback after it", func(t *testing.T) {
+		db, fake := newFakeDB(t)
+		txManager := NewTxManager(db)
+
+		txCtx, err := txManager.Begin(ctx)
+		assert.Nil(t, err)
+		assert.True(t, InTransaction(txCtx))
+		assert.IsType(t, &sql.Tx{}, GetExecutor(txCtx, db))
+
+		assert.Nil(t, txManager.Commit(txCtx))
+		assert.Nil(t, txManager.Rollback(txCtx))
+		assert.Equal(t, int32(1), fake.committed.Load())
+		assert.Equal(t, int32(0), fake.rolledBack.Load())
+	})
+
+	t.Run("should join the outer transaction when nested", func(t *testing.T) {
+		db, fake := newFakeDB(t)
+		txManager := NewTxManager(db)
+
+		outerCtx, _ := txManager.Begin(ctx)
+		innerCtx, err := txManager.Begin(outerCtx)
+		assert.Nil(t, err)
+		assert.Equal(t, GetExecutor(outerCtx, db), GetExecutor(innerCtx, db))
+
+		assert.Nil(t, txManager.Commit(innerCtx))
+		assert.Equal(t, int32(0), fake.committed.Load())
+
+		assert.Nil(t, txManager.Commit(outerCtx))
+		assert.Equal(t, int32(1), fake.begun.Load())
+		assert.Equal(t, int32(1), fake.committed.Load())
+	})
+
+	t.Run("should not commit when a nested unit of work rolled back", func(t *testing.T) {
+		db, fake := newFakeDB(t)
+		txManager := NewTxManager(db)
+
+		outerCtx, _ := txManager.Begin(ctx)
+		innerCtx, _ := txManager.Begin(outerCtx)
+
+		assert.Nil(t, txManager.Rollback(innerCtx))
+
+		err := txManager.Commit(outerCtx)
+		assert.ErrorIs(t, err, ErrTransactionCancelled)
+		assert.Equal(t, int32(0), fake.committed.Load())
+		assert.Equal(t, int32(1), fake.rolledBack.Load())
+	})
+
+	t.Run("should return error when there is no transaction", func(t *testing.T) {
+		db, _ := newFakeDB(t)
+		txManager := NewTxManager(db)
+
+		assert.ErrorIs(t, txManager.Commit(ctx), ErrNoTransaction)
+		assert.ErrorIs(t, txManager.Rollback(ctx), ErrNoTransaction)
+	})
+
+	t.Run("should roll back when function fails", func(t *testing.T) {
+		db, fake := newFakeDB(t)
+		unexpectedError := errors.New("unexpected error")
+
+		err := interfaces.WithinTx(ctx, NewTxManager(db), func(ctx context.Context) error {
+			return unexpectedError
+		})
+
+		assert.Equal(t, unexpectedError, err)
+		assert.Equal(t, int32(0), fake.committed.Load())
+		assert.Equal(t, int32(1), fake.rolledBack.Load())
+	})
+}
@@ -0,0 +1,104 @@
+package dealerrepository
+
+import (
+	"context"
+	"database/sql"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/database"
+	"github.com/caiiomp/vehicle-platform-sales/src/tracing"
+)
+
+type dealerRepository struct {
+	db *sql.DB
+}
+
+func NewDealerRepository(db *sql.DB) interfaces.DealerRepository {
+	return &dealerRepository{
+		db: db,
+	}
+}
+
+// Create only runs in a scope reaching every dealer, as a dealer can not
+// create another.
+func (ref *dealerRepository) Create(ctx context.Context, dealer entity.Dealer) (_ *entity.Dealer, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "dealerRepository.Create", insertDealer)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	if err = tenant.RequireAllDealers(ctx); err != nil {
+		return nil, err
+	}
+
+	record := model.DealerFromDomain(dealer)
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, insertDealer, record.Name)
+
+	var created model.Dealer
+	if err = scanDealer(row, &created); err != nil {
+		return nil, database.MapError(err)
+	}
+
+	return created.ToDomain(), nil
+}
+
+func (ref *dealerRepository) GetByID(ctx context.Context, id int) (_ *entity.Dealer, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "dealerRepository.GetByID", getDealerByID)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, getDealerByID, id, scope.DealerID)
+
+	var dealer model.Dealer
+	if err = scanDealer(row, &dealer); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return dealer.ToDomain(), nil
+}
+
+func (ref *dealerRepository) Search(ctx context.Context) (_ []entity.Dealer, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "dealerRepository.Search", searchAllDealers)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.GetExecutor(ctx, ref.db).QueryContext(ctx, searchAllDealers, scope.DealerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	dealers := make([]entity.Dealer, 0)
+
+	for rows.Next() {
+		var record model.Dealer
+		if err = scanDealer(rows, &record); err != nil {
+			return nil, err
+		}
+
+		dealers = append(dealers, *record.ToDomain())
+	}
+
+	return dealers, nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanDealer(row scanner, dealer *model.Dealer) error {
+	return row.Scan(&dealer.ID, &dealer.Name, &dealer.CreatedAt)
+}
@@ -0,0 +1,9 @@
+package dealerrepository
+
+const (
+	insertDealer = "INSERT INTO dealers (name) VALUES ($1) RETURNING *;"
+
+	getDealerByID = "SELECT * FROM dealers WHERE id = $1 AND ($2 = 0 OR id = $2);"
+
+	searchAllDealers = "SELECT * FROM dealers WHERE ($1 = 0 OR id = $1) ORDER BY id;"
+)
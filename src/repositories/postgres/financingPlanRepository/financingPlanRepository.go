@@ -0,0 +1,131 @@
+package financingplanrepository
+
+import (
+	"context"
+	"database/sql"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/database"
+	"github.com/caiiomp/vehicle-platform-sales/src/tracing"
+)
+
+type financingPlanRepository struct {
+	db *sql.DB
+}
+
+func NewFinancingPlanRepository(db *sql.DB) interfaces.FinancingPlanRepository {
+	return &financingPlanRepository{
+		db: db,
+	}
+}
+
+func (ref *financingPlanRepository) Create(ctx context.Context, plan entity.FinancingPlan) (_ *entity.FinancingPlan, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "financingPlanRepository.Create", insertFinancingPlan)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record := model.FinancingPlanFromDomain(plan)
+
+	if record.DealerID, err = scope.Resolve(record.DealerID); err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, insertFinancingPlan,
+		record.DealerID, record.Name, record.AmortizationSystem, record.MonthlyInterestRate, record.MaxMonths, record.MinDownPaymentPercentage,
+	)
+
+	var created model.FinancingPlan
+	if err = scanFinancingPlan(row, &created); err != nil {
+		return nil, database.MapError(err)
+	}
+
+	return created.ToDomain(), nil
+}
+
+func (ref *financingPlanRepository) GetByID(ctx context.Context, id int) (_ *entity.FinancingPlan, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "financingPlanRepository.GetByID", getFinancingPlanByID)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, getFinancingPlanByID, id, scope.DealerID)
+
+	return getFinancingPlan(row)
+}
+
+func (ref *financingPlanRepository) Search(ctx context.Context) (_ []entity.FinancingPlan, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "financingPlanRepository.Search", searchFinancingPlans)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.GetExecutor(ctx, ref.db).QueryContext(ctx, searchFinancingPlans, scope.DealerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	plans := make([]entity.FinancingPlan, 0)
+
+	for rows.Next() {
+		var plan model.FinancingPlan
+		if err = scanFinancingPlan(rows, &plan); err != nil {
+			return nil, err
+		}
+
+		plans = append(plans, *plan.ToDomain())
+	}
+
+	return plans, rows.Err()
+}
+
+func (ref *financingPlanRepository) Delete(ctx context.Context, id int) (_ *entity.FinancingPlan, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "financingPlanRepository.Delete", deleteFinancingPlan)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, deleteFinancingPlan, id, scope.DealerID)
+
+	return getFinancingPlan(row)
+}
+
+// getFinancingPlan reads the row of a single financing plan query, nil when
+// there is none.
+func getFinancingPlan(row *sql.Row) (*entity.FinancingPlan, error) {
+	var plan model.FinancingPlan
+	if err := scanFinancingPlan(row, &plan); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return plan.ToDomain(), nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanFinancingPlan(row scanner, plan *model.FinancingPlan) error {
+	return row.Scan(&plan.ID, &plan.DealerID, &plan.Name, &plan.AmortizationSystem, &plan.MonthlyInterestRate, &plan.MaxMonths,
+		&plan.MinDownPaymentPercentage, &plan.CreatedAt, &plan.UpdatedAt,
+	)
+}
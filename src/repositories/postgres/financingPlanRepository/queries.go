@@ -0,0 +1,19 @@
+package financingplanrepository
+
+// Every query takes the dealer of the tenant scope as its last parameter, zero
+// reaching every dealer.
+const (
+	insertFinancingPlan = `
+		INSERT INTO financing_plans (
+			dealer_id, name, amortization_system, monthly_interest_rate, max_months, min_down_payment_percentage
+		)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING *;
+	`
+
+	getFinancingPlanByID = "SELECT * FROM financing_plans WHERE id = $1 AND ($2 = 0 OR dealer_id = $2);"
+
+	searchFinancingPlans = "SELECT * FROM financing_plans WHERE ($1 = 0 OR dealer_id = $1) ORDER BY id;"
+
+	deleteFinancingPlan = "DELETE FROM financing_plans WHERE id = $1 AND ($2 = 0 OR dealer_id = $2) RETURNING *;"
+)
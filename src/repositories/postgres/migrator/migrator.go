@@ -0,0 +1,339 @@
+package migrator
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// advisoryLockID serializes migrations between replicas starting at the same time.
+const advisoryLockID = 7_340_021_118
+
+var migrationFilePattern = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+var (
+	ErrDirtySchema  = errors.New("database schema is dirty, fix the failed migration and force the version manually")
+	ErrSchemaBehind = errors.New("database schema is behind the version expected by the application")
+	ErrNoMigrations = errors.New("no migrations found")
+	ErrNothingToDo  = errors.New("no migration to apply")
+)
+
+type Migration struct {
+	Version uint
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Version uint
+	Name    string
+	Applied bool
+}
+
+// Migrator applies the migrations using the same schema_migrations table as
+// the golang-migrate CLI, so both can be used against the same database.
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func NewMigrator(db *sql.DB, source fs.FS) (*Migrator, error) {
+	migrations, err := Load(source)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{
+		db:         db,
+		migrations: migrations,
+	}, nil
+}
+
+// Load reads every "<version>_<name>.(up|down).sql" file at the root of source
+// and returns the migrations sorted by version.
+func Load(source fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(source, ".")
+	if err != nil {
+		return nil, err
+	}
+
+	byVersion := make(map[uint]*Migration)
+
+	for _, entry := range entries {
+		if entry.IsDir() {
+			continue
+		}
+
+		matches := migrationFilePattern.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			continue
+		}
+
+		version, err := strconv.ParseUint(matches[1], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid migration version %q: %w", entry.Name(), err)
+		}
+
+		content, err := fs.ReadFile(source, path.Join(".", entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		migration, ok := byVersion[uint(version)]
+		if !ok {
+			migration = &Migration{Version: uint(version), Name: matches[2]}
+			byVersion[uint(version)] = migration
+		}
+
+		if migration.Name != matches[2] {
+			return nil, fmt.Errorf("migration %d has files with different names: %q and %q", version, migration.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			migration.Up = string(content)
+		} else {
+			migration.Down = string(content)
+		}
+	}
+
+	if len(byVersion) == 0 {
+		return nil, ErrNoMigrations
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+
+	return migrations, nil
+}
+
+// Latest returns the version the application expects the schema to be at.
+func (ref *Migrator) Latest() uint {
+	return ref.migrations[len(ref.migrations)-1].Version
+}
+
+// Version returns the current schema version. A version of zero means no
+// migration was applied yet.
+func (ref *Migrator) Version(ctx context.Context) (uint, bool, error) {
+	var exists bool
+	if err := ref.db.QueryRowContext(ctx, versionTableExists).Scan(&exists); err != nil {
+		return 0, false, err
+	}
+
+	if !exists {
+		return 0, false, nil
+	}
+
+	return currentVersion(ctx, ref.db)
+}
+
+func (ref *Migrator) Status(ctx context.Context) ([]MigrationStatus, error) {
+	version, _, err := ref.Version(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	status := make([]MigrationStatus, len(ref.migrations))
+
+	for i, migration := range ref.migrations {
+		status[i] = MigrationStatus{
+			Version: migration.Version,
+			Name:    migration.Name,
+			Applied: migration.Version <= version,
+		}
+	}
+
+	return status, nil
+}
+
+// Check fails when the schema is dirty or older than the latest embedded migration.
+func (ref *Migrator) Check(ctx context.Context) error {
+	version, dirty, err := ref.Version(ctx)
+	if err != nil {
+		return err
+	}
+
+	if dirty {
+		return fmt.Errorf("%w (version %d)", ErrDirtySchema, version)
+	}
+
+	if version < ref.Latest() {
+		return fmt.Errorf("%w (current %d, expected %d)", ErrSchemaBehind, version, ref.Latest())
+	}
+
+	return nil
+}
+
+// Up applies every pending migration.
+func (ref *Migrator) Up(ctx context.Context) error {
+	return ref.withLock(ctx, func(conn *sql.Conn) error {
+		version, dirty, err := currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		if dirty {
+			return fmt.Errorf("%w (version %d)", ErrDirtySchema, version)
+		}
+
+		var applied int
+
+		for _, migration := range ref.migrations {
+			if migration.Version <= version {
+				continue
+			}
+
+			if err := apply(ctx, conn, migration.Version, migration.Up); err != nil {
+				return fmt.Errorf("failed to apply migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			applied++
+		}
+
+		if applied == 0 {
+			return ErrNothingToDo
+		}
+
+		return nil
+	})
+}
+
+// Down rolls back the given number of applied migrations.
+func (ref *Migrator) Down(ctx context.Context, steps int) error {
+	return ref.withLock(ctx, func(conn *sql.Conn) error {
+		version, dirty, err := currentVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		if dirty {
+			return fmt.Errorf("%w (version %d)", ErrDirtySchema, version)
+		}
+
+		var rolledBack int
+
+		for i := len(ref.migrations) - 1; i >= 0 && rolledBack < steps; i-- {
+			migration := ref.migrations[i]
+			if migration.Version > version {
+				continue
+			}
+
+			var previous uint
+			if i > 0 {
+				previous = ref.migrations[i-1].Version
+			}
+
+			if err := apply(ctx, conn, previous, migration.Down); err != nil {
+				return fmt.Errorf("failed to roll back migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+
+			rolledBack++
+		}
+
+		if rolledBack == 0 {
+			return ErrNothingToDo
+		}
+
+		return nil
+	})
+}
+
+func (ref *Migrator) withLock(ctx context.Context, fn func(conn *sql.Conn) error) error {
+	conn, err := ref.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err = conn.ExecContext(ctx, acquireLock, advisoryLockID); err != nil {
+		return err
+	}
+	defer conn.ExecContext(context.WithoutCancel(ctx), releaseLock, advisoryLockID)
+
+	if _, err = conn.ExecContext(ctx, createVersionTable); err != nil {
+		return err
+	}
+
+	return fn(conn)
+}
+
+type queryer interface {
+	QueryRowContext(ctx context.Context, query string, args ...any) *sql.Row
+}
+
+func currentVersion(ctx context.Context, db queryer) (uint, bool, error) {
+	var (
+		version int64
+		dirty   bool
+	)
+
+	err := db.QueryRowContext(ctx, selectVersion).Scan(&version, &dirty)
+	if err != nil {
+		if err == sql.ErrNoRows {
+			return 0, false, nil
+		}
+		return 0, false, err
+	}
+
+	return uint(version), dirty, nil
+}
+
+// apply marks the schema dirty, runs the statements and records the target
+// version, mirroring how golang-migrate tracks a migration in progress.
+func apply(ctx context.Context, conn *sql.Conn, targetVersion uint, statements string) error {
+	if err := setVersion(ctx, conn, targetVersion, true); err != nil {
+		return err
+	}
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, statements); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return err
+	}
+
+	return setVersion(ctx, conn, targetVersion, false)
+}
+
+func setVersion(ctx context.Context, conn *sql.Conn, version uint, dirty bool) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, deleteVersion); err != nil {
+		tx.Rollback()
+		return err
+	}
+
+	if version > 0 || dirty {
+		if _, err = tx.ExecContext(ctx, insertVersion, int64(version), dirty); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+
+	return tx.Commit()
+}
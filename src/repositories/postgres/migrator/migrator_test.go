@@ -0,0 +1,88 @@
+package migrator
+
+import (
+	"io/fs"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/caiiomp/vehicle-platform-sales/db"
+)
+
+func TestLoad(t *testing.T) {
+	t.Run("should not load when there are no migrations", func(t *testing.T) {
+		source := fstest.MapFS{
+			"README.md": &fstest.MapFile{Data: []byte("docs")},
+		}
+
+		actual, err := Load(source)
+
+		assert.Nil(t, actual)
+		assert.ErrorIs(t, err, ErrNoMigrations)
+	})
+
+	t.Run("should not load when a migration has no up file", func(t *testing.T) {
+		source := fstest.MapFS{
+			"000001_init.down.sql": &fstest.MapFile{Data: []byte("DROP TABLE a;")},
+		}
+
+		actual, err := Load(source)
+
+		assert.Nil(t, actual)
+		assert.ErrorContains(t, err, "has no up file")
+	})
+
+	t.Run("should not load when files of the same version have different names", func(t *testing.T) {
+		source := fstest.MapFS{
+			"000001_init.up.sql":    &fstest.MapFile{Data: []byte("CREATE TABLE a();")},
+			"000001_other.down.sql": &fstest.MapFile{Data: []byte("DROP TABLE a;")},
+		}
+
+		actual, err := Load(source)
+
+		assert.Nil(t, actual)
+		assert.ErrorContains(t, err, "different names")
+	})
+
+	t.Run("should load migrations sorted by version", func(t *testing.T) {
+		source := fstest.MapFS{
+			"000002_second.up.sql":   &fstest.MapFile{Data: []byte("CREATE TABLE b();")},
+			"000002_second.down.sql": &fstest.MapFile{Data: []byte("DROP TABLE b;")},
+			"000001_first.up.sql":    &fstest.MapFile{Data: []byte("CREATE TABLE a();")},
+			"000001_first.down.sql":  &fstest.MapFile{Data: []byte("DROP TABLE a;")},
+			"notes.txt":              &fstest.MapFile{Data: []byte("ignored")},
+		}
+
+		expected := []Migration{
+			{Version: 1, Name: "first", Up: "CREATE TABLE a();", Down: "DROP TABLE a;"},
+			{Version: 2, Name: "second", Up: "CREATE TABLE b();", Down: "DROP TABLE b;"},
+		}
+
+		actual, err := Load(source)
+
+		assert.Equal(t, expected, actual)
+		assert.Nil(t, err)
+	})
+
+	t.Run("should load embedded migrations", func(t *testing.T) {
+		source, err := fs.Sub(db.Migrations, "migrations")
+		assert.Nil(t, err)
+
+		actual, err := Load(source)
+
+		assert.NotEmpty(t, actual)
+		assert.Nil(t, err)
+	})
+}
+
+func TestLatest(t *testing.T) {
+	migrator := &Migrator{
+		migrations: []Migration{
+			{Version: 1, Name: "first"},
+			{Version: 3, Name: "third"},
+		},
+	}
+
+	assert.Equal(t, uint(3), migrator.Latest())
+}
@@ -0,0 +1,22 @@
+package migrator
+
+const (
+	createVersionTable = `
+		CREATE TABLE IF NOT EXISTS schema_migrations (
+			version BIGINT NOT NULL PRIMARY KEY,
+			dirty BOOLEAN NOT NULL
+		);
+	`
+
+	versionTableExists = "SELECT to_regclass('schema_migrations') IS NOT NULL;"
+
+	selectVersion = "SELECT version, dirty FROM schema_migrations LIMIT 1;"
+
+	deleteVersion = "DELETE FROM schema_migrations;"
+
+	insertVersion = "INSERT INTO schema_migrations (version, dirty) VALUES ($1, $2);"
+
+	acquireLock = "SELECT pg_advisory_lock($1);"
+
+	releaseLock = "SELECT pg_advisory_unlock($1);"
+)
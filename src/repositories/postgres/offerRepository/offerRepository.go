@@ -0,0 +1,138 @@
+package offerrepository
+
+import (
+	"context"
+	"database/sql"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/database"
+	"github.com/caiiomp/vehicle-platform-sales/src/tracing"
+)
+
+type offerRepository struct {
+	db *sql.DB
+}
+
+func NewOfferRepository(db *sql.DB) interfaces.OfferRepository {
+	return &offerRepository{
+		db: db,
+	}
+}
+
+func (ref *offerRepository) Create(ctx context.Context, offer entity.Offer) (_ *entity.Offer, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "offerRepository.Create", insertOffer)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record := model.OfferFromDomain(offer)
+
+	if record.DealerID, err = scope.Resolve(record.DealerID); err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, insertOffer,
+		record.EntityID, record.VehicleID, record.DealerID, record.BuyerSubject, record.BuyerDocumentNumber,
+		record.Amount, record.CounterAmount, record.Status, record.PaymentID, record.ExpiresAt,
+	)
+
+	var created model.Offer
+	if err = scanOffer(row, &created); err != nil {
+		return nil, database.MapError(err)
+	}
+
+	return created.ToDomain(), nil
+}
+
+func (ref *offerRepository) GetByID(ctx context.Context, id string) (_ *entity.Offer, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "offerRepository.GetByID", getOfferByEntityID)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, getOfferByEntityID, id, scope.DealerID)
+
+	var offer model.Offer
+	if err = scanOffer(row, &offer); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return offer.ToDomain(), nil
+}
+
+func (ref *offerRepository) SearchByVehicleID(ctx context.Context, vehicleID string) (_ []entity.Offer, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "offerRepository.SearchByVehicleID", searchOffersByVehicleID)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.GetExecutor(ctx, ref.db).QueryContext(ctx, searchOffersByVehicleID, vehicleID, scope.DealerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	offers := make([]entity.Offer, 0)
+
+	for rows.Next() {
+		var offer model.Offer
+		if err = scanOffer(rows, &offer); err != nil {
+			return nil, err
+		}
+
+		offers = append(offers, *offer.ToDomain())
+	}
+
+	return offers, rows.Err()
+}
+
+func (ref *offerRepository) Update(ctx context.Context, offer entity.Offer) (_ *entity.Offer, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "offerRepository.Update", updateOffer)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record := model.OfferFromDomain(offer)
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, updateOffer,
+		record.EntityID, record.CounterAmount, record.Status, record.PaymentID, record.ExpiresAt, scope.DealerID,
+	)
+
+	var updated model.Offer
+	if err = scanOffer(row, &updated); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return updated.ToDomain(), nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanOffer(row scanner, offer *model.Offer) error {
+	return row.Scan(&offer.ID, &offer.EntityID, &offer.VehicleID, &offer.DealerID, &offer.BuyerSubject, &offer.BuyerDocumentNumber,
+		&offer.Amount, &offer.CounterAmount, &offer.Status, &offer.PaymentID, &offer.ExpiresAt, &offer.CreatedAt, &offer.UpdatedAt,
+	)
+}
@@ -0,0 +1,29 @@
+package offerrepository
+
+// Every query takes the dealer of the tenant scope as its last parameter, zero
+// reaching every dealer.
+const (
+	insertOffer = `
+		INSERT INTO vehicle_offers (
+			entity_id, vehicle_id, dealer_id, buyer_subject, buyer_document_number,
+			amount, counter_amount, status, payment_id, expires_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10)
+		RETURNING *;
+	`
+
+	getOfferByEntityID = "SELECT * FROM vehicle_offers WHERE entity_id = $1 AND ($2 = 0 OR dealer_id = $2);"
+
+	searchOffersByVehicleID = "SELECT * FROM vehicle_offers WHERE vehicle_id = $1 AND ($2 = 0 OR dealer_id = $2) ORDER BY id;"
+
+	updateOffer = `
+		UPDATE vehicle_offers
+		SET
+			counter_amount = $2,
+			status = $3,
+			payment_id = $4,
+			expires_at = $5
+		WHERE entity_id = $1 AND ($6 = 0 OR dealer_id = $6)
+		RETURNING *;
+	`
+)
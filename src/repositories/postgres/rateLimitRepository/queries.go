@@ -0,0 +1,16 @@
+package ratelimitrepository
+
+const (
+	// lockBucket creates a missing bucket full and returns the current one. The
+	// no-op update of a conflict locks the row until the transaction ends.
+	lockBucket = `
+		INSERT INTO rate_limit_buckets (key, tokens, updated_at)
+		VALUES ($1, $2, $3)
+		ON CONFLICT (key) DO UPDATE SET key = EXCLUDED.key
+		RETURNING tokens, updated_at;
+	`
+
+	updateBucket = "UPDATE rate_limit_buckets SET tokens = $2, updated_at = $3 WHERE key = $1;"
+
+	deleteIdleBuckets = "DELETE FROM rate_limit_buckets WHERE updated_at < $1;"
+)
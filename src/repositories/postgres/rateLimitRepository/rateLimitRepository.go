@@ -0,0 +1,61 @@
+package ratelimitrepository
+
+import (
+	"context"
+	"database/sql"
+	"time"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/ratelimit"
+	"github.com/caiiomp/vehicle-platform-sales/src/tracing"
+)
+
+type rateLimitRepository struct {
+	db *sql.DB
+}
+
+// NewRateLimitRepository shares the buckets between every instance using the
+// database. It runs its own short transactions instead of joining the one of
+// the request, so a rolled back request still spends its token.
+func NewRateLimitRepository(db *sql.DB) ratelimit.Store {
+	return &rateLimitRepository{
+		db: db,
+	}
+}
+
+func (ref *rateLimitRepository) Take(ctx context.Context, key string, limit ratelimit.Limit, now time.Time) (_ ratelimit.Decision, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "rateLimitRepository.Take", lockBucket)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	tx, err := ref.db.BeginTx(ctx, nil)
+	if err != nil {
+		return ratelimit.Decision{}, err
+	}
+	defer tx.Rollback()
+
+	initial := ratelimit.NewBucket(limit, now)
+
+	var bucket ratelimit.Bucket
+	if err = tx.QueryRowContext(ctx, lockBucket, key, initial.Tokens, initial.UpdatedAt).Scan(&bucket.Tokens, &bucket.UpdatedAt); err != nil {
+		return ratelimit.Decision{}, err
+	}
+
+	next, decision := bucket.Take(limit, now)
+
+	if _, err = tx.ExecContext(ctx, updateBucket, key, next.Tokens, next.UpdatedAt); err != nil {
+		return ratelimit.Decision{}, err
+	}
+
+	if err = tx.Commit(); err != nil {
+		return ratelimit.Decision{}, err
+	}
+
+	return decision, nil
+}
+
+func (ref *rateLimitRepository) Prune(ctx context.Context, before time.Time) (err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "rateLimitRepository.Prune", deleteIdleBuckets)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	_, err = ref.db.ExecContext(ctx, deleteIdleBuckets, before)
+	return err
+}
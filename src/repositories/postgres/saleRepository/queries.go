@@ -1,28 +1,116 @@
 package salerepository
 
+// Every query takes the dealer of the tenant scope as its last parameter, zero
+// reaching every dealer.
 const (
-	getSaleByEntityID = "SELECT * FROM sales WHERE entity_id = $1;"
+	getSaleByID = "SELECT * FROM sales WHERE id = $1 AND ($2 = 0 OR dealer_id = $2);"
+
+	getSaleByEntityID = "SELECT * FROM sales WHERE entity_id = $1 AND ($2 = 0 OR dealer_id = $2);"
 
 	insertSale = `
 		INSERT INTO sales (
 			entity_id,
+			dealer_id,
 			payment_id,
 			buyer_document_number,
 			price,
 			status,
-			sold_at
+			sold_at,
+			list_price,
+			discount,
+			coupon_code,
+			trade_in_brand,
+			trade_in_model,
+			trade_in_year,
+			trade_in_color,
+			trade_in_mileage,
+			trade_in_license_plate,
+			trade_in_vin,
+			trade_in_value,
+			trade_in_appraised_by,
+			trade_in_vehicle_id,
+			financing_plan_id,
+			financing_amortization_system,
+			financing_monthly_interest_rate,
+			financing_down_payment,
+			financing_principal,
+			financing_months
 		) 
-		VALUES ($1, $2, $3, $4, $5, $6)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19, $20, $21, $22, $23, $24, $25, $26)
 		RETURNING *;
 	`
 
+	// updateSaleStatusByPaymentID locks the sales in previous before updating
+	// them, so a concurrent update waits and reads the status left by this one.
 	updateSaleStatusByPaymentID = `
-		UPDATE sales SET 
+		WITH previous AS (
+			SELECT id, status FROM sales
+			WHERE payment_id = $1 AND ($4 = 0 OR dealer_id = $4)
+			FOR UPDATE
+		)
+		UPDATE sales SET
 			status = $2,
 			sold_at = $3
-		WHERE payment_id = $1 
+		FROM previous
+		WHERE sales.id = previous.id
+		RETURNING sales.*, previous.status;
+	`
+
+	linkSaleTradeInVehicle = `
+		UPDATE sales SET
+			trade_in_vehicle_id = $2
+		WHERE id = $1 AND trade_in_value > 0 AND trade_in_vehicle_id = '' AND ($3 = 0 OR dealer_id = $3)
+		RETURNING *;
+	`
+
+	// insertSaleInstallment only inserts the installment of a sale in the
+	// scope, returning no row otherwise.
+	attachSalePayment = `
+		UPDATE sales SET
+			payment_id = $2
+		WHERE id = $1 AND payment_id = '' AND ($3 = 0 OR dealer_id = $3)
+		RETURNING *;
+	`
+
+	deleteSaleReservation = `
+		DELETE FROM sales
+		WHERE id = $1 AND payment_id = '' AND ($2 = 0 OR dealer_id = $2)
+		RETURNING *;
+	`
+
+	// deleteRejectedSale leaves the installments of the sale to the cascade of
+	// their foreign key.
+	deleteRejectedSale = `
+		DELETE FROM sales
+		WHERE id = $1 AND status = $2 AND ($3 = 0 OR dealer_id = $3)
+		RETURNING *;
+	`
+
+	insertSaleInstallment = `
+		INSERT INTO sale_installments (sale_id, number, due_date, amount, interest, amortization, balance)
+		SELECT id, $2, $3, $4, $5, $6, $7
+		FROM sales
+		WHERE id = $1 AND ($8 = 0 OR dealer_id = $8)
 		RETURNING *;
 	`
 
-	searchAllSales = "SELECT * FROM sales;"
+	searchSaleInstallments = `
+		SELECT i.*
+		FROM sale_installments AS i
+		JOIN sales AS s ON s.id = i.sale_id
+		WHERE i.sale_id = $1 AND ($2 = 0 OR s.dealer_id = $2)
+		ORDER BY i.number;
+	`
+
+	paySaleInstallment = `
+		UPDATE sale_installments AS i SET
+			paid_at = COALESCE(i.paid_at, $3)
+		FROM sales AS s
+		WHERE s.id = i.sale_id AND s.payment_id = $1 AND i.number = $2 AND ($4 = 0 OR s.dealer_id = $4)
+		RETURNING i.*;
+	`
+
+	searchAllSales = "SELECT * FROM sales WHERE ($1 = 0 OR dealer_id = $1);"
+
+	countSalesByStatus = "SELECT COUNT(*) FROM sales WHERE status = $1 AND ($2 = 0 OR dealer_id = $2);"
 )
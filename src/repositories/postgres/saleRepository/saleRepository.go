@@ -3,11 +3,18 @@ package salerepository
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"time"
 
 	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/logger"
 	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/database"
+	"github.com/caiiomp/vehicle-platform-sales/src/tracing"
 )
 
 type saleRepository struct {
@@ -20,29 +27,71 @@ func NewSaleRepository(db *sql.DB) interfaces.SaleRepository {
 	}
 }
 
-func (ref *saleRepository) Create(ctx context.Context, sale entity.Sale) (*entity.Sale, error) {
+func (ref *saleRepository) Create(ctx context.Context, sale entity.Sale) (_ *entity.Sale, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.Create", insertSale)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	record := model.SaleFromDomain(sale)
 
-	row := ref.db.QueryRowContext(ctx, insertSale, record.EntityID, record.PaymentID, record.BuyerDocumentNumber, record.Price, record.Status, record.SoldAt)
+	if record.DealerID, err = scope.Resolve(record.DealerID); err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, insertSale, record.EntityID, record.DealerID, record.PaymentID, record.BuyerDocumentNumber, record.Price, record.Status, record.SoldAt, record.ListPrice, record.Discount, record.CouponCode,
+		record.TradeInBrand, record.TradeInModel, record.TradeInYear, record.TradeInColor, record.TradeInMileage, record.TradeInLicensePlate, record.TradeInVIN, record.TradeInValue, record.TradeInAppraisedBy, record.TradeInVehicleID,
+		record.FinancingPlanID, record.FinancingAmortizationSystem, record.FinancingMonthlyInterestRate, record.FinancingDownPayment, record.FinancingPrincipal, record.FinancingMonths)
 
 	var created model.Sale
-	err := row.Scan(&created.ID, &created.EntityID, &created.PaymentID, &created.BuyerDocumentNumber, &created.Price, &created.Status, &created.SoldAt, &created.CreatedAt, &created.UpdatedAt)
-	if err != nil {
+	if err = scanSale(row, &created); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
-		return nil, err
+		return nil, database.MapError(err)
 	}
 
 	return created.ToDomain(), nil
 }
 
-func (ref *saleRepository) GetByEntityID(ctx context.Context, entityID string) (*entity.Sale, error) {
-	row := ref.db.QueryRowContext(ctx, getSaleByEntityID, entityID)
+func (ref *saleRepository) GetByID(ctx context.Context, id int) (_ *entity.Sale, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.GetByID", getSaleByID)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, getSaleByID, id, scope.DealerID)
 
 	var sale model.Sale
-	err := row.Scan(&sale.ID, &sale.EntityID, &sale.PaymentID, &sale.BuyerDocumentNumber, &sale.Price, &sale.Status, &sale.SoldAt, &sale.CreatedAt, &sale.UpdatedAt)
+	if err = scanSale(row, &sale); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return sale.ToDomain(), nil
+}
+
+func (ref *saleRepository) GetByEntityID(ctx context.Context, entityID string) (_ *entity.Sale, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.GetByEntityID", getSaleByEntityID)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
 	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, getSaleByEntityID, entityID, scope.DealerID)
+
+	var sale model.Sale
+	if err = scanSale(row, &sale); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -52,8 +101,16 @@ func (ref *saleRepository) GetByEntityID(ctx context.Context, entityID string) (
 	return sale.ToDomain(), nil
 }
 
-func (ref *saleRepository) Search(ctx context.Context) ([]entity.Sale, error) {
-	rows, err := ref.db.QueryContext(ctx, searchAllSales)
+func (ref *saleRepository) Search(ctx context.Context) (_ []entity.Sale, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.Search", searchAllSales)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.GetExecutor(ctx, ref.db).QueryContext(ctx, searchAllSales, scope.DealerID)
 	if err != nil {
 		return nil, err
 	}
@@ -63,8 +120,7 @@ func (ref *saleRepository) Search(ctx context.Context) ([]entity.Sale, error) {
 
 	for rows.Next() {
 		var record model.Sale
-		err = rows.Scan(&record.ID, &record.EntityID, &record.PaymentID, &record.BuyerDocumentNumber, &record.Price, &record.Status, &record.SoldAt, &record.CreatedAt, &record.UpdatedAt)
-		if err != nil {
+		if err = scanSale(rows, &record); err != nil {
 			return nil, err
 		}
 
@@ -74,12 +130,126 @@ func (ref *saleRepository) Search(ctx context.Context) ([]entity.Sale, error) {
 	return sales, nil
 }
 
-func (ref *saleRepository) UpdateStatusByPaymentID(ctx context.Context, paymentID, status string, soldDate time.Time) (*entity.Sale, error) {
-	row := ref.db.QueryRowContext(ctx, updateSaleStatusByPaymentID, paymentID, status, soldDate)
+func (ref *saleRepository) CountByStatus(ctx context.Context, status string) (_ int, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.CountByStatus", countSalesByStatus)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var count int
+	if err = database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, countSalesByStatus, status, scope.DealerID).Scan(&count); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (ref *saleRepository) UpdateStatusByPaymentID(ctx context.Context, paymentID, status string, soldDate time.Time) (_ *entity.Sale, _ valueobjects.SaleStatusType, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.UpdateStatusByPaymentID", updateSaleStatusByPaymentID)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, "", err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, updateSaleStatusByPaymentID, paymentID, status, soldDate, scope.DealerID)
+
+	var sale model.Sale
+	var previous string
+	if err = scanSale(row, &sale, &previous); err != nil {
+		if err == sql.ErrNoRows {
+			logger.FromContext(ctx).DebugContext(ctx, "no sale to update", "payment_id", paymentID)
+			return nil, "", nil
+		}
+		return nil, "", err
+	}
+
+	return sale.ToDomain(), valueobjects.SaleStatusType(previous), nil
+}
+
+func (ref *saleRepository) LinkTradeInVehicle(ctx context.Context, id int, vehicleID string) (_ *entity.Sale, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.LinkTradeInVehicle", linkSaleTradeInVehicle)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, linkSaleTradeInVehicle, id, vehicleID, scope.DealerID)
+
+	var sale model.Sale
+	if err = scanSale(row, &sale); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return sale.ToDomain(), nil
+}
+
+func (ref *saleRepository) AttachPayment(ctx context.Context, id int, paymentID string) (_ *entity.Sale, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.AttachPayment", attachSalePayment)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, attachSalePayment, id, paymentID, scope.DealerID)
+
+	var sale model.Sale
+	if err = scanSale(row, &sale); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return sale.ToDomain(), nil
+}
+
+func (ref *saleRepository) DeleteReservation(ctx context.Context, id int) (_ *entity.Sale, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.DeleteReservation", deleteSaleReservation)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, deleteSaleReservation, id, scope.DealerID)
 
 	var sale model.Sale
-	err := row.Scan(&sale.ID, &sale.EntityID, &sale.PaymentID, &sale.BuyerDocumentNumber, &sale.Price, &sale.Status, &sale.SoldAt, &sale.CreatedAt, &sale.UpdatedAt)
+	if err = scanSale(row, &sale); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return sale.ToDomain(), nil
+}
+
+func (ref *saleRepository) DeleteRejected(ctx context.Context, id int) (_ *entity.Sale, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.DeleteRejected", deleteRejectedSale)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
 	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, deleteRejectedSale, id, string(valueobjects.SaleStatusTypeRejected), scope.DealerID)
+
+	var sale model.Sale
+	if err = scanSale(row, &sale); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -88,3 +258,107 @@ func (ref *saleRepository) UpdateStatusByPaymentID(ctx context.Context, paymentI
 
 	return sale.ToDomain(), nil
 }
+
+func (ref *saleRepository) CreateInstallments(ctx context.Context, id int, installments []entity.Installment) (_ []entity.Installment, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.CreateInstallments", insertSaleInstallment)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	executor := database.GetExecutor(ctx, ref.db)
+
+	created := make([]entity.Installment, 0, len(installments))
+
+	for _, installment := range installments {
+		record := model.InstallmentFromDomain(installment)
+
+		row := executor.QueryRowContext(ctx, insertSaleInstallment, id, record.Number, record.DueDate, record.Amount, record.Interest, record.Amortization, record.Balance, scope.DealerID)
+
+		var inserted model.Installment
+		if err = scanInstallment(row, &inserted); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, fmt.Errorf("%w: sale %d", domainerrors.ErrReferenceNotFound, id)
+			}
+			return nil, database.MapError(err)
+		}
+
+		created = append(created, *inserted.ToDomain())
+	}
+
+	return created, nil
+}
+
+func (ref *saleRepository) SearchInstallments(ctx context.Context, id int) (_ []entity.Installment, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.SearchInstallments", searchSaleInstallments)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.GetExecutor(ctx, ref.db).QueryContext(ctx, searchSaleInstallments, id, scope.DealerID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	installments := make([]entity.Installment, 0)
+
+	for rows.Next() {
+		var installment model.Installment
+		if err = scanInstallment(rows, &installment); err != nil {
+			return nil, err
+		}
+
+		installments = append(installments, *installment.ToDomain())
+	}
+
+	return installments, rows.Err()
+}
+
+func (ref *saleRepository) PayInstallment(ctx context.Context, paymentID string, number int, paidAt time.Time) (_ *entity.Installment, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "saleRepository.PayInstallment", paySaleInstallment)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, paySaleInstallment, paymentID, number, paidAt, scope.DealerID)
+
+	var installment model.Installment
+	if err = scanInstallment(row, &installment); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return installment.ToDomain(), nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanSale follows the column order of the table, dealer_id, the price
+// breakdown, the trade-in and the financing last as they were added after the
+// others.
+// scanSale reads the columns of a sale, followed by the extra columns a query
+// returns after them.
+func scanSale(row scanner, sale *model.Sale, extra ...any) error {
+	dest := []any{&sale.ID, &sale.EntityID, &sale.PaymentID, &sale.BuyerDocumentNumber, &sale.Price, &sale.Status, &sale.SoldAt, &sale.CreatedAt, &sale.UpdatedAt, &sale.DealerID, &sale.ListPrice, &sale.Discount, &sale.CouponCode,
+		&sale.TradeInBrand, &sale.TradeInModel, &sale.TradeInYear, &sale.TradeInColor, &sale.TradeInMileage, &sale.TradeInLicensePlate, &sale.TradeInVIN, &sale.TradeInValue, &sale.TradeInAppraisedBy, &sale.TradeInVehicleID,
+		&sale.FinancingPlanID, &sale.FinancingAmortizationSystem, &sale.FinancingMonthlyInterestRate, &sale.FinancingDownPayment, &sale.FinancingPrincipal, &sale.FinancingMonths}
+
+	return row.Scan(append(dest, extra...)...)
+}
+
+func scanInstallment(row scanner, installment *model.Installment) error {
+	return row.Scan(&installment.SaleID, &installment.Number, &installment.DueDate, &installment.Amount, &installment.Interest, &installment.Amortization, &installment.Balance, &installment.PaidAt)
+}
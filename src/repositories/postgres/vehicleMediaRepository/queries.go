@@ -0,0 +1,35 @@
+package vehiclemediarepository
+
+// Every query takes the dealer of the tenant scope as its last parameter, zero
+// reaching every dealer.
+const (
+	insertVehicleMedia = `
+		INSERT INTO vehicle_media (
+			entity_id, vehicle_id, dealer_id, content_type, size, width, height,
+			position, is_cover, storage_key, thumbnail_key
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11)
+		RETURNING *;
+	`
+
+	getVehicleMediaByEntityID = "SELECT * FROM vehicle_media WHERE entity_id = $1 AND ($2 = 0 OR dealer_id = $2);"
+
+	searchVehicleMediaByVehicleIDs = `
+		SELECT * FROM vehicle_media
+		WHERE vehicle_id = ANY($1::TEXT[]) AND ($2 = 0 OR dealer_id = $2)
+		ORDER BY vehicle_id, position, id;
+	`
+
+	updateVehicleMedia = `
+		UPDATE vehicle_media
+		SET
+			position = $2,
+			is_cover = $3
+		WHERE entity_id = $1 AND ($4 = 0 OR dealer_id = $4)
+		RETURNING *;
+	`
+
+	deleteVehicleMedia = "DELETE FROM vehicle_media WHERE entity_id = $1 AND ($2 = 0 OR dealer_id = $2) RETURNING *;"
+
+	deleteVehicleMediaByVehicleID = "DELETE FROM vehicle_media WHERE vehicle_id = $1 AND ($2 = 0 OR dealer_id = $2) RETURNING *;"
+)
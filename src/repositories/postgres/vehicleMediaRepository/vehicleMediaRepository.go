@@ -0,0 +1,178 @@
+package vehiclemediarepository
+
+import (
+	"context"
+	"database/sql"
+
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/database"
+	"github.com/caiiomp/vehicle-platform-sales/src/tracing"
+)
+
+type vehicleMediaRepository struct {
+	db *sql.DB
+}
+
+func NewVehicleMediaRepository(db *sql.DB) interfaces.VehicleMediaRepository {
+	return &vehicleMediaRepository{
+		db: db,
+	}
+}
+
+func (ref *vehicleMediaRepository) Create(ctx context.Context, media entity.VehicleMedia) (_ *entity.VehicleMedia, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleMediaRepository.Create", insertVehicleMedia)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	record := model.VehicleMediaFromDomain(media)
+
+	if record.DealerID, err = scope.Resolve(record.DealerID); err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, insertVehicleMedia,
+		record.EntityID, record.VehicleID, record.DealerID, record.ContentType, record.Size, record.Width, record.Height,
+		record.Position, record.IsCover, record.StorageKey, record.ThumbnailKey,
+	)
+
+	var created model.VehicleMedia
+	if err = scanVehicleMedia(row, &created); err != nil {
+		return nil, database.MapError(err)
+	}
+
+	return created.ToDomain(), nil
+}
+
+func (ref *vehicleMediaRepository) GetByID(ctx context.Context, id string) (_ *entity.VehicleMedia, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleMediaRepository.GetByID", getVehicleMediaByEntityID)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, getVehicleMediaByEntityID, id, scope.DealerID)
+
+	var media model.VehicleMedia
+	if err = scanVehicleMedia(row, &media); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return media.ToDomain(), nil
+}
+
+func (ref *vehicleMediaRepository) SearchByVehicleIDs(ctx context.Context, vehicleIDs []string) (_ []entity.VehicleMedia, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleMediaRepository.SearchByVehicleIDs", searchVehicleMediaByVehicleIDs)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.GetExecutor(ctx, ref.db).QueryContext(ctx, searchVehicleMediaByVehicleIDs, vehicleIDs, scope.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectVehicleMedia(rows)
+}
+
+func (ref *vehicleMediaRepository) Update(ctx context.Context, media entity.VehicleMedia) (_ *entity.VehicleMedia, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleMediaRepository.Update", updateVehicleMedia)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, updateVehicleMedia, media.EntityID, media.Position, media.IsCover, scope.DealerID)
+
+	var updated model.VehicleMedia
+	if err = scanVehicleMedia(row, &updated); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return updated.ToDomain(), nil
+}
+
+func (ref *vehicleMediaRepository) Delete(ctx context.Context, id string) (_ *entity.VehicleMedia, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleMediaRepository.Delete", deleteVehicleMedia)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, deleteVehicleMedia, id, scope.DealerID)
+
+	var deleted model.VehicleMedia
+	if err = scanVehicleMedia(row, &deleted); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return deleted.ToDomain(), nil
+}
+
+func (ref *vehicleMediaRepository) DeleteByVehicleID(ctx context.Context, vehicleID string) (_ []entity.VehicleMedia, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleMediaRepository.DeleteByVehicleID", deleteVehicleMediaByVehicleID)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.GetExecutor(ctx, ref.db).QueryContext(ctx, deleteVehicleMediaByVehicleID, vehicleID, scope.DealerID)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectVehicleMedia(rows)
+}
+
+func collectVehicleMedia(rows *sql.Rows) ([]entity.VehicleMedia, error) {
+	defer rows.Close()
+
+	media := make([]entity.VehicleMedia, 0)
+
+	for rows.Next() {
+		var record model.VehicleMedia
+		if err := scanVehicleMedia(rows, &record); err != nil {
+			return nil, err
+		}
+
+		media = append(media, *record.ToDomain())
+	}
+
+	return media, rows.Err()
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+func scanVehicleMedia(row scanner, media *model.VehicleMedia) error {
+	return row.Scan(&media.ID, &media.EntityID, &media.VehicleID, &media.DealerID, &media.ContentType, &media.Size, &media.Width, &media.Height,
+		&media.Position, &media.IsCover, &media.StorageKey, &media.ThumbnailKey, &media.CreatedAt,
+	)
+}
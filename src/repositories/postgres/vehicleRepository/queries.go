@@ -1,11 +1,26 @@
 package vehiclerepository
 
+// Every query takes the dealer of the tenant scope as its last parameter, zero
+// reaching every dealer. updateVehicle only matches the version the caller
+// read, so a concurrent update makes it return no rows. Timestamps are stored
+// in UTC, so published_at is compared with the current UTC time.
 const (
-	getVehicleByEntityID = "SELECT * FROM vehicles WHERE entity_id = $1;"
+	getVehicleByEntityID = "SELECT * FROM vehicles WHERE entity_id = $1 AND ($2 = 0 OR dealer_id = $2);"
+
+	getVehicleByEntityIDForUpdate = "SELECT * FROM vehicles WHERE entity_id = $1 AND ($2 = 0 OR dealer_id = $2) FOR UPDATE;"
+
+	getVehicleBySlug = "SELECT * FROM vehicles WHERE slug = $1 AND ($2 = 0 OR dealer_id = $2);"
+
+	// existsVehicleByVIN reaches every dealer, like the unique index on VINs.
+	existsVehicleByVIN = "SELECT EXISTS (SELECT 1 FROM vehicles WHERE vin = $1);"
 
 	insertVehicle = `
-		INSERT INTO vehicles (entity_id, brand, model, year, color, price) 
-		VALUES ($1, $2, $3, $4, $5, $6)
+		INSERT INTO vehicles (
+			entity_id, dealer_id, brand, model, year, color, price,
+			mileage, fuel_type, transmission, body_type, condition, doors, engine_displacement, license_plate, vin, slug,
+			status, published_at
+		)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 		RETURNING *;
 	`
 
@@ -16,18 +31,52 @@ const (
 			model = $3,
 			year = $4,
 			color = $5,
-			price = $6
-		WHERE entity_id = $1
+			price = $6,
+			mileage = $7,
+			fuel_type = $8,
+			transmission = $9,
+			body_type = $10,
+			condition = $11,
+			doors = $12,
+			engine_displacement = $13,
+			license_plate = $14,
+			vin = $15,
+			slug = $16,
+			status = $17,
+			published_at = $18,
+			version = version + 1
+		WHERE entity_id = $1 AND version = $19 AND ($20 = 0 OR dealer_id = $20)
 		RETURNING *;
 	`
 
-	searchAllVehicles = "SELECT * FROM vehicles ORDER BY price ASC;"
+	deleteVehicle = "DELETE FROM vehicles WHERE entity_id = $1 AND ($2 = 0 OR dealer_id = $2) RETURNING *;"
+
+	// vehicleFilters takes the fields of entity.VehicleFilter, where empty
+	// values, a null max mileage and false do not filter, followed by the
+	// dealer.
+	vehicleFilters = `
+		AND ($1 = '' OR v.fuel_type = $1)
+		AND ($2 = '' OR v.transmission = $2)
+		AND ($3 = '' OR v.body_type = $3)
+		AND ($4 = '' OR v.condition = $4)
+		AND ($5 = 0 OR v.doors = $5)
+		AND ($6::INT IS NULL OR v.mileage <= $6)
+		AND ($7 = '' OR v.status = $7)
+		AND (NOT $8::BOOLEAN OR (v.status = 'PUBLISHED' AND v.published_at <= NOW() AT TIME ZONE 'UTC'))
+		AND ($9 = 0 OR v.dealer_id = $9)
+	`
+
+	searchAllVehicles = `
+		SELECT v.* FROM vehicles v
+		WHERE TRUE` + vehicleFilters + `
+		ORDER BY v.price ASC;
+	`
 
 	searchSoldVehicles = `
 		SELECT v.* FROM vehicles v
 		JOIN sales s
 		ON v.entity_id = s.entity_id
-		WHERE s.status = 'APPROVED' and s.sold_at IS NOT NULL
+		WHERE s.status = 'APPROVED' and s.sold_at IS NOT NULL` + vehicleFilters + `
 		ORDER BY v.price ASC;
 	`
 
@@ -35,7 +84,7 @@ const (
 		SELECT v.* FROM vehicles v
 		LEFT JOIN sales s
 		ON v.entity_id = s.entity_id
-		WHERE s.entity_id IS NULL OR (s.status != 'APPROVED' AND s.sold_at IS NULL)
+		WHERE (s.entity_id IS NULL OR (s.status != 'APPROVED' AND s.sold_at IS NULL))` + vehicleFilters + `
 		ORDER BY v.price ASC;
 	`
 )
@@ -3,10 +3,16 @@ package vehiclerepository
 import (
 	"context"
 	"database/sql"
+	"fmt"
 
 	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	domainerrors "github.com/caiiomp/vehicle-platform-sales/src/core/domain/domainErrors"
 	"github.com/caiiomp/vehicle-platform-sales/src/core/domain/entity"
+	valueobjects "github.com/caiiomp/vehicle-platform-sales/src/core/domain/valueObjects"
+	"github.com/caiiomp/vehicle-platform-sales/src/core/tenant"
 	"github.com/caiiomp/vehicle-platform-sales/src/repositories/model"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/database"
+	"github.com/caiiomp/vehicle-platform-sales/src/tracing"
 )
 
 type vehicleRepository struct {
@@ -19,29 +25,80 @@ func NewVehicleRepository(db *sql.DB) interfaces.VehicleRepository {
 	}
 }
 
-func (ref *vehicleRepository) Create(ctx context.Context, vehicle entity.Vehicle) (*entity.Vehicle, error) {
+func (ref *vehicleRepository) Create(ctx context.Context, vehicle entity.Vehicle) (_ *entity.Vehicle, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleRepository.Create", insertVehicle)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	record := model.VehicleFromDomain(vehicle)
 
-	row := ref.db.QueryRowContext(ctx, insertVehicle, record.EntityID, record.Brand, record.Model, record.Year, record.Color, record.Price)
+	if record.DealerID, err = scope.Resolve(record.DealerID); err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, insertVehicle,
+		record.EntityID, record.DealerID, record.Brand, record.Model, record.Year, record.Color, record.Price,
+		record.Mileage, record.FuelType, record.Transmission, record.BodyType, record.Condition, record.Doors, record.EngineDisplacement, record.LicensePlate, record.VIN, record.Slug,
+		record.Status, record.PublishedAt,
+	)
 
 	var created model.Vehicle
-	err := row.Scan(&created.ID, &created.EntityID, &created.Brand, &created.Model, &created.Year, &created.Color, &created.Price, &created.CreatedAt, &created.UpdatedAt)
-	if err != nil {
+	if err = scanVehicle(row, &created); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
-		return nil, err
+		return nil, database.MapError(err)
 	}
 
 	return created.ToDomain(), nil
 }
 
-func (ref *vehicleRepository) GetByID(ctx context.Context, id string) (*entity.Vehicle, error) {
-	row := ref.db.QueryRowContext(ctx, getVehicleByEntityID, id)
+// GetByID locks the vehicle row when called inside a transaction, so a unit of
+// work that reads a vehicle before changing it is not raced by another one.
+func (ref *vehicleRepository) GetByID(ctx context.Context, id string) (_ *entity.Vehicle, err error) {
+	query := getVehicleByEntityID
+	if database.InTransaction(ctx) {
+		query = getVehicleByEntityIDForUpdate
+	}
+
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleRepository.GetByID", query)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, query, id, scope.DealerID)
 
 	var vehicle model.Vehicle
-	err := row.Scan(&vehicle.ID, &vehicle.EntityID, &vehicle.Brand, &vehicle.Model, &vehicle.Year, &vehicle.Color, &vehicle.Price, &vehicle.CreatedAt, &vehicle.UpdatedAt)
+	if err = scanVehicle(row, &vehicle); err != nil {
+		if err == sql.ErrNoRows {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return vehicle.ToDomain(), nil
+}
+
+func (ref *vehicleRepository) GetBySlug(ctx context.Context, slug string) (_ *entity.Vehicle, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleRepository.GetBySlug", getVehicleBySlug)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
 	if err != nil {
+		return nil, err
+	}
+
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, getVehicleBySlug, slug, scope.DealerID)
+
+	var vehicle model.Vehicle
+	if err = scanVehicle(row, &vehicle); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
@@ -51,17 +108,41 @@ func (ref *vehicleRepository) GetByID(ctx context.Context, id string) (*entity.V
 	return vehicle.ToDomain(), nil
 }
 
-func (ref *vehicleRepository) Search(ctx context.Context, isSold *bool) ([]entity.Vehicle, error) {
+func (ref *vehicleRepository) ExistsByVIN(ctx context.Context, vin valueobjects.VIN) (_ bool, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleRepository.ExistsByVIN", existsVehicleByVIN)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	var exists bool
+	if err = database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, existsVehicleByVIN, vin.String()).Scan(&exists); err != nil {
+		return false, err
+	}
+
+	return exists, nil
+}
+
+func (ref *vehicleRepository) Search(ctx context.Context, filter entity.VehicleFilter) (_ []entity.Vehicle, err error) {
 	query := searchAllVehicles
 
-	if isSold != nil {
+	if filter.IsSold != nil {
 		query = searchNotSoldVehicles
-		if *isSold {
+		if *filter.IsSold {
 			query = searchSoldVehicles
 		}
 	}
 
-	rows, err := ref.db.QueryContext(ctx, query)
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleRepository.Search", query)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rows, err := database.GetExecutor(ctx, ref.db).QueryContext(ctx, query,
+		filter.FuelType.String(), filter.Transmission.String(), filter.BodyType.String(), filter.Condition.String(), filter.Doors, filter.MaxMileage,
+		filter.Status.String(), filter.PublishedOnly,
+		scope.DealerID,
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -71,8 +152,7 @@ func (ref *vehicleRepository) Search(ctx context.Context, isSold *bool) ([]entit
 
 	for rows.Next() {
 		var record model.Vehicle
-		err := rows.Scan(&record.ID, &record.EntityID, &record.Brand, &record.Model, &record.Year, &record.Color, &record.Price, &record.CreatedAt, &record.UpdatedAt)
-		if err != nil {
+		if err = scanVehicle(rows, &record); err != nil {
 			return nil, err
 		}
 
@@ -82,57 +162,85 @@ func (ref *vehicleRepository) Search(ctx context.Context, isSold *bool) ([]entit
 	return vehicles, nil
 }
 
-func (ref *vehicleRepository) Update(ctx context.Context, id string, vehicle entity.Vehicle) (*entity.Vehicle, error) {
-	row := ref.db.QueryRowContext(ctx, getVehicleByEntityID, id)
+// Update writes the fields of vehicle only when the stored vehicle is still at
+// vehicle.Version. When no row matches, the vehicle is read again to tell a
+// vehicle that does not exist apart from one at another version.
+func (ref *vehicleRepository) Update(ctx context.Context, id string, vehicle entity.Vehicle) (_ *entity.Vehicle, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleRepository.Update", updateVehicle)
+	defer func() { tracing.EndSpan(span, err) }()
 
-	var current model.Vehicle
-	if err := row.Scan(&current.ID, &current.EntityID, &current.Brand, &current.Model, &current.Year, &current.Color, &current.Price, &current.CreatedAt, &current.UpdatedAt); err != nil {
-		if err == sql.ErrNoRows {
-			return nil, nil
-		}
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
 		return nil, err
 	}
 
-	var hasUpdate bool
+	executor := database.GetExecutor(ctx, ref.db)
 
-	if vehicle.Brand != "" {
-		current.Brand = vehicle.Brand
-		hasUpdate = true
-	}
+	record := model.VehicleFromDomain(vehicle)
 
-	if vehicle.Model != "" {
-		current.Model = vehicle.Model
-		hasUpdate = true
-	}
+	row := executor.QueryRowContext(ctx, updateVehicle, id,
+		record.Brand, record.Model, record.Year, record.Color, record.Price,
+		record.Mileage, record.FuelType, record.Transmission, record.BodyType, record.Condition, record.Doors, record.EngineDisplacement, record.LicensePlate, record.VIN, record.Slug,
+		record.Status, record.PublishedAt,
+		record.Version, scope.DealerID,
+	)
 
-	if vehicle.Color != "" {
-		current.Color = vehicle.Color
-		hasUpdate = true
-	}
+	var updated model.Vehicle
+	if err = scanVehicle(row, &updated); err != nil {
+		if err != sql.ErrNoRows {
+			return nil, database.MapError(err)
+		}
 
-	if vehicle.Year != 0 {
-		current.Year = vehicle.Year
-		hasUpdate = true
-	}
+		row = executor.QueryRowContext(ctx, getVehicleByEntityID, id, scope.DealerID)
 
-	if vehicle.Price != 0 {
-		current.Price = vehicle.Price
-		hasUpdate = true
+		var current model.Vehicle
+		if err = scanVehicle(row, &current); err != nil {
+			if err == sql.ErrNoRows {
+				return nil, nil
+			}
+			return nil, err
+		}
+
+		return nil, fmt.Errorf("%w: vehicle %q is at version %d", domainerrors.ErrVersionMismatch, id, current.Version)
 	}
 
-	if !hasUpdate {
-		return current.ToDomain(), nil
+	return updated.ToDomain(), nil
+}
+
+// Delete relies on the foreign keys to refuse a vehicle with a sale and to
+// delete its media.
+func (ref *vehicleRepository) Delete(ctx context.Context, id string) (_ *entity.Vehicle, err error) {
+	ctx, span := tracing.StartDBSpan(ctx, "vehicleRepository.Delete", deleteVehicle)
+	defer func() { tracing.EndSpan(span, err) }()
+
+	scope, err := tenant.FromContext(ctx)
+	if err != nil {
+		return nil, err
 	}
 
-	row = ref.db.QueryRowContext(ctx, updateVehicle, id, current.Brand, current.Model, current.Year, current.Color, current.Price)
+	row := database.GetExecutor(ctx, ref.db).QueryRowContext(ctx, deleteVehicle, id, scope.DealerID)
 
-	var updated model.Vehicle
-	if err := row.Scan(&updated.ID, &updated.EntityID, &updated.Brand, &updated.Model, &updated.Year, &updated.Color, &updated.Price, &updated.CreatedAt, &updated.UpdatedAt); err != nil {
+	var deleted model.Vehicle
+	if err = scanVehicle(row, &deleted); err != nil {
 		if err == sql.ErrNoRows {
 			return nil, nil
 		}
-		return nil, err
+		return nil, database.MapDeleteError(err)
 	}
 
-	return updated.ToDomain(), nil
+	return deleted.ToDomain(), nil
+}
+
+type scanner interface {
+	Scan(dest ...any) error
+}
+
+// scanVehicle follows the column order of the table, where each migration
+// appended its columns: dealer_id tenth, then version, the catalog columns,
+// slug, status and published_at.
+func scanVehicle(row scanner, vehicle *model.Vehicle) error {
+	return row.Scan(&vehicle.ID, &vehicle.EntityID, &vehicle.Brand, &vehicle.Model, &vehicle.Year, &vehicle.Color, &vehicle.Price, &vehicle.CreatedAt, &vehicle.UpdatedAt, &vehicle.DealerID, &vehicle.Version,
+		&vehicle.Mileage, &vehicle.FuelType, &vehicle.Transmission, &vehicle.BodyType, &vehicle.Condition, &vehicle.Doors, &vehicle.EngineDisplacement, &vehicle.LicensePlate, &vehicle.VIN,
+		&vehicle.Slug, &vehicle.Status, &vehicle.PublishedAt,
+	)
 }
@@ -0,0 +1,226 @@
+package main
+
+import (
+	"context"
+	"io/fs"
+	"log/slog"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+
+	"github.com/caiiomp/vehicle-platform-sales/db"
+	"github.com/caiiomp/vehicle-platform-sales/src/config"
+	interfaces "github.com/caiiomp/vehicle-platform-sales/src/core/_interfaces"
+	"github.com/caiiomp/vehicle-platform-sales/src/health"
+	"github.com/caiiomp/vehicle-platform-sales/src/metrics"
+	"github.com/caiiomp/vehicle-platform-sales/src/ratelimit"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/cache"
+	memoryapikeyrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/apiKeyRepository"
+	memorycouponrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/couponRepository"
+	memorydealerrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/dealerRepository"
+	memoryfinancingplanrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/financingPlanRepository"
+	memoryofferrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/offerRepository"
+	memorysalerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/saleRepository"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/store"
+	memoryvehiclemediarepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/vehicleMediaRepository"
+	memoryvehiclerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/memory/vehicleRepository"
+	mongoapikeyrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/apiKeyRepository"
+	mongocouponrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/couponRepository"
+	mongodealerrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/dealerRepository"
+	mongofinancingplanrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/financingPlanRepository"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/mongodb"
+	mongoofferrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/offerRepository"
+	mongosalerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/saleRepository"
+	mongovehiclemediarepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/vehicleMediaRepository"
+	mongovehiclerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/mongo/vehicleRepository"
+	apikeyrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/apiKeyRepository"
+	couponrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/couponRepository"
+	postgresdatabase "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/database"
+	dealerrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/dealerRepository"
+	financingplanrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/financingPlanRepository"
+	"github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/migrator"
+	offerrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/offerRepository"
+	ratelimitrepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/rateLimitRepository"
+	salerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/saleRepository"
+	vehiclemediarepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/vehicleMediaRepository"
+	vehiclerepository "github.com/caiiomp/vehicle-platform-sales/src/repositories/postgres/vehicleRepository"
+)
+
+// storage is the backend selected by STORAGE. The migrator and the shared
+// rate limit store are only set for Postgres, the one backend with a
+// versioned schema.
+type storage struct {
+	vehicleRepository       interfaces.VehicleRepository
+	mediaRepository         interfaces.VehicleMediaRepository
+	saleRepository          interfaces.SaleRepository
+	offerRepository         interfaces.OfferRepository
+	couponRepository        interfaces.CouponRepository
+	financingPlanRepository interfaces.FinancingPlanRepository
+	apiKeyRepository        interfaces.APIKeyRepository
+	dealerRepository        interfaces.DealerRepository
+	txManager               interfaces.TxManager
+	rateLimitStore          ratelimit.Store
+
+	migrator     *migrator.Migrator
+	healthChecks map[string]health.CheckFunc
+	collectors   []prometheus.Collector
+	close        func() error
+}
+
+// useCatalogCache puts the catalog cache in front of the vehicle reads. The
+// sale repository and the transaction manager are wrapped too, since sales
+// and commits invalidate cached vehicles.
+func (ref *storage) useCatalogCache(cfg config.Cache) {
+	lru := cache.NewLRU(cfg.CatalogCapacity, timeGenerator)
+	lru.OnEvict(metrics.CacheEvictionsTotal.WithLabelValues(cache.CatalogName).Inc)
+
+	catalog := cache.NewCatalog(lru, cfg.CatalogTTL)
+
+	ref.vehicleRepository = catalog.Vehicles(ref.vehicleRepository)
+	ref.saleRepository = catalog.Sales(ref.saleRepository)
+	ref.txManager = catalog.TxManager(ref.txManager)
+	ref.collectors = append(ref.collectors, metrics.NewCacheEntriesGauge(cache.CatalogName, lru.Len))
+}
+
+func openStorage(ctx context.Context, cfg *config.Config) (*storage, error) {
+	switch cfg.Storage {
+	case config.StorageMemory:
+		return openMemoryStorage(), nil
+	case config.StorageMongo:
+		return openMongoStorage(ctx, cfg)
+	default:
+		return openPostgresStorage(ctx, cfg)
+	}
+}
+
+func openMemoryStorage() *storage {
+	memoryStore := store.NewStore(timeGenerator)
+
+	return &storage{
+		vehicleRepository:       memoryvehiclerepository.NewVehicleRepository(memoryStore),
+		mediaRepository:         memoryvehiclemediarepository.NewVehicleMediaRepository(memoryStore),
+		saleRepository:          memorysalerepository.NewSaleRepository(memoryStore),
+		offerRepository:         memoryofferrepository.NewOfferRepository(memoryStore),
+		couponRepository:        memorycouponrepository.NewCouponRepository(memoryStore),
+		financingPlanRepository: memoryfinancingplanrepository.NewFinancingPlanRepository(memoryStore),
+		apiKeyRepository:        memoryapikeyrepository.NewAPIKeyRepository(memoryStore),
+		dealerRepository:        memorydealerrepository.NewDealerRepository(memoryStore),
+		txManager:               store.NewTxManager(memoryStore),
+		healthChecks:            map[string]health.CheckFunc{},
+		close:                   func() error { return nil },
+	}
+}
+
+func openMongoStorage(ctx context.Context, cfg *config.Config) (*storage, error) {
+	client, err := mongodb.Connect(ctx, cfg.Mongo.URI)
+	if err != nil {
+		return nil, err
+	}
+
+	closeClient := func() error {
+		return client.Disconnect(context.Background())
+	}
+
+	ping := func(ctx context.Context) error {
+		return client.Ping(ctx, nil)
+	}
+
+	if err = ping(ctx); err != nil {
+		closeClient()
+		return nil, err
+	}
+
+	database := client.Database(cfg.Mongo.Database)
+
+	if err = mongodb.EnsureIndexes(ctx, database); err != nil {
+		closeClient()
+		return nil, err
+	}
+
+	if err = mongovehiclerepository.BackfillSlugs(ctx, database); err != nil {
+		closeClient()
+		return nil, err
+	}
+
+	if err = mongovehiclerepository.BackfillListingStatus(ctx, database); err != nil {
+		closeClient()
+		return nil, err
+	}
+
+	if err = mongosalerepository.BackfillPriceBreakdown(ctx, database); err != nil {
+		closeClient()
+		return nil, err
+	}
+
+	transactional, err := mongodb.SupportsTransactions(ctx, client)
+	if err != nil {
+		closeClient()
+		return nil, err
+	}
+
+	if !transactional {
+		slog.Warn("mongo is not a replica set, units of work run without transactions")
+	}
+
+	return &storage{
+		vehicleRepository:       mongovehiclerepository.NewVehicleRepository(database),
+		mediaRepository:         mongovehiclemediarepository.NewVehicleMediaRepository(database),
+		saleRepository:          mongosalerepository.NewSaleRepository(database),
+		offerRepository:         mongoofferrepository.NewOfferRepository(database),
+		couponRepository:        mongocouponrepository.NewCouponRepository(database),
+		financingPlanRepository: mongofinancingplanrepository.NewFinancingPlanRepository(database),
+		apiKeyRepository:        mongoapikeyrepository.NewAPIKeyRepository(database),
+		dealerRepository:        mongodealerrepository.NewDealerRepository(database),
+		txManager:               mongodb.NewTxManager(client, transactional),
+		healthChecks: map[string]health.CheckFunc{
+			"mongo": ping,
+		},
+		close: closeClient,
+	}, nil
+}
+
+func openPostgresStorage(ctx context.Context, cfg *config.Config) (*storage, error) {
+	database, err := postgresdatabase.Open(ctx, cfg.Environment, cfg.Database)
+	if err != nil {
+		return nil, err
+	}
+
+	if err = database.PingContext(ctx); err != nil {
+		database.Close()
+		return nil, err
+	}
+
+	migrationsSource, err := fs.Sub(db.Migrations, "migrations")
+	if err != nil {
+		database.Close()
+		return nil, err
+	}
+
+	schemaMigrator, err := migrator.NewMigrator(database, migrationsSource)
+	if err != nil {
+		database.Close()
+		return nil, err
+	}
+
+	return &storage{
+		vehicleRepository:       vehiclerepository.NewVehicleRepository(database),
+		mediaRepository:         vehiclemediarepository.NewVehicleMediaRepository(database),
+		saleRepository:          salerepository.NewSaleRepository(database),
+		offerRepository:         offerrepository.NewOfferRepository(database),
+		couponRepository:        couponrepository.NewCouponRepository(database),
+		financingPlanRepository: financingplanrepository.NewFinancingPlanRepository(database),
+		apiKeyRepository:        apikeyrepository.NewAPIKeyRepository(database),
+		dealerRepository:        dealerrepository.NewDealerRepository(database),
+		txManager:               postgresdatabase.NewTxManager(database),
+		rateLimitStore:          ratelimitrepository.NewRateLimitRepository(database),
+		migrator:                schemaMigrator,
+		healthChecks: map[string]health.CheckFunc{
+			"postgres":   database.PingContext,
+			"migrations": schemaMigrator.Check,
+		},
+		collectors: []prometheus.Collector{
+			collectors.NewDBStatsCollector(database, "vehicle_platform_sales"),
+		},
+		close: database.Close,
+	}, nil
+}
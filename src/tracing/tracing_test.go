@@ -0,0 +1,73 @@
+package tracing
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"go.opentelemetry.io/otel"
+
+	"github.com/caiiomp/vehicle-platform-sales/src/config"
+)
+
+func TestSetup(t *testing.T) {
+	ctx := context.TODO()
+
+	t.Run("should not setup unknown exporter", func(t *testing.T) {
+		shutdown, err := Setup(ctx, config.Tracing{Exporter: "zipkin"}, nil)
+
+		assert.Nil(t, shutdown)
+		assert.ErrorContains(t, err, "unknown tracing exporter")
+	})
+
+	t.Run("should export spans to stdout", func(t *testing.T) {
+		var buffer bytes.Buffer
+
+		shutdown, err := Setup(ctx, config.Tracing{
+			Exporter:    ExporterStdout,
+			ServiceName: "vehicle-platform-sales",
+			SampleRatio: 1,
+		}, &buffer)
+		assert.Nil(t, err)
+
+		_, span := StartDBSpan(ctx, "vehicleRepository.GetByID", "SELECT * FROM vehicles\n\t\tWHERE entity_id = $1;")
+		EndSpan(span, errors.New("connection refused"))
+
+		assert.Nil(t, shutdown(ctx))
+		assert.Contains(t, buffer.String(), `"Name":"vehicleRepository.GetByID"`)
+		assert.Contains(t, buffer.String(), "SELECT * FROM vehicles WHERE entity_id = $1;")
+		assert.Contains(t, buffer.String(), "connection refused")
+	})
+
+	t.Run("should inject trace context on outgoing requests", func(t *testing.T) {
+		var traceparent string
+
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			traceparent = r.Header.Get("traceparent")
+		}))
+		defer server.Close()
+
+		shutdown, err := Setup(ctx, config.Tracing{
+			Exporter:    ExporterStdout,
+			ServiceName: "vehicle-platform-sales",
+			SampleRatio: 1,
+		}, &bytes.Buffer{})
+		assert.Nil(t, err)
+		defer shutdown(ctx)
+
+		client := &http.Client{Transport: otelhttp.NewTransport(http.DefaultTransport)}
+
+		spanCtx, span := otel.Tracer("test").Start(ctx, "buy")
+		request, _ := http.NewRequestWithContext(spanCtx, http.MethodPost, server.URL, nil)
+		_, err = client.Do(request)
+		span.End()
+
+		assert.Nil(t, err)
+		assert.Contains(t, traceparent, span.SpanContext().TraceID().String())
+	})
+}
@@ -0,0 +1,52 @@
+package workers
+
+import (
+	"context"
+	"sync"
+)
+
+// Group runs background workers bound to a shared context, so they can be
+// stopped together when the server shuts down.
+type Group struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+func NewGroup(ctx context.Context) *Group {
+	ctx, cancel := context.WithCancel(ctx)
+
+	return &Group{
+		ctx:    ctx,
+		cancel: cancel,
+	}
+}
+
+// Go starts fn in its own goroutine. fn must return once its context is done.
+func (ref *Group) Go(fn func(ctx context.Context)) {
+	ref.wg.Add(1)
+
+	go func() {
+		defer ref.wg.Done()
+		fn(ref.ctx)
+	}()
+}
+
+// Shutdown cancels every worker and waits for them to return or for ctx to
+// expire, whichever happens first.
+func (ref *Group) Shutdown(ctx context.Context) error {
+	ref.cancel()
+
+	done := make(chan struct{})
+	go func() {
+		ref.wg.Wait()
+		close(done)
+	}()
+
+	select {
+	case <-done:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
@@ -0,0 +1,46 @@
+package workers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestShutdown(t *testing.T) {
+	t.Run("should stop workers successfully", func(t *testing.T) {
+		group := NewGroup(context.TODO())
+
+		stopped := make(chan struct{})
+		group.Go(func(ctx context.Context) {
+			<-ctx.Done()
+			close(stopped)
+		})
+
+		err := group.Shutdown(context.TODO())
+
+		_, isRunning := <-stopped
+
+		assert.Nil(t, err)
+		assert.False(t, isRunning)
+	})
+
+	t.Run("should give up when workers do not stop before the deadline", func(t *testing.T) {
+		group := NewGroup(context.TODO())
+
+		release := make(chan struct{})
+		defer close(release)
+
+		group.Go(func(ctx context.Context) {
+			<-release
+		})
+
+		ctx, cancel := context.WithTimeout(context.TODO(), 10*time.Millisecond)
+		defer cancel()
+
+		err := group.Shutdown(ctx)
+
+		assert.ErrorIs(t, err, context.DeadlineExceeded)
+	})
+}